@@ -16,29 +16,48 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/exhibitions": {
+        "/api/artworks": {
             "get": {
-                "description": "Get a list of all exhibitions data is public only",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the catalogue of the current user sorted by title. Admins may list the catalogue of another owner.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exhibitions"
+                    "Artworks"
+                ],
+                "summary": "Get artworks",
+                "operationId": "GetArtworks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matches title, creator or accession number",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Owner of the catalogue (admins only)",
+                        "name": "ownerId",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all exhibitions is public",
-                "operationId": "GetExhibitionsIsPublic",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.ResponseExhibition"
+                                "$ref": "#/definitions/model.Artwork"
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
@@ -51,7 +70,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new exhibition data",
+                "description": "Add an object to the catalogue of the current user. Accession numbers are unique per owner.",
                 "consumes": [
                     "application/json"
                 ],
@@ -59,18 +78,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Exhibitions"
+                    "Artworks"
                 ],
-                "summary": "Create a new exhibition",
-                "operationId": "CreateExhibition",
+                "summary": "Create an artwork",
+                "operationId": "CreateArtwork",
                 "parameters": [
                     {
-                        "description": "Exhibition data to create",
-                        "name": "requestExhibition",
+                        "description": "Artwork data",
+                        "name": "requestArtwork",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.RequestCreateExhibition"
+                            "$ref": "#/definitions/model.RequestArtwork"
                         }
                     }
                 ],
@@ -86,38 +105,9 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
-                    }
-                }
-            }
-        },
-        "/api/exhibitions/all": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get a list of all exhibitions data",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Exhibitions"
-                ],
-                "summary": "Get all exhibitions",
-                "operationId": "GetAllExhibitions",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.ResponseExhibition"
-                            }
-                        }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Accession number already exists",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
@@ -125,77 +115,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/exhibitions/filter/{category}": {
-            "get": {
-                "description": "Get exhibitions filtered by category, status, and sorting order",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Exhibitions"
-                ],
-                "summary": "Get exhibitions by category",
-                "operationId": "get-exhibitions-by-category",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Category name",
-                        "name": "category",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Status of the exhibitions (current, previous, upcoming)",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort order (asc, desc)",
-                        "name": "sort",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibition"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                }
-            }
-        },
-        "/api/exhibitions/{id}": {
+        "/api/artworks/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get exhibition data by exhibitionID",
+                "description": "Get an artwork of the current user's catalogue",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exhibitions"
+                    "Artworks"
                 ],
-                "summary": "Get exhibition by ID",
-                "operationId": "GetExhibitionByID",
+                "summary": "Get artwork by ID",
+                "operationId": "GetArtworkByID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Exhibition ID",
+                        "description": "Artwork ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -205,11 +144,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibition"
+                            "$ref": "#/definitions/model.Artwork"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Artwork not found",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
@@ -222,30 +161,33 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update exhibition data by exhibitionID",
+                "description": "Replace the catalogue fields of an artwork. Exhibitions referencing it show the new metadata.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exhibitions"
+                    "Artworks"
                 ],
-                "summary": "Update exhibition by ID",
-                "operationId": "UpdateExhibition",
+                "summary": "Update artwork by ID",
+                "operationId": "UpdateArtwork",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Exhibition ID",
+                        "description": "Artwork ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Exhibition data to update",
-                        "name": "updateRequest",
+                        "description": "Artwork data",
+                        "name": "requestArtwork",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.RequestUpdateExhibition"
+                            "$ref": "#/definitions/model.RequestArtwork"
                         }
                     }
                 ],
@@ -253,11 +195,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibition"
+                            "$ref": "#/definitions/model.Artwork"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Artwork not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Accession number already exists",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
@@ -270,19 +224,19 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete exhibition data by exhibitionID",
+                "description": "Delete an artwork. Artworks still referenced by a section or room item cannot be deleted.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exhibitions"
+                    "Artworks"
                 ],
-                "summary": "Delete exhibition by ID",
-                "operationId": "DeleteExhibition",
+                "summary": "Delete artwork by ID",
+                "operationId": "DeleteArtwork",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Exhibition ID",
+                        "description": "Artwork ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -290,13 +244,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Delete Exhibition Success",
+                        "description": "Delete Artwork Success",
                         "schema": {
                             "$ref": "#/definitions/model.ResponseGetExhibitionId"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Artwork not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Artwork is used in exhibitions",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
@@ -304,36 +264,92 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/exhibitions/{id}/ban": {
-            "post": {
+        "/api/audit-log": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "BanExhibition by exhibitionID",
+                "description": "Get the entries of the audit log, most recent first. Every request changing something is recorded, including the rejected ones, with its actor, IP, request ID, response status and hashes of the target before and after. Page with before, the ID of the last entry of the previous page. Entries expire after the retention set by AUDIT_RETENTION_DAYS.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Ban"
+                    "Audit"
                 ],
-                "summary": "BanExhibition",
-                "operationId": "BanExhibition",
+                "summary": "Get audit log",
+                "operationId": "GetAuditLog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Exhibition ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Actor user ID",
+                        "name": "actorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action, such as BanExhibition",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target type, such as exhibition",
+                        "name": "targetType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target ID",
+                        "name": "targetId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest time, in RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time before which entries were recorded, in RFC 3339",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the entry to list the entries before",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entries, 50 by default and at most 200",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibition"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.AuditEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter or limit",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
                         }
                     },
                     "500": {
@@ -345,40 +361,81 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/exhibitions/{id}/like": {
-            "put": {
+        "/api/events": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Like exhibition by exhibitionID",
+                "description": "Stream the changes of exhibitions as Server-Sent Events for dashboards: created, updated, published, unpublished, banned, moderated and like counts. Events resulting from a moderation action carry it, so exhibitors learn the outcome of reports. Exhibitors follow their own exhibitions, admins every exhibition or those of the owner given by ownerId. Deleted exhibitions and changes of sections and rooms are only streamed to admins following every exhibition. Events, resuming and authentication work as for GET /api/exhibitions/{id}/events.",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "Like \u0026 Unlike"
+                    "Events"
                 ],
-                "summary": "Like exhibition by ID",
-                "operationId": "LikeExhibition",
+                "summary": "Stream the changes of exhibitions",
+                "operationId": "StreamEvents",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Exhibition ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Owner of the exhibitions to follow",
+                        "name": "ownerId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated event types to receive, all by default",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received, to resume after",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received, to resume after",
+                        "name": "lastEventId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer token, for clients that cannot set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Stream of events",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibition"
+                            "$ref": "#/definitions/model.Event"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Invalid owner ID, event type or last event ID",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Events after the last event ID are no longer available",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "503": {
+                        "description": "Event streams are not available",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
@@ -386,44 +443,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/exhibitions/{id}/rooms": {
+        "/api/exhibitions": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get Rooms By exhibitionID",
+                "description": "Get a list of all exhibitions data is public only",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Rooms"
-                ],
-                "summary": "Get Rooms By exhibitionID",
-                "operationId": "GetRoomsByExhibitionID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Exhibition ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Exhibitions"
                 ],
+                "summary": "Get all exhibitions is public",
+                "operationId": "GetExhibitionsIsPublic",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.ResponseExhibitionRoom"
+                                "$ref": "#/definitions/model.ResponseExhibition"
                             }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -431,46 +471,84 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/exhibitions/{id}/sections": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get Sections By exhibitionID",
+                "description": "Create a new exhibition data. Its texts are screened: an exhibition with flagged texts is held for review by a moderator instead of being published, and blocked texts are rejected with the rules they matched.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sections"
+                    "Exhibitions"
                 ],
-                "summary": "Get Sections By exhibitionID",
-                "operationId": "GetSectionsByExhibitionID",
+                "summary": "Create a new exhibition",
+                "operationId": "CreateExhibition",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Exhibition ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Exhibition data to create",
+                        "name": "requestExhibition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCreateExhibition"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, unsupported layout or invalid venue location",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Content blocked by screening",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
                     }
                 ],
+                "description": "Get a list of all exhibitions data",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exhibitions"
+                ],
+                "summary": "Get all exhibitions",
+                "operationId": "GetAllExhibitions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.ResponseExhibitionSection"
+                                "$ref": "#/definitions/model.ResponseExhibition"
                             }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -480,29 +558,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/exhibitions/{id}/unlike": {
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/api/exhibitions/filter/{category}": {
+            "get": {
+                "description": "Get exhibitions filtered by category, status, and sorting order",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "unlike exhibition by exhibitionID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Like \u0026 Unlike"
+                    "Exhibitions"
                 ],
-                "summary": "Unlike exhibition by ID",
-                "operationId": "UnlikeExhibition",
+                "summary": "Get exhibitions by category",
+                "operationId": "get-exhibitions-by-category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Exhibition ID",
-                        "name": "id",
+                        "description": "Category name",
+                        "name": "category",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status of the exhibitions (current, previous, upcoming)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc, desc)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -512,95 +600,149 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ResponseExhibition"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request"
+                    },
                     "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/helper.APIError"
-                        }
+                        "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/api/rooms": {
+        "/api/exhibitions/import": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new exhibitionRoom data",
+                "description": "Import an exhibition bundle as a new private exhibition owned by the current user. IDs are reassigned and conflicts are reported. Use dryRun to only validate the bundle. Bundles may hold at most 512 MiB, and unpack to at most 1 GiB with no file over 200 MiB. Its texts are screened as for a new exhibition: an exhibition with flagged texts is held for review and blocked texts are rejected. References to artworks outside the catalogue of the current user are dropped.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Rooms"
+                    "Bundles"
                 ],
-                "summary": "Create a new exhibitionRoom",
-                "operationId": "CreateExhibitionRoom",
+                "summary": "Import an exhibition",
+                "operationId": "ImportExhibition",
                 "parameters": [
                     {
-                        "description": "ExhibitionRoom data to create",
-                        "name": "requestExhibitionRoom",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.RequestCreateExhibitionRoom"
-                        }
+                        "type": "file",
+                        "description": "Bundle archive",
+                        "name": "bundle",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate without importing",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "Dry run result",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseImportExhibition"
+                        }
+                    },
                     "201": {
-                        "description": "Success",
+                        "description": "Imported",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibitionRoom"
+                            "$ref": "#/definitions/model.ResponseImportExhibition"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid bundle",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
+                    "413": {
+                        "description": "Bundle too large",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unsupported layout or content blocked by screening",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
                     "500": {
-                        "description": "Invalid request body"
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     }
                 }
             }
         },
-        "/api/rooms/all": {
+        "/api/exhibitions/nearby": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get a list of all exhibition Rooms data",
+                "description": "Get published exhibitions whose venue lies within a radius of a place, nearest first, with the distance of the venue in meters",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Rooms"
+                    "Exhibitions"
+                ],
+                "summary": "Get exhibitions near a place",
+                "operationId": "GetNearbyExhibitions",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Radius in meters (default 10000, at most 200000)",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of results (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all exhibitions Rooms",
-                "operationId": "GetAllExhibitionRooms",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.ResponseExhibitionRoom"
+                                "$ref": "#/definitions/model.ResponseNearbyExhibition"
                             }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
+                    "400": {
+                        "description": "Invalid location or radius",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -611,40 +753,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/rooms/{id}": {
+        "/api/exhibitions/search": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get exhibition data by RoomID",
+                "description": "Full text search over the texts of published exhibitions in every locale, best matches first. Search terms are analysed in the preferred locale of the client.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Rooms"
+                    "Exhibitions"
                 ],
-                "summary": "Get exhibitionRoom by ID",
-                "operationId": "GetExhibitionRoomByID",
+                "summary": "Search exhibitions",
+                "operationId": "SearchExhibitions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Exhibition Room ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Search terms",
+                        "name": "q",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of results (default 50)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibitionRoom"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResponseExhibition"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
+                    "400": {
+                        "description": "Missing search terms",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -653,38 +808,55 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/exhibitions/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update exhibitionRoom data by RoomID",
+                "description": "Get exhibition data by exhibitionID. Private exhibitions are only returned to the owner, collaborators, admins or holders of a valid share link.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Rooms"
+                    "Exhibitions"
                 ],
-                "summary": "Update exhibitionRoom by RoomID",
-                "operationId": "UpdateExhibitionRoom",
+                "summary": "Get exhibition by ID",
+                "operationId": "GetExhibitionByID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ExhibitionRoom ID",
+                        "description": "Exhibition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "ExhibitionRoom data to update",
-                        "name": "updateRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.RequestUpdateExhibitionRoom"
-                        }
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -695,7 +867,22 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized"
+                        "description": "Share link password required or invalid",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Share link expired",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -705,39 +892,63 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete Room data by RoomID",
+                "description": "Update exhibition data by exhibitionID. Its texts are screened: flagged texts hold the exhibition for review by a moderator, unpublishing it until it is approved, and blocked texts are rejected with the rules they matched. Owners cannot change the status of an exhibition held for review.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Rooms"
+                    "Exhibitions"
                 ],
-                "summary": "Delete Room by ID",
-                "operationId": "DeleteExhibitionRoomByID",
+                "summary": "Update exhibition by ID",
+                "operationId": "UpdateExhibition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Room ID",
+                        "description": "Exhibition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Exhibition data to update",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestUpdateExhibition"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Delete Room Success",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                            "$ref": "#/definitions/model.ResponseExhibition"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
+                    "400": {
+                        "description": "Invalid request body, unsupported layout, invalid venue location, or a section or room of another exhibition",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Media is under embargo or content blocked by screening",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -746,88 +957,120 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/sections": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new exhibitionSection data",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete exhibition data by exhibitionID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sections"
+                    "Exhibitions"
                 ],
-                "summary": "Create a new exhibitionSection",
-                "operationId": "CreateExhibitionSection",
+                "summary": "Delete exhibition by ID",
+                "operationId": "DeleteExhibition",
                 "parameters": [
                     {
-                        "description": "ExhibitionSection data to create",
-                        "name": "requestExhibitionSection",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.RequestCreateExhibitionSection"
-                        }
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Success",
+                    "200": {
+                        "description": "Delete Exhibition Success",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseGetExhibitionSectionId"
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
-                    },
                     "500": {
-                        "description": "Invalid request body"
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     }
                 }
             }
         },
-        "/api/sections/all": {
-            "get": {
+        "/api/exhibitions/{id}/appeals": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a list of all exhibition sections data",
+                "description": "Ask the moderators to reconsider a warning, unpublishing or ban of an exhibition. Only the owner may appeal, once per action.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sections"
+                    "Moderation"
+                ],
+                "summary": "Appeal a moderation action",
+                "operationId": "AppealModerationAction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Appeal",
+                        "name": "requestAppeal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestAppeal"
+                        }
+                    }
                 ],
-                "summary": "Get all exhibitions sections",
-                "operationId": "GetAllExhibitionSections",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.ResponseExhibitionSection"
-                            }
+                            "$ref": "#/definitions/model.Appeal"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
+                    "400": {
+                        "description": "Invalid request body or action cannot be appealed",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition or action not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Action is already appealed",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -838,40 +1081,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/sections/{id}": {
-            "get": {
+        "/api/exhibitions/{id}/ban": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get exhibition data by sectionID",
+                "description": "Ban an exhibition. This is the ban action of POST /api/moderation/exhibitions/{id}/actions: the reason is required, the ban is recorded with the moderator, the owner is told and can appeal it, and the open reports are resolved.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sections"
+                    "Ban"
                 ],
-                "summary": "Get exhibitionSection by ID",
-                "operationId": "GetExhibitionSectionByID",
+                "summary": "BanExhibition",
+                "operationId": "BanExhibition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Exhibition Section ID",
+                        "description": "Exhibition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Reason of the ban",
+                        "name": "requestBan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestBanExhibition"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibitionSection"
+                            "$ref": "#/definitions/model.ModerationAction"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Exhibition is already banned",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -880,37 +1150,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/exhibitions/{id}/check-in": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update exhibitionSection data by sectionID",
+                "description": "Validate the booking token scanned from the QR code of a reservation for this exhibition and consume it. A token can be checked in once; cancelled reservations are refused.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sections"
+                    "Reservations"
                 ],
-                "summary": "Update exhibitionSection by sectionID",
-                "operationId": "UpdateExhibitionSection",
+                "summary": "Check in a booking token",
+                "operationId": "CheckInReservation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ExhibitionSection ID",
+                        "description": "Exhibition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "ExhibitionSection data to update",
-                        "name": "updateRequest",
+                        "description": "Booking token",
+                        "name": "requestCheckIn",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.RequestUpdateExhibitionSection"
+                            "$ref": "#/definitions/model.RequestCheckIn"
                         }
                     }
                 ],
@@ -918,193 +1193,8744 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibition"
+                            "$ref": "#/definitions/model.ResponseReservation"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
+                    "400": {
+                        "description": "Invalid request body or booking token",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Reservation is cancelled or already checked in",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/exhibitions/{id}/clone": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete Section data by sectionID",
+                "description": "Deep-copy an exhibition with its sections and rooms into a new private exhibition owned by the current user. Its texts are screened as for a new exhibition: a copy with flagged texts is held for review and blocked texts are rejected. References to artworks outside the catalogue of the current user are left out of the copy.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sections"
+                    "Templates"
                 ],
-                "summary": "Delete Section by ID",
-                "operationId": "DeleteExhibitionSectionByID",
+                "summary": "Clone an exhibition",
+                "operationId": "CloneExhibition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Section ID",
+                        "description": "Exhibition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Clone options",
+                        "name": "cloneRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCloneExhibition"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Delete Section Success",
+                    "201": {
+                        "description": "Success",
                         "schema": {
                             "$ref": "#/definitions/model.ResponseGetExhibitionId"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized"
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Exhibition not found",
                         "schema": {
                             "$ref": "#/definitions/helper.APIError"
                         }
-                    }
+                    },
+                    "422": {
+                        "description": "Content blocked by screening",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/collaborators": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the owner and collaborators of an exhibition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collaborators"
+                ],
+                "summary": "Get collaborators of an exhibition",
+                "operationId": "GetCollaborators",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Collaborator"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invite a user to co-curate an exhibition as editor or viewer. Only the owner can invite.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collaborators"
+                ],
+                "summary": "Invite a collaborator",
+                "operationId": "InviteCollaborator",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Collaborator to invite",
+                        "name": "requestCollaborator",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestInviteCollaborator"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Collaborator already exists",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/collaborators/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accept a pending invitation to collaborate on an exhibition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collaborators"
+                ],
+                "summary": "Accept a collaboration invitation",
+                "operationId": "AcceptInvitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Invitation not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/collaborators/{userId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the role of a collaborator. Only the owner can change roles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collaborators"
+                ],
+                "summary": "Change a collaborator role",
+                "operationId": "UpdateCollaboratorRole",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Collaborator user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New role",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestUpdateCollaboratorRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Collaborator not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a collaborator or revoke a pending invitation. Collaborators can also remove themselves.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collaborators"
+                ],
+                "summary": "Remove a collaborator",
+                "operationId": "RemoveCollaborator",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Collaborator user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Collaborator not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stream the changes of an exhibition, its sections and its rooms as Server-Sent Events, so editors see the edits of other collaborators without polling. Every event is named after its type and carries a model.Event as data. section.changed and room.changed carry the ID of the section or room that was created or edited, or none when sections or rooms were added, removed or reordered. Collaborators with any role may follow an exhibition. Browsers pass the bearer token as the access_token query parameter. Reconnecting clients send the ID of the last event they received in the Last-Event-ID header, which EventSource does by itself, and receive the events they missed; the stream also sends ID-only messages while idle so the resume position stays recent.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Stream the changes of an exhibition",
+                "operationId": "StreamExhibitionEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated event types to receive, all by default",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received, to resume after",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received, to resume after",
+                        "name": "lastEventId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer token, for clients that cannot set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of events",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid event type or last event ID",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Events after the last event ID are no longer available",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "503": {
+                        "description": "Event streams are not available",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export an exhibition with its sections, rooms and locally stored media as a versioned zip bundle",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "Bundles"
+                ],
+                "summary": "Export an exhibition",
+                "operationId": "ExportExhibition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bundle archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/export/site": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Render a blogLayout or liveLayout exhibition into a static HTML site packaged as a zip archive. Stored media is copied into the site, remote media stays linked to where it is hosted",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "Bundles"
+                ],
+                "summary": "Export an exhibition as a static site",
+                "operationId": "ExportExhibitionSite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Site archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unsupported layout",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/iiif/manifest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a IIIF Presentation 3.0 manifest of an exhibition. Sections and rooms become ranges, their images canvases.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Publishing"
+                ],
+                "summary": "Get the IIIF manifest of an exhibition",
+                "operationId": "GetIIIFManifest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "IIIF manifest",
+                        "schema": {
+                            "$ref": "#/definitions/iiif.Manifest"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Exhibition has no images",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/like": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Like exhibition by exhibitionID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Like \u0026 Unlike"
+                ],
+                "summary": "Like exhibition by ID",
+                "operationId": "LikeExhibition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibition"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/media-rights": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the rights holder, licence, attribution and embargo date of the media an exhibition uses. Public exhibitions may not use embargoed media.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exhibitions"
+                ],
+                "summary": "Update media rights of an exhibition",
+                "operationId": "UpdateMediaRights",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Media rights",
+                        "name": "requestMediaRights",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestMediaRights"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Media is under embargo",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/metadata": {
+            "get": {
+                "description": "Get schema.org ExhibitionEvent JSON-LD with OpenGraph and Twitter card tags for a published exhibition. With format=html the metadata is returned as an HTML snippet for the page head.",
+                "produces": [
+                    "application/json",
+                    "text/html"
+                ],
+                "tags": [
+                    "Publishing"
+                ],
+                "summary": "Get the page metadata of an exhibition",
+                "operationId": "GetExhibitionMetadata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page metadata",
+                        "schema": {
+                            "$ref": "#/definitions/seo.Metadata"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/moderation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the moderation actions taken on an exhibition, most recent first, and the appeals against them. Available to the owner and admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Get the moderation history of an exhibition",
+                "operationId": "GetModerationHistory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ModerationHistory"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/occupancy": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Count the visitors connected to an exhibition, in total and per room. Users connected from several devices count once. The exhibition is visible to the same users as GET /api/exhibitions/{id}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Presence"
+                ],
+                "summary": "Get the occupancy of an exhibition",
+                "operationId": "GetOccupancy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Occupancy"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/presence": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upgrade to a WebSocket connection to walk a liveLayout exhibition together with other visitors. Browsers pass the bearer token as the access_token query parameter. Send {\"type\":\"move\",\"roomId\":\"...\",\"position\":{\"x\":0,\"y\":0,\"z\":0,\"yaw\":0}} to enter a room or move in it, and {\"type\":\"chat\",\"text\":\"...\"} to talk to the visitors of your room. You receive welcome with your session, room with the visitors of a room you entered, joined, moved, left and chat events of the other visitors of your room, and error events for invalid messages. Visitors who read too slowly miss position updates and are disconnected when even chat messages cannot be queued.",
+                "tags": [
+                    "Presence"
+                ],
+                "summary": "Join the visitors of an exhibition",
+                "operationId": "JoinPresence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer token, for clients that cannot set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching protocols",
+                        "schema": {
+                            "$ref": "#/definitions/model.PresenceEvent"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Exhibition does not use the live layout",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/quizzes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the quizzes of an exhibition without their answers, optionally only those of a section or room. The exhibition is visible to the same users as GET /api/exhibitions/{id}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quizzes"
+                ],
+                "summary": "Get the quizzes of an exhibition",
+                "operationId": "GetExhibitionQuizzes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Section ID",
+                        "name": "sectionId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResponseQuiz"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid section or room ID",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/reports": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report an exhibition the user can see to the moderators, with a reason category and optional details. A user has one open report per exhibition; they may report it again once moderators acted on it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Report an exhibition",
+                "operationId": "ReportExhibition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Report",
+                        "name": "requestReport",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestReport"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Exhibition is already reported",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/reservations": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Book seats in a time slot of an exhibition. Bookings never exceed the capacity of the slot, also when made at the same time, and a user holds at most one confirmed reservation per slot. The response carries the booking token to show at the entrance, also available as a QR code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Reserve a time slot",
+                "operationId": "CreateReservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot and seats",
+                        "name": "requestCreateReservation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCreateReservation"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseReservation"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition or time slot not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Time slot is fully booked, has ended or is already reserved",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/rooms": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get Rooms By exhibitionID. Rooms are only shown to those who may see the exhibition, without media under embargo.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rooms"
+                ],
+                "summary": "Get Rooms By exhibitionID",
+                "operationId": "GetRoomsByExhibitionID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResponseExhibitionRoom"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/sections": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get Sections By exhibitionID. Sections are only shown to those who may see the exhibition, without media under embargo.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sections"
+                ],
+                "summary": "Get Sections By exhibitionID",
+                "operationId": "GetSectionsByExhibitionID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResponseExhibitionSection"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/share-links": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all share links of an exhibition, including expired and revoked ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share Links"
+                ],
+                "summary": "Get share links of an exhibition",
+                "operationId": "GetShareLinks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ShareLink"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a tokenised preview link to an exhibition, optionally with expiry and password. The token is only returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share Links"
+                ],
+                "summary": "Create a share link",
+                "operationId": "CreateShareLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Share link options",
+                        "name": "requestShareLink",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCreateShareLink"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseCreateShareLink"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/share-links/{linkId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke a share link so it can no longer be used",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share Links"
+                ],
+                "summary": "Revoke a share link",
+                "operationId": "RevokeShareLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link ID",
+                        "name": "linkId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Share link not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/time-slots": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the entry time slots of an exhibition in chronological order with their remaining seats. The exhibition is visible to the same users as GET /api/exhibitions/{id}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Get the time slots of an exhibition",
+                "operationId": "GetTimeSlots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.TimeSlot"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add an entry time slot with a number of seats to an exhibition",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Create a time slot",
+                "operationId": "CreateTimeSlot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot data to create",
+                        "name": "requestTimeSlot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestTimeSlot"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or window",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/timeline": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the entries of a timelineLayout exhibition in chronological order, grouped by era. The exhibition is visible to the same users as GET /api/exhibitions/{id}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Timeline"
+                ],
+                "summary": "Get the timeline of an exhibition",
+                "operationId": "GetExhibitionTimeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseTimeline"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Exhibition does not use the timeline layout",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/tours": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the tours of an exhibition sorted by name, resolved for playback in the negotiated locale. The exhibition is visible to the same users as GET /api/exhibitions/{id}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tours"
+                ],
+                "summary": "Get the tours of an exhibition",
+                "operationId": "GetExhibitionTours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResponseTour"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Transfer ownership to an accepted collaborator. The previous owner stays on as editor.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Collaborators"
+                ],
+                "summary": "Transfer exhibition ownership",
+                "operationId": "TransferOwnership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New owner",
+                        "name": "transferRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestTransferOwnership"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Collaborator not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/translations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report for every locale of an exhibition how many of its texts are translated and which are missing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exhibitions"
+                ],
+                "summary": "Get translation status of an exhibition",
+                "operationId": "GetExhibitionTranslations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseTranslations"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exhibitions/{id}/unlike": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "unlike exhibition by exhibitionID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Like \u0026 Unlike"
+                ],
+                "summary": "Unlike exhibition by ID",
+                "operationId": "UnlikeExhibition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibition"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/feeds/exhibitions.atom": {
+            "get": {
+                "description": "Get an Atom feed of newly published exhibitions, optionally of one category or exhibitor",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Publishing"
+                ],
+                "summary": "Get the Atom feed of exhibitions",
+                "operationId": "GetAtomFeed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the exhibitor",
+                        "name": "exhibitor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Atom feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid exhibitor",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/feeds/exhibitions.ics": {
+            "get": {
+                "description": "Get an iCalendar feed with the dates of published exhibitions that have not ended, optionally of one category or exhibitor",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Publishing"
+                ],
+                "summary": "Get the calendar of exhibitions",
+                "operationId": "GetCalendarFeed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the exhibitor",
+                        "name": "exhibitor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid exhibitor",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/feeds/exhibitions.rss": {
+            "get": {
+                "description": "Get an RSS 2.0 feed of newly published exhibitions, optionally of one category or exhibitor",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Publishing"
+                ],
+                "summary": "Get the RSS feed of exhibitions",
+                "operationId": "GetRSSFeed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the exhibitor",
+                        "name": "exhibitor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid exhibitor",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/internal/profile-changes": {
+            "post": {
+                "description": "Internal endpoint the user service posts changed profiles to. The body is signed like webhook payloads, with an X-AtomMuse-Signature header of the form t=\u003cunix seconds\u003e,v1=\u003chex HMAC-SHA256 of \"\u003ct\u003e.\u003cbody\u003e\" keyed with the shared secret\u003e. The profile is copied into every exhibition the user owns or collaborates on. A change older than the last one received is ignored and reported as stale, so changes may be retried and arrive out of order.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Apply a changed user profile",
+                "operationId": "ProfileChanged",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Signature of the body",
+                        "name": "X-AtomMuse-Signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Changed profile",
+                        "name": "userProfile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserProfile"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProfileSync"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid signature",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "503": {
+                        "description": "No shared secret is configured",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/appeals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the appeals against moderation actions, oldest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Get appeals",
+                "operationId": "GetAppeals",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "granted",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "description": "Appeal status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Appeal"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/appeals/{id}/decision": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Grant or reject a pending appeal with a reason. Granting the appeal of a ban unbans the exhibition, which tells the owner through an event of the exhibition.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Decide an appeal",
+                "operationId": "DecideAppeal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Appeal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision",
+                        "name": "requestAppealDecision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestAppealDecision"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Appeal"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Appeal not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Appeal is already decided",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/exhibitions/{id}/actions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Take a moderation action on an exhibition and resolve its open reports: dismiss the reports, warn the owner, unpublish the exhibition until the owner publishes it again, ban it, unban it, or approve an exhibition the content screening held for review. Only public exhibitions can be unpublished, only banned ones unbanned and only held ones approved. The action, the moderator, the reason and the time are recorded, and the owner is told through an event of the exhibition carrying the action.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Act on an exhibition",
+                "operationId": "TakeModerationAction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Action",
+                        "name": "requestModerationAction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestModerationAction"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ModerationAction"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Action does not apply to the exhibition",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/exhibitions/{id}/reports": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the reports of an exhibition, most recent first. Resolved reports name the action that resolved them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Get the reports of an exhibition",
+                "operationId": "GetExhibitionReports",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "open",
+                            "resolved"
+                        ],
+                        "type": "string",
+                        "description": "Report status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Report"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/queue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the exhibitions with open reports, the most reported first, including those the content screening held for review with a report in the screening category, with the number of open reports in every category, when they were first and last reported and the last action taken on them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Get the moderation queue",
+                "operationId": "GetModerationQueue",
+                "parameters": [
+                    {
+                        "enum": [
+                            "spam",
+                            "offensive",
+                            "harassment",
+                            "copyright",
+                            "misleading",
+                            "other",
+                            "screening"
+                        ],
+                        "type": "string",
+                        "description": "Only exhibitions with open reports in this category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of exhibitions, 50 by default and at most 200",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ModerationCase"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid category or limit",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/points-of-interest": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a point of interest to a mapLayout exhibition. The location is a GeoJSON point with coordinates in [longitude, latitude] order.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Map"
+                ],
+                "summary": "Create a point of interest",
+                "operationId": "CreatePointOfInterest",
+                "parameters": [
+                    {
+                        "description": "Point of interest data to create",
+                        "name": "requestPointOfInterest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCreatePointOfInterest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or location",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Exhibition does not use the map layout",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/points-of-interest/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a point of interest with all its translations for editing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Map"
+                ],
+                "summary": "Get point of interest by ID",
+                "operationId": "GetPointOfInterestByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Point of interest ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.PointOfInterest"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Point of interest not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the location and content of a point of interest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Map"
+                ],
+                "summary": "Update point of interest by ID",
+                "operationId": "UpdatePointOfInterest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Point of interest ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Point of interest data",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestUpdatePointOfInterest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.PointOfInterest"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or location",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Point of interest not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a point of interest from the map of its exhibition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Map"
+                ],
+                "summary": "Delete point of interest by ID",
+                "operationId": "DeletePointOfInterest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Point of interest ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete point of interest success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Point of interest not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/preview/{token}": {
+            "get": {
+                "description": "Get the exhibition a share link points to. Password-protected links need the X-Share-Password header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share Links"
+                ],
+                "summary": "Preview an exhibition through a share link",
+                "operationId": "GetSharedExhibition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibition"
+                        }
+                    },
+                    "401": {
+                        "description": "Password required or invalid",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Share link not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Share link expired",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/profiles/reconcile": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copy every stored user profile into the exhibitions again right away, instead of waiting for the hourly reconciliation, and list the users whose copies had drifted with the number of exhibitions repaired.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Reconcile user profiles",
+                "operationId": "ReconcileProfiles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ProfileSync"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/quizzes": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach a quiz to an exhibition, or to one of its sections or rooms. Questions are multipleChoice, trueFalse or imagePick; correct lists the positions of the correct options and points defaults to 1.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quizzes"
+                ],
+                "summary": "Create a quiz",
+                "operationId": "CreateQuiz",
+                "parameters": [
+                    {
+                        "description": "Quiz data to create",
+                        "name": "requestQuiz",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestQuiz"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or questions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/quizzes/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a quiz with its correct answers for editing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quizzes"
+                ],
+                "summary": "Get quiz by ID",
+                "operationId": "GetQuizByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Quiz ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Quiz"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Quiz not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the questions of a quiz or move it to another section or room of its exhibition. Earlier attempts keep their scores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quizzes"
+                ],
+                "summary": "Update quiz by ID",
+                "operationId": "UpdateQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Quiz ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quiz data",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestQuiz"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Quiz"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or questions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Quiz not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a quiz together with the attempts of visitors",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quizzes"
+                ],
+                "summary": "Delete quiz by ID",
+                "operationId": "DeleteQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Quiz ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete quiz success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Quiz not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/quizzes/{id}/attempts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the attempts of the current user at a quiz, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quizzes"
+                ],
+                "summary": "Get my attempts at a quiz",
+                "operationId": "GetQuizAttempts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Quiz ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.QuizAttempt"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Quiz not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Submit answers to a quiz. The answers are scored on the server and the attempt is returned with the correct answers and explanations. A question is only correct when exactly its correct options are picked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quizzes"
+                ],
+                "summary": "Answer a quiz",
+                "operationId": "SubmitQuiz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Quiz ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Answers",
+                        "name": "requestSubmitQuiz",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestSubmitQuiz"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.QuizAttempt"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or answers",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Quiz not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/quizzes/{id}/statistics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get aggregate results of the attempts at a quiz: attempts, participants, average score, and per question the correct rate and how often each option was picked. Collaborators with any role may read them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quizzes"
+                ],
+                "summary": "Get the results of a quiz",
+                "operationId": "GetQuizStatistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Quiz ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.QuizStatistics"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Quiz not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reservations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the reservations of the current user, newest first, with their time slots. Confirmed reservations carry their booking token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Get my reservations",
+                "operationId": "GetReservations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResponseReservation"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reservations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a reservation of the current user with its time slot and booking token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Get reservation by ID",
+                "operationId": "GetReservationByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseReservation"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Reservation not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel a confirmed reservation and give its seats back to the time slot. Users cancel their own reservations; the owner and editors of the exhibition may cancel any.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Cancel reservation by ID",
+                "operationId": "CancelReservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseReservation"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Reservation not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Reservation is cancelled or checked in",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reservations/{id}/qr": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the booking token of a confirmed reservation of the current user as a PNG QR code, to be scanned at check-in",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Get the QR code of a reservation",
+                "operationId": "GetReservationQRCode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "QR code",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Authorization token is required",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Reservation not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Reservation is cancelled or checked in",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rooms": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new exhibitionRoom data. The titles and texts of the items are screened like those of sections.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rooms"
+                ],
+                "summary": "Create a new exhibitionRoom",
+                "operationId": "CreateExhibitionRoom",
+                "parameters": [
+                    {
+                        "description": "ExhibitionRoom data to create",
+                        "name": "requestExhibitionRoom",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCreateExhibitionRoom"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibitionRoom"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Content blocked by screening, or artwork from another catalogue",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Invalid request body"
+                    }
+                }
+            }
+        },
+        "/api/rooms/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a list of all exhibition Rooms data",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rooms"
+                ],
+                "summary": "Get all exhibitions Rooms",
+                "operationId": "GetAllExhibitionRooms",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResponseExhibitionRoom"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rooms/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get exhibition data by RoomID. Rooms are only shown to those who may see their exhibition, without media under embargo.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rooms"
+                ],
+                "summary": "Get exhibitionRoom by ID",
+                "operationId": "GetExhibitionRoomByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibitionRoom"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update exhibitionRoom data by RoomID. The texts are screened as when the room is created.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rooms"
+                ],
+                "summary": "Update exhibitionRoom by RoomID",
+                "operationId": "UpdateExhibitionRoom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ExhibitionRoom ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ExhibitionRoom data to update",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestUpdateExhibitionRoom"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibition"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, or another exhibitionId than the current one",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Content blocked by screening, or artwork from another catalogue",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete Room data by RoomID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rooms"
+                ],
+                "summary": "Delete Room by ID",
+                "operationId": "DeleteExhibitionRoomByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete Room Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/screening/rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the screening rules, enabled or not, in the order they were created.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Screening"
+                ],
+                "summary": "Get screening rules",
+                "operationId": "GetScreeningRules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ScreeningRule"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a rule the texts of exhibitions, sections and rooms are screened against when they are created or updated. A words rule matches any of its words, ignoring case, on their own in English or anywhere in Thai text. A pattern rule matches a regular expression in RE2 syntax against the lower-cased text. Texts matching a flag rule hold the exhibition for review in the moderation queue, and texts matching a block rule are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Screening"
+                ],
+                "summary": "Create screening rule",
+                "operationId": "CreateScreeningRule",
+                "parameters": [
+                    {
+                        "description": "Screening rule",
+                        "name": "requestScreeningRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestScreeningRule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ScreeningRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or pattern",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/screening/rules/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the words or pattern, severity and state of a screening rule. Content already written is not screened again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Screening"
+                ],
+                "summary": "Update screening rule by ID",
+                "operationId": "UpdateScreeningRule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Screening rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Screening rule",
+                        "name": "requestScreeningRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestScreeningRule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ScreeningRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or pattern",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Screening rule not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a screening rule. Exhibitions it held for review stay in the moderation queue.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Screening"
+                ],
+                "summary": "Delete screening rule by ID",
+                "operationId": "DeleteScreeningRule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Screening rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete Screening Rule Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Screening rule not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sections": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new exhibitionSection data. Texts matching a screening rule hold the exhibition for review, or are rejected when the rule blocks them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sections"
+                ],
+                "summary": "Create a new exhibitionSection",
+                "operationId": "CreateExhibitionSection",
+                "parameters": [
+                    {
+                        "description": "ExhibitionSection data to create",
+                        "name": "requestExhibitionSection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCreateExhibitionSection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionSectionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Content blocked by screening, or artwork from another catalogue",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Invalid request body"
+                    }
+                }
+            }
+        },
+        "/api/sections/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a list of all exhibition sections data",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sections"
+                ],
+                "summary": "Get all exhibitions sections",
+                "operationId": "GetAllExhibitionSections",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResponseExhibitionSection"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sections/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get exhibition data by sectionID. Sections are only shown to those who may see their exhibition, without media under embargo.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sections"
+                ],
+                "summary": "Get exhibitionSection by ID",
+                "operationId": "GetExhibitionSectionByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibition Section ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibitionSection"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update exhibitionSection data by sectionID. The texts are screened as when the section is created.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sections"
+                ],
+                "summary": "Update exhibitionSection by sectionID",
+                "operationId": "UpdateExhibitionSection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ExhibitionSection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ExhibitionSection data to update",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestUpdateExhibitionSection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibition"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, or another exhibitionID than the current one",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Content blocked by screening, or artwork from another catalogue",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete Section data by sectionID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sections"
+                ],
+                "summary": "Delete Section by ID",
+                "operationId": "DeleteExhibitionSectionByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Section ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete Section Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sitemap.xml": {
+            "get": {
+                "description": "Get a sitemap index listing one sitemap per page of published exhibitions",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Publishing"
+                ],
+                "summary": "Get the sitemap index",
+                "operationId": "GetSitemapIndex",
+                "responses": {
+                    "200": {
+                        "description": "Sitemap index",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sitemaps/{page}": {
+            "get": {
+                "description": "Get a sitemap of the pages of published exhibitions",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Publishing"
+                ],
+                "summary": "Get a sitemap page",
+                "operationId": "GetSitemap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page number followed by .xml, starting at 1.xml",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sitemap",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Sitemap not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/templates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the system templates and the templates saved by the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Get templates",
+                "operationId": "GetTemplates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ExhibitionTemplate"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Save an exhibition structure as a template. Content is replaced with placeholders unless keepContent is set. Only admins can create system templates.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Save an exhibition as a template",
+                "operationId": "CreateTemplate",
+                "parameters": [
+                    {
+                        "description": "Template data",
+                        "name": "requestTemplate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCreateTemplate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a template with its sections and rooms",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Get template by ID",
+                "operationId": "GetTemplateByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ExhibitionTemplate"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a template. Users can delete their own templates, admins any template.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Delete template by ID",
+                "operationId": "DeleteTemplate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete Template Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/templates/{id}/instantiate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new private exhibition owned by the current user from a template. Its texts are screened as for a new exhibition: an exhibition with flagged texts is held for review and blocked texts are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Create an exhibition from a template",
+                "operationId": "InstantiateTemplate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New exhibition data",
+                        "name": "requestInstantiate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestInstantiateTemplate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Content blocked by screening",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/time-slots/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the window or capacity of a time slot. The capacity cannot drop below the seats already booked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Update time slot by ID",
+                "operationId": "UpdateTimeSlot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Time slot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot data",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestTimeSlot"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.TimeSlot"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or window",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Time slot not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Capacity is below the booked seats",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a time slot nobody has booked. Booked slots must have their reservations cancelled first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Delete time slot by ID",
+                "operationId": "DeleteTimeSlot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Time slot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete time slot success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Time slot not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Time slot has reservations",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/timeline-entries": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a dated entry to a timelineLayout exhibition. Dates are YYYY, YYYY-MM or YYYY-MM-DD, with negative years before the common era, and an entry may not end before it starts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Timeline"
+                ],
+                "summary": "Create a timeline entry",
+                "operationId": "CreateTimelineEntry",
+                "parameters": [
+                    {
+                        "description": "Timeline entry data to create",
+                        "name": "requestTimelineEntry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestCreateTimelineEntry"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or dates",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Exhibition does not use the timeline layout",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/timeline-entries/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a timeline entry with all its translations for editing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Timeline"
+                ],
+                "summary": "Get timeline entry by ID",
+                "operationId": "GetTimelineEntryByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Timeline entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.TimelineEntry"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Timeline entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the dates and content of a timeline entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Timeline"
+                ],
+                "summary": "Update timeline entry by ID",
+                "operationId": "UpdateTimelineEntry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Timeline entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Timeline entry data",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestUpdateTimelineEntry"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.TimelineEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or dates",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Timeline entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove an entry from the timeline of its exhibition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Timeline"
+                ],
+                "summary": "Delete timeline entry by ID",
+                "operationId": "DeleteTimelineEntry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Timeline entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete timeline entry success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Timeline entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tours": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a guided tour to an exhibition. Every stop references either a section or an item on a wall of a room, by its position on the wall.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tours"
+                ],
+                "summary": "Create a tour",
+                "operationId": "CreateTour",
+                "parameters": [
+                    {
+                        "description": "Tour data to create",
+                        "name": "requestTour",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestTour"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or stops",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Exhibition not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tours/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a tour with all its stops and translations for editing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tours"
+                ],
+                "summary": "Get tour by ID",
+                "operationId": "GetTourByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Tour"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Tour not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the name, stops and translations of a tour. The exhibition of a tour cannot change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tours"
+                ],
+                "summary": "Update tour by ID",
+                "operationId": "UpdateTour",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tour data",
+                        "name": "updateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestTour"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Tour"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or stops",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Tour not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a tour from its exhibition",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tours"
+                ],
+                "summary": "Delete tour by ID",
+                "operationId": "DeleteTour",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete tour success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Tour not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tours/{id}/playback": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a tour with the narration, audio and referenced section or room item of every stop in the negotiated locale. Stops whose section or item was removed are left out. The tour is visible to the same users as its exhibition.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tours"
+                ],
+                "summary": "Get a tour for playback",
+                "operationId": "GetTourPlayback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "share",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "X-Share-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link password",
+                        "name": "X-Share-Password",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales, before those of the Accept-Language header",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseTour"
+                        }
+                    },
+                    "404": {
+                        "description": "Tour not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tours/{id}/stops/{index}/audio": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload the audio recording of a stop, or of its translation when lang is given. MP3, M4A, AAC, Ogg, WAV, WebM and FLAC recordings of up to 50 MB are accepted. The duration of the stop is set with PUT /api/tours/{id}.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tours"
+                ],
+                "summary": "Upload the narration of a tour stop",
+                "operationId": "UploadTourStopAudio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Position of the stop in the tour, starting at 0",
+                        "name": "index",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale of the translated narration",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Audio recording",
+                        "name": "audio",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Media reference of the recording",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Missing file or unknown stop",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Tour not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "413": {
+                        "description": "Recording too large",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported audio format",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "503": {
+                        "description": "No media storage configured",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook-deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the deliveries of webhooks, most recent first and without their attempts. Filter on status=dead for the dead-letter list of deliveries that gave up retrying. Deliveries are kept for 30 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "operationId": "GetWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "delivered",
+                            "dead"
+                        ],
+                        "type": "string",
+                        "description": "Delivery status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of deliveries, 50 by default and at most 200",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID, status or limit",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook-deliveries/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a delivery with the log of its latest attempts: when they were made, the status and start of the response or the error, and how long they took.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook delivery by ID",
+                "operationId": "GetWebhookDeliveryByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookDelivery"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook-deliveries/{id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send a delivery again right away, whatever its status, with a fresh series of retries. Dead deliveries leave the dead-letter list. The payload keeps its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver a webhook delivery",
+                "operationId": "RedeliverWebhookDelivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookDelivery"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the registered webhooks in the order they were registered. Secrets are not returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhooks",
+                "operationId": "GetWebhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Webhook"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Register an endpoint of another service to receive events, all of them or the types listed in events. Every event is posted as a model.WebhookPayload with the X-AtomMuse-Event and X-AtomMuse-Delivery headers and an X-AtomMuse-Signature header of the form t=\u003cunix seconds\u003e,v1=\u003chex HMAC-SHA256 of \"\u003ct\u003e.\u003cbody\u003e\" keyed with the secret\u003e. The secret is only returned in this response. Deliveries that do not get a 2xx response within 10 seconds are retried after 30 seconds, doubling the wait up to 6 hours, and are dead after 10 failures. Receivers should deduplicate on the payload ID, which stays the same across retries.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Register a webhook",
+                "operationId": "CreateWebhook",
+                "parameters": [
+                    {
+                        "description": "Webhook data",
+                        "name": "requestWebhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseCreateWebhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a registered webhook. The secret is not returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook by ID",
+                "operationId": "GetWebhookByID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the URL, description and event types of a webhook, and pause or resume it with active. The secret stays the same. Pending deliveries of a paused webhook become dead and can be redelivered once it is active again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update webhook by ID",
+                "operationId": "UpdateWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook data",
+                        "name": "requestWebhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RequestWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a webhook together with its deliveries.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete webhook by ID",
+                "operationId": "DeleteWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete Webhook Success",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseGetExhibitionId"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/{userId}/exhibitions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the exhibitions a user owns or collaborates on. Other users than the user and admins only get the published ones.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exhibitions"
+                ],
+                "summary": "Get exhibition by UserID",
+                "operationId": "GetExhibitionByUserID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResponseExhibition"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.APIError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "helper.APIError": {
+            "type": "object",
+            "properties": {
+                "errorMessage": {
+                    "type": "string"
+                }
+            }
+        },
+        "iiif.Annotation": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "$ref": "#/definitions/iiif.Resource"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "motivation": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "iiif.AnnotationPage": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/iiif.Annotation"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "iiif.Canvas": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/iiif.AnnotationPage"
+                    }
+                },
+                "label": {
+                    "$ref": "#/definitions/iiif.LanguageMap"
+                },
+                "requiredStatement": {
+                    "$ref": "#/definitions/iiif.MetadataItem"
+                },
+                "rights": {
+                    "type": "string"
+                },
+                "summary": {
+                    "$ref": "#/definitions/iiif.LanguageMap"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "iiif.LanguageMap": {
+            "type": "object",
+            "additionalProperties": {
+                "type": "array",
+                "items": {
+                    "type": "string"
+                }
+            }
+        },
+        "iiif.Manifest": {
+            "type": "object",
+            "properties": {
+                "@context": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/iiif.Canvas"
+                    }
+                },
+                "label": {
+                    "$ref": "#/definitions/iiif.LanguageMap"
+                },
+                "metadata": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/iiif.MetadataItem"
+                    }
+                },
+                "structures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/iiif.Range"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/iiif.LanguageMap"
+                },
+                "thumbnail": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/iiif.Resource"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "iiif.MetadataItem": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "$ref": "#/definitions/iiif.LanguageMap"
+                },
+                "value": {
+                    "$ref": "#/definitions/iiif.LanguageMap"
+                }
+            }
+        },
+        "iiif.Range": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/iiif.RangeMember"
+                    }
+                },
+                "label": {
+                    "$ref": "#/definitions/iiif.LanguageMap"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "iiif.RangeMember": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "iiif.Resource": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Appeal": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "actionId": {
+                    "type": "string"
+                },
+                "appellantId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "decidedAt": {
+                    "type": "string"
+                },
+                "decidedBy": {
+                    "type": "string"
+                },
+                "decision": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "granted",
+                        "rejected"
+                    ]
+                }
+            }
+        },
+        "model.Artwork": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "accessionNumber": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "creator": {
+                    "type": "string"
+                },
+                "creditLine": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dimensions": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "license": {
+                    "type": "string"
+                },
+                "medium": {
+                    "type": "string"
+                },
+                "ownerId": {
+                    "type": "string"
+                },
+                "rights": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "action": {
+                    "description": "Action is the name of the handler of the request, such as BanExhibition.",
+                    "type": "string"
+                },
+                "actorId": {
+                    "type": "string"
+                },
+                "actorUsername": {
+                    "type": "string"
+                },
+                "afterHash": {
+                    "type": "string"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "beforeHash": {
+                    "description": "BeforeHash and AfterHash are SHA-256 hashes of the target document, empty when it did not\nexist.",
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "requestId": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "route": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "targetId": {
+                    "type": "string"
+                },
+                "targetType": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CenterItem": {
+            "type": "object",
+            "properties": {
+                "artwork": {
+                    "$ref": "#/definitions/model.Artwork"
+                },
+                "artworkId": {
+                    "type": "string"
+                },
+                "details": {
+                    "$ref": "#/definitions/model.Details"
+                },
+                "previewType": {
+                    "type": "string"
+                },
+                "src": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Collaborator": {
+            "type": "object",
+            "properties": {
+                "acceptedAt": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "invitedAt": {
+                    "type": "string"
+                },
+                "invitedBy": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "profile": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Contents": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeliveryAttempt": {
+            "type": "object",
+            "properties": {
+                "attemptedAt": {
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "Duration is how long the request took, in milliseconds.",
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "response": {
+                    "type": "string"
+                },
+                "statusCode": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Details": {
+            "type": "object",
+            "properties": {
+                "contents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Contents"
+                    }
+                },
+                "img": {
+                    "type": "string"
+                },
+                "translations": {
+                    "description": "Translations holds the contents in other locales than the default locale of the exhibition.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/model.Contents"
+                        }
+                    }
+                }
+            }
+        },
+        "model.Event": {
+            "type": "object",
+            "properties": {
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "exhibitionName": {
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "Fields lists the top-level fields an update changed.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "description": "ID identifies the event. Events of streams are identified by their position in the\nchange history, which streams resume after.",
+                    "type": "string"
+                },
+                "likeCount": {
+                    "type": "integer"
+                },
+                "moderation": {
+                    "description": "Moderation is the action a moderator took when the event results from one, whatever\nits type: bans are banned events and unpublishing is an unpublished event.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ModerationOutcome"
+                        }
+                    ]
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "ownerId": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "sectionId": {
+                    "type": "string"
+                },
+                "sequence": {
+                    "description": "Sequence numbers the events of an exhibition published to the event bus from 1 on, so\nconsumers can order them and drop the ones delivered again. Streams leave it out.",
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "exhibition.created",
+                        "exhibition.updated",
+                        "exhibition.published",
+                        "exhibition.unpublished",
+                        "exhibition.banned",
+                        "exhibition.moderated",
+                        "exhibition.deleted",
+                        "exhibition.likesChanged",
+                        "section.changed",
+                        "room.changed"
+                    ]
+                }
+            }
+        },
+        "model.ExhibitionSection": {
+            "type": "object",
+            "required": [
+                "exhibitionId",
+                "sectionType"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "background": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "leftCol": {
+                    "$ref": "#/definitions/model.LeftColumn"
+                },
+                "rightCol": {
+                    "$ref": "#/definitions/model.RightColumn"
+                },
+                "sectionType": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.ExhibitionTemplate": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "exhibitionCategories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "layoutUsed": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ownerId": {
+                    "type": "string"
+                },
+                "pointsOfInterest": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PointOfInterest"
+                    }
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Room"
+                    }
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ExhibitionSection"
+                    }
+                },
+                "thumbnailImg": {
+                    "type": "string"
+                },
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TimelineEntry"
+                    }
+                }
+            }
+        },
+        "model.ExhibitionTranslation": {
+            "type": "object",
+            "properties": {
+                "exhibitionDescription": {
+                    "type": "string"
+                },
+                "exhibitionName": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GeoPoint": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    },
+                    "example": [
+                        100.493,
+                        13.75
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Point"
+                }
+            }
+        },
+        "model.ImportConflict": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "ref": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LeftColumn": {
+            "type": "object",
+            "properties": {
+                "artwork": {
+                    "$ref": "#/definitions/model.Artwork"
+                },
+                "artworkId": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imageDescription": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LeftRightItem": {
+            "type": "object",
+            "properties": {
+                "artwork": {
+                    "$ref": "#/definitions/model.Artwork"
+                },
+                "artworkId": {
+                    "type": "string"
+                },
+                "details": {
+                    "$ref": "#/definitions/model.Details"
+                },
+                "previewType": {
+                    "type": "string"
+                },
+                "src": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LocaleCompleteness": {
+            "type": "object",
+            "properties": {
+                "complete": {
+                    "type": "boolean"
+                },
+                "default": {
+                    "type": "boolean"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "translated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.MediaRights": {
+            "type": "object",
+            "required": [
+                "license",
+                "ref"
+            ],
+            "properties": {
+                "attribution": {
+                    "type": "string"
+                },
+                "credit": {
+                    "description": "Credit is the credit line shown with the media. It is derived when the exhibition is loaded.",
+                    "type": "string"
+                },
+                "embargoUntil": {
+                    "type": "string"
+                },
+                "license": {
+                    "type": "string"
+                },
+                "ref": {
+                    "type": "string"
+                },
+                "rightsHolder": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ModerationAction": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "dismiss",
+                        "warn",
+                        "unpublish",
+                        "ban",
+                        "unban",
+                        "approve"
+                    ]
+                },
+                "appealId": {
+                    "description": "AppealID is the appeal the action was taken on, if any.",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "moderatorId": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "reports": {
+                    "description": "Reports counts the open reports the action resolved.",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ModerationCase": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ReportCount"
+                    }
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "exhibitionName": {
+                    "type": "string"
+                },
+                "firstReportedAt": {
+                    "type": "string"
+                },
+                "isPublic": {
+                    "type": "boolean"
+                },
+                "lastReportedAt": {
+                    "type": "string"
+                },
+                "moderation": {
+                    "description": "Moderation is the last action taken on the exhibition, if any.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ModerationOutcome"
+                        }
+                    ]
+                },
+                "openReports": {
+                    "type": "integer"
+                },
+                "ownerId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ModerationHistory": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ModerationAction"
+                    }
+                },
+                "appeals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Appeal"
+                    }
+                }
+            }
+        },
+        "model.ModerationOutcome": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actionId": {
+                    "type": "string"
+                },
+                "moderatedAt": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Occupancy": {
+            "type": "object",
+            "properties": {
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.RoomOccupancy"
+                    }
+                },
+                "visitors": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.PointOfInterest": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/model.GeoPoint"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.Position": {
+            "type": "object",
+            "properties": {
+                "x": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                },
+                "yaw": {
+                    "type": "number"
+                },
+                "z": {
+                    "type": "number"
+                }
+            }
+        },
+        "model.PresenceEvent": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "sentAt": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "welcome",
+                        "room",
+                        "joined",
+                        "moved",
+                        "left",
+                        "chat",
+                        "error"
+                    ]
+                },
+                "visitor": {
+                    "$ref": "#/definitions/model.Visitor"
+                },
+                "visitors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Visitor"
+                    }
+                }
+            }
+        },
+        "model.ProfileSync": {
+            "type": "object",
+            "properties": {
+                "collaborations": {
+                    "type": "integer"
+                },
+                "exhibitions": {
+                    "type": "integer"
+                },
+                "stale": {
+                    "description": "Stale is true when the change was older than the stored profile, which was applied\ninstead.",
+                    "type": "boolean"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.QuestionResult": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "correct": {
+                    "type": "boolean"
+                },
+                "explanation": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "question": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.QuestionStatistics": {
+            "type": "object",
+            "properties": {
+                "answered": {
+                    "type": "integer"
+                },
+                "correct": {
+                    "type": "integer"
+                },
+                "correctRate": {
+                    "type": "number"
+                },
+                "optionCounts": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "prompt": {
+                    "type": "string"
+                },
+                "question": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Quiz": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.QuizQuestion"
+                    }
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "sectionId": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.QuizAnswer": {
+            "type": "object",
+            "properties": {
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "question": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.QuizAttempt": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.QuizAnswer"
+                    }
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "maxScore": {
+                    "type": "integer"
+                },
+                "quizId": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.QuestionResult"
+                    }
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "submittedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.QuizOption": {
+            "type": "object",
+            "properties": {
+                "image": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.QuizQuestion": {
+            "type": "object",
+            "properties": {
+                "correct": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "explanation": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.QuizOption"
+                    }
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "prompt": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "multipleChoice",
+                        "trueFalse",
+                        "imagePick"
+                    ]
+                }
+            }
+        },
+        "model.QuizStatistics": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "averageScore": {
+                    "type": "number"
+                },
+                "maxScore": {
+                    "type": "integer"
+                },
+                "participants": {
+                    "type": "integer"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.QuestionStatistics"
+                    }
+                },
+                "quizId": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Report": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "actionId": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string",
+                    "enum": [
+                        "spam",
+                        "offensive",
+                        "harassment",
+                        "copyright",
+                        "misleading",
+                        "other",
+                        "screening"
+                    ]
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "matches": {
+                    "description": "Matches are the rules the content of the exhibition matched, in screening reports.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ScreeningMatch"
+                    }
+                },
+                "reporterId": {
+                    "type": "string"
+                },
+                "resolvedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "resolved"
+                    ]
+                }
+            }
+        },
+        "model.ReportCount": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RequestAppeal": {
+            "type": "object",
+            "required": [
+                "actionId",
+                "message"
+            ],
+            "properties": {
+                "actionId": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "model.RequestAppealDecision": {
+            "type": "object",
+            "required": [
+                "reason",
+                "status"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "granted",
+                        "rejected"
+                    ]
+                }
+            }
+        },
+        "model.RequestArtwork": {
+            "type": "object",
+            "required": [
+                "images",
+                "title"
+            ],
+            "properties": {
+                "accessionNumber": {
+                    "type": "string"
+                },
+                "creator": {
+                    "type": "string"
+                },
+                "creditLine": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dimensions": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "license": {
+                    "type": "string"
+                },
+                "medium": {
+                    "type": "string"
+                },
+                "rights": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestBanExhibition": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "model.RequestCheckIn": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestCloneExhibition": {
+            "type": "object",
+            "properties": {
+                "exhibitionName": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestCreateExhibition": {
+            "type": "object",
+            "required": [
+                "endDate",
+                "exhibitionCategories",
+                "exhibitionDescription",
+                "exhibitionName",
+                "isPublic",
+                "layoutUsed",
+                "startDate",
+                "status",
+                "thumbnailImg",
+                "userId"
+            ],
+            "properties": {
+                "defaultLocale": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "exhibitionCategories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionDescription": {
+                    "type": "string"
+                },
+                "exhibitionName": {
+                    "type": "string"
+                },
+                "exhibitionSectionsID": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "isLike": {
+                    "type": "boolean"
+                },
+                "isPublic": {
+                    "type": "boolean"
+                },
+                "layoutUsed": {
+                    "type": "string"
+                },
+                "likeCount": {
+                    "type": "integer"
+                },
+                "likeList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Room"
+                    }
+                },
+                "roomsID": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "thumbnailImg": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.ExhibitionTranslation"
+                    }
+                },
+                "userId": {
+                    "$ref": "#/definitions/model.UserID"
+                },
+                "venue": {
+                    "$ref": "#/definitions/model.Venue"
+                },
+                "visitedNumber": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RequestCreateExhibitionRoom": {
+            "type": "object",
+            "required": [
+                "exhibitionId"
+            ],
+            "properties": {
+                "center": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CenterItem"
+                    }
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "left": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LeftRightItem"
+                    }
+                },
+                "mapThumbnail": {
+                    "type": "string"
+                },
+                "right": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LeftRightItem"
+                    }
+                }
+            }
+        },
+        "model.RequestCreateExhibitionSection": {
+            "type": "object",
+            "required": [
+                "exhibitionID",
+                "sectionType"
+            ],
+            "properties": {
+                "background": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "exhibitionID": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "leftCol": {
+                    "$ref": "#/definitions/model.LeftColumn"
+                },
+                "rightCol": {
+                    "$ref": "#/definitions/model.RightColumn"
+                },
+                "sectionType": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.RequestCreatePointOfInterest": {
+            "type": "object",
+            "required": [
+                "exhibitionId",
+                "media",
+                "title"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/model.GeoPoint"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.RequestCreateReservation": {
+            "type": "object",
+            "required": [
+                "slotId"
+            ],
+            "properties": {
+                "seats": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1
+                },
+                "slotId": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestCreateShareLink": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 4
+                }
+            }
+        },
+        "model.RequestCreateTemplate": {
+            "type": "object",
+            "required": [
+                "exhibitionId",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "keepContent": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string",
+                    "enum": [
+                        "system",
+                        "user"
+                    ]
+                }
+            }
+        },
+        "model.RequestCreateTimelineEntry": {
+            "type": "object",
+            "required": [
+                "exhibitionId",
+                "media",
+                "startDate",
+                "title"
+            ],
+            "properties": {
+                "endDate": {
+                    "type": "string"
+                },
+                "era": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.RequestInstantiateTemplate": {
+            "type": "object",
+            "required": [
+                "exhibitionName"
+            ],
+            "properties": {
+                "endDate": {
+                    "type": "string"
+                },
+                "exhibitionDescription": {
+                    "type": "string"
+                },
+                "exhibitionName": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestInviteCollaborator": {
+            "type": "object",
+            "required": [
+                "role",
+                "userId"
+            ],
+            "properties": {
+                "firstName": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "profile": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "editor",
+                        "viewer"
+                    ]
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestMediaRights": {
+            "type": "object",
+            "properties": {
+                "mediaRights": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MediaRights"
+                    }
+                }
+            }
+        },
+        "model.RequestModerationAction": {
+            "type": "object",
+            "required": [
+                "action",
+                "reason"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "dismiss",
+                        "warn",
+                        "unpublish",
+                        "ban",
+                        "unban",
+                        "approve"
+                    ]
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "model.RequestQuiz": {
+            "type": "object",
+            "required": [
+                "exhibitionId",
+                "questions",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "questions": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/model.QuizQuestion"
+                    }
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "sectionId": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestReport": {
+            "type": "object",
+            "required": [
+                "category"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "enum": [
+                        "spam",
+                        "offensive",
+                        "harassment",
+                        "copyright",
+                        "misleading",
+                        "other"
+                    ]
+                },
+                "details": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "model.RequestScreeningRule": {
+            "type": "object",
+            "required": [
+                "enabled",
+                "name",
+                "severity",
+                "type",
+                "words"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "pattern": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "severity": {
+                    "type": "string",
+                    "enum": [
+                        "flag",
+                        "block"
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "words",
+                        "pattern"
+                    ]
+                },
+                "words": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.RequestSubmitQuiz": {
+            "type": "object",
+            "required": [
+                "answers"
+            ],
+            "properties": {
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.QuizAnswer"
+                    }
+                }
+            }
+        },
+        "model.RequestTimeSlot": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "endsAt",
+                "startsAt"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestTour": {
+            "type": "object",
+            "required": [
+                "exhibitionId",
+                "name",
+                "stops"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stops": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/model.TourStop"
+                    }
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.TourTranslation"
+                    }
+                }
+            }
+        },
+        "model.RequestTransferOwnership": {
+            "type": "object",
+            "required": [
+                "userId"
+            ],
+            "properties": {
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RequestUpdateCollaboratorRole": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "editor",
+                        "viewer"
+                    ]
+                }
+            }
+        },
+        "model.RequestUpdateExhibition": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "defaultLocale": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "exhibitionCategories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionDescription": {
+                    "type": "string"
+                },
+                "exhibitionName": {
+                    "type": "string"
+                },
+                "exhibitionSectionsID": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "isLike": {
+                    "type": "boolean"
+                },
+                "isPublic": {
+                    "type": "boolean"
+                },
+                "layoutUsed": {
+                    "type": "string"
+                },
+                "likeList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Room"
+                    }
+                },
+                "roomsID": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "thumbnailImg": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.ExhibitionTranslation"
+                    }
+                },
+                "userId": {
+                    "$ref": "#/definitions/model.UserID"
+                },
+                "venue": {
+                    "$ref": "#/definitions/model.Venue"
+                },
+                "visitedNumber": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RequestUpdateExhibitionRoom": {
+            "type": "object",
+            "required": [
+                "exhibitionId"
+            ],
+            "properties": {
+                "center": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CenterItem"
+                    }
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "left": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LeftRightItem"
+                    }
+                },
+                "mapThumbnail": {
+                    "type": "string"
+                },
+                "right": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LeftRightItem"
+                    }
+                }
+            }
+        },
+        "model.RequestUpdateExhibitionSection": {
+            "type": "object",
+            "required": [
+                "exhibitionID",
+                "sectionType"
+            ],
+            "properties": {
+                "background": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "exhibitionID": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "leftCol": {
+                    "$ref": "#/definitions/model.LeftColumn"
+                },
+                "rightCol": {
+                    "$ref": "#/definitions/model.RightColumn"
+                },
+                "sectionType": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.RequestUpdatePointOfInterest": {
+            "type": "object",
+            "required": [
+                "media",
+                "title"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/model.GeoPoint"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.RequestUpdateTimelineEntry": {
+            "type": "object",
+            "required": [
+                "media",
+                "startDate",
+                "title"
+            ],
+            "properties": {
+                "endDate": {
+                    "type": "string"
+                },
+                "era": {
+                    "type": "string"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.RequestWebhook": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ResolvedStop": {
+            "type": "object",
+            "properties": {
+                "audio": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "item": {
+                    "$ref": "#/definitions/model.TourItem"
+                },
+                "narration": {
+                    "type": "string"
+                },
+                "section": {
+                    "$ref": "#/definitions/model.ExhibitionSection"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ResponseCreateShareLink": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "hasPassword": {
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "revokedAt": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ResponseCreateWebhook": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "active": {
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "events": {
+                    "description": "Events lists the event types the webhook receives; empty receives every type.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ResponseExhibition": {
+            "type": "object",
+            "required": [
+                "_id",
+                "exhibitionName",
+                "layoutUsed",
+                "status",
+                "userId"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "collaborators": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Collaborator"
+                    }
+                },
+                "defaultLocale": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "exhibitionCategories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionDescription": {
+                    "type": "string"
+                },
+                "exhibitionName": {
+                    "type": "string"
+                },
+                "exhibitionSections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ExhibitionSection"
+                    }
+                },
+                "exhibitionSectionsID": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "isLike": {
+                    "type": "boolean"
+                },
+                "isPublic": {
+                    "type": "boolean"
+                },
+                "layoutUsed": {
+                    "type": "string"
+                },
+                "likeCount": {
+                    "type": "integer"
+                },
+                "likeList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "locale": {
+                    "description": "Locale is the locale the texts of the exhibition are returned in.",
+                    "type": "string"
+                },
+                "mediaRights": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MediaRights"
+                    }
+                },
+                "pointsOfInterest": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PointOfInterest"
+                    }
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Room"
+                    }
+                },
+                "roomsID": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "thumbnailImg": {
+                    "type": "string"
+                },
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TimelineEntry"
+                    }
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.ExhibitionTranslation"
+                    }
+                },
+                "userId": {
+                    "$ref": "#/definitions/model.UserID"
+                },
+                "venue": {
+                    "$ref": "#/definitions/model.Venue"
+                },
+                "visitedNumber": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ResponseExhibitionRoom": {
+            "type": "object",
+            "required": [
+                "exhibitionId"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "center": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CenterItem"
+                    }
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "left": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LeftRightItem"
+                    }
+                },
+                "mapThumbnail": {
+                    "type": "string"
+                },
+                "right": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LeftRightItem"
+                    }
+                }
+            }
+        },
+        "model.ResponseExhibitionSection": {
+            "type": "object",
+            "required": [
+                "exhibitionID",
+                "sectionType"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "background": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "exhibitionID": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "leftCol": {
+                    "$ref": "#/definitions/model.LeftColumn"
+                },
+                "rightCol": {
+                    "$ref": "#/definitions/model.RightColumn"
+                },
+                "sectionType": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
+                }
+            }
+        },
+        "model.ResponseGetExhibitionId": {
+            "type": "object",
+            "required": [
+                "_id"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ResponseGetExhibitionSectionId": {
+            "type": "object",
+            "required": [
+                "_id"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ResponseImportExhibition": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ImportConflict"
+                    }
+                },
+                "dryRun": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ResponseNearbyExhibition": {
+            "type": "object",
+            "required": [
+                "_id",
+                "exhibitionName",
+                "layoutUsed",
+                "status",
+                "userId"
+            ],
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "collaborators": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Collaborator"
+                    }
+                },
+                "defaultLocale": {
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "exhibitionCategories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionDescription": {
+                    "type": "string"
+                },
+                "exhibitionName": {
+                    "type": "string"
+                },
+                "exhibitionSections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ExhibitionSection"
+                    }
+                },
+                "exhibitionSectionsID": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exhibitionTags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "isLike": {
+                    "type": "boolean"
+                },
+                "isPublic": {
+                    "type": "boolean"
+                },
+                "layoutUsed": {
+                    "type": "string"
+                },
+                "likeCount": {
+                    "type": "integer"
+                },
+                "likeList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "locale": {
+                    "description": "Locale is the locale the texts of the exhibition are returned in.",
+                    "type": "string"
+                },
+                "mediaRights": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MediaRights"
+                    }
+                },
+                "pointsOfInterest": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PointOfInterest"
+                    }
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Room"
+                    }
+                },
+                "roomsID": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "thumbnailImg": {
+                    "type": "string"
+                },
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TimelineEntry"
+                    }
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.ExhibitionTranslation"
+                    }
+                },
+                "userId": {
+                    "$ref": "#/definitions/model.UserID"
+                },
+                "venue": {
+                    "$ref": "#/definitions/model.Venue"
+                },
+                "visitedNumber": {
+                    "type": "integer"
                 }
             }
         },
-        "/api/{userId}/exhibitions": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get exhibition data by exhibitionUserID",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Exhibitions"
-                ],
-                "summary": "Get exhibition by UserID",
-                "operationId": "GetExhibitionByUserID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.ResponseExhibition"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/helper.APIError"
-                        }
+        "model.ResponseQuestion": {
+            "type": "object",
+            "properties": {
+                "image": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "multiple": {
+                    "type": "boolean"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.QuizOption"
                     }
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "prompt": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
-        }
-    },
-    "definitions": {
-        "helper.APIError": {
+        },
+        "model.ResponseQuiz": {
             "type": "object",
             "properties": {
-                "errorMessage": {
+                "_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "maxScore": {
+                    "type": "integer"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResponseQuestion"
+                    }
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "sectionId": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "model.CenterItem": {
+        "model.ResponseReservation": {
             "type": "object",
             "properties": {
-                "details": {
-                    "$ref": "#/definitions/model.Details"
+                "_id": {
+                    "type": "string"
                 },
-                "previewType": {
+                "cancelledAt": {
                     "type": "string"
                 },
-                "src": {
+                "checkedInAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "seats": {
+                    "type": "integer"
+                },
+                "slot": {
+                    "$ref": "#/definitions/model.TimeSlot"
+                },
+                "slotId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "confirmed",
+                        "cancelled",
+                        "checkedIn"
+                    ]
+                },
+                "token": {
+                    "type": "string"
+                },
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "model.Contents": {
+        "model.ResponseTimeline": {
             "type": "object",
             "properties": {
-                "text": {
+                "eras": {
                     "type": "array",
                     "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        }
+                        "$ref": "#/definitions/model.TimelineEra"
                     }
                 },
-                "title": {
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "locale": {
                     "type": "string"
                 }
             }
         },
-        "model.Details": {
+        "model.ResponseTour": {
             "type": "object",
             "properties": {
-                "contents": {
+                "_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "Duration is the total length of the narration recordings in seconds.",
+                    "type": "integer"
+                },
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stops": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Contents"
+                        "$ref": "#/definitions/model.ResolvedStop"
+                    }
+                }
+            }
+        },
+        "model.ResponseTranslations": {
+            "type": "object",
+            "properties": {
+                "defaultLocale": {
+                    "type": "string"
+                },
+                "locales": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LocaleCompleteness"
                     }
+                }
+            }
+        },
+        "model.RightColumn": {
+            "type": "object",
+            "properties": {
+                "artwork": {
+                    "$ref": "#/definitions/model.Artwork"
                 },
-                "img": {
+                "artworkId": {
+                    "type": "string"
+                },
+                "contentType": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imageDescription": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "model.ExhibitionSection": {
+        "model.Room": {
             "type": "object",
             "required": [
-                "exhibitionId",
-                "sectionType"
+                "_id",
+                "exhibitionId"
             ],
             "properties": {
                 "_id": {
                     "type": "string"
                 },
-                "background": {
-                    "type": "string"
+                "center": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CenterItem"
+                    }
                 },
-                "contentType": {
+                "exhibitionId": {
                     "type": "string"
                 },
-                "exhibitionId": {
+                "left": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LeftRightItem"
+                    }
+                },
+                "mapThumbnail": {
                     "type": "string"
                 },
-                "images": {
+                "right": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.LeftRightItem"
                     }
+                }
+            }
+        },
+        "model.RoomOccupancy": {
+            "type": "object",
+            "properties": {
+                "roomId": {
+                    "type": "string"
                 },
-                "leftCol": {
-                    "$ref": "#/definitions/model.LeftColumn"
+                "visitors": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ScreeningMatch": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Field names the text that matched, such as exhibitionName or translations.th.text.",
+                    "type": "string"
+                },
+                "match": {
+                    "type": "string"
+                },
+                "rule": {
+                    "type": "string"
+                },
+                "ruleId": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ScreeningRule": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pattern": {
+                    "description": "Pattern is a regular expression in RE2 syntax matched against the lower-cased text.",
+                    "type": "string"
                 },
-                "rightCol": {
-                    "$ref": "#/definitions/model.RightColumn"
+                "severity": {
+                    "type": "string",
+                    "enum": [
+                        "flag",
+                        "block"
+                    ]
                 },
-                "sectionType": {
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "words",
+                        "pattern"
+                    ]
+                },
+                "updatedAt": {
                     "type": "string"
                 },
+                "words": {
+                    "description": "Words match whole words, ignoring case, or anywhere in Thai text, which does not separate\nwords with spaces.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.SectionTranslation": {
+            "type": "object",
+            "properties": {
                 "text": {
                     "type": "string"
                 },
@@ -1113,107 +9939,78 @@ const docTemplate = `{
                 }
             }
         },
-        "model.LeftColumn": {
+        "model.ShareLink": {
             "type": "object",
             "properties": {
-                "contentType": {
+                "_id": {
                     "type": "string"
                 },
-                "image": {
+                "createdAt": {
                     "type": "string"
                 },
-                "imageDescription": {
+                "createdBy": {
                     "type": "string"
                 },
-                "text": {
+                "exhibitionId": {
                     "type": "string"
                 },
-                "title": {
+                "expiresAt": {
                     "type": "string"
-                }
-            }
-        },
-        "model.LeftRightItem": {
-            "type": "object",
-            "properties": {
-                "details": {
-                    "$ref": "#/definitions/model.Details"
                 },
-                "previewType": {
+                "hasPassword": {
+                    "type": "boolean"
+                },
+                "label": {
                     "type": "string"
                 },
-                "src": {
+                "revokedAt": {
                     "type": "string"
                 }
             }
         },
-        "model.RequestCreateExhibition": {
+        "model.TimeSlot": {
             "type": "object",
-            "required": [
-                "endDate",
-                "exhibitionCategories",
-                "exhibitionDescription",
-                "exhibitionName",
-                "isPublic",
-                "layoutUsed",
-                "startDate",
-                "status",
-                "thumbnailImg",
-                "userId"
-            ],
             "properties": {
-                "endDate": {
+                "_id": {
                     "type": "string"
                 },
-                "exhibitionCategories": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "booked": {
+                    "description": "Booked counts the seats of confirmed and checked in reservations.",
+                    "type": "integer"
                 },
-                "exhibitionDescription": {
-                    "type": "string"
+                "capacity": {
+                    "type": "integer"
                 },
-                "exhibitionName": {
+                "endsAt": {
                     "type": "string"
                 },
-                "exhibitionSectionsID": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "exhibitionTags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "isLike": {
-                    "type": "boolean"
+                "exhibitionId": {
+                    "type": "string"
                 },
-                "isPublic": {
-                    "type": "boolean"
+                "remaining": {
+                    "type": "integer"
                 },
-                "layoutUsed": {
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TimelineEntry": {
+            "type": "object",
+            "properties": {
+                "_id": {
                     "type": "string"
                 },
-                "likeCount": {
-                    "type": "integer"
+                "endDate": {
+                    "type": "string"
                 },
-                "likeList": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "era": {
+                    "type": "string"
                 },
-                "rooms": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Room"
-                    }
+                "exhibitionId": {
+                    "type": "string"
                 },
-                "roomsID": {
+                "media": {
                     "type": "array",
                     "items": {
                         "type": "string"
@@ -1222,506 +10019,431 @@ const docTemplate = `{
                 "startDate": {
                     "type": "string"
                 },
-                "status": {
+                "text": {
                     "type": "string"
                 },
-                "thumbnailImg": {
+                "title": {
                     "type": "string"
                 },
-                "userId": {
-                    "$ref": "#/definitions/model.UserID"
-                },
-                "visitedNumber": {
-                    "type": "integer"
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.SectionTranslation"
+                    }
                 }
             }
         },
-        "model.RequestCreateExhibitionRoom": {
+        "model.TimelineEra": {
             "type": "object",
-            "required": [
-                "exhibitionId"
-            ],
             "properties": {
-                "center": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.CenterItem"
-                    }
-                },
-                "exhibitionId": {
+                "endDate": {
                     "type": "string"
                 },
-                "left": {
+                "entries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.LeftRightItem"
+                        "$ref": "#/definitions/model.TimelineEntry"
                     }
                 },
-                "mapThumbnail": {
+                "era": {
                     "type": "string"
                 },
-                "right": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.LeftRightItem"
-                    }
+                "startDate": {
+                    "type": "string"
                 }
             }
         },
-        "model.RequestCreateExhibitionSection": {
+        "model.Tour": {
             "type": "object",
-            "required": [
-                "exhibitionID",
-                "sectionType"
-            ],
             "properties": {
-                "background": {
+                "_id": {
                     "type": "string"
                 },
-                "contentType": {
+                "createdAt": {
                     "type": "string"
                 },
-                "exhibitionID": {
+                "description": {
                     "type": "string"
                 },
-                "images": {
+                "exhibitionId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stops": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.TourStop"
                     }
                 },
-                "leftCol": {
-                    "$ref": "#/definitions/model.LeftColumn"
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.TourTranslation"
+                    }
                 },
-                "rightCol": {
-                    "$ref": "#/definitions/model.RightColumn"
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TourItem": {
+            "type": "object",
+            "properties": {
+                "artwork": {
+                    "$ref": "#/definitions/model.Artwork"
                 },
-                "sectionType": {
+                "details": {
+                    "$ref": "#/definitions/model.Details"
+                },
+                "itemIndex": {
+                    "type": "integer"
+                },
+                "previewType": {
                     "type": "string"
                 },
-                "text": {
+                "roomId": {
                     "type": "string"
                 },
-                "title": {
+                "src": {
+                    "type": "string"
+                },
+                "wall": {
                     "type": "string"
                 }
             }
         },
-        "model.RequestUpdateExhibition": {
+        "model.TourStop": {
             "type": "object",
-            "required": [
-                "status"
-            ],
             "properties": {
-                "endDate": {
+                "audio": {
+                    "description": "Audio is the media reference of the narration recording, Duration its length in seconds.",
                     "type": "string"
                 },
-                "exhibitionCategories": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "duration": {
+                    "type": "integer"
                 },
-                "exhibitionDescription": {
-                    "type": "string"
+                "itemIndex": {
+                    "type": "integer"
                 },
-                "exhibitionName": {
+                "narration": {
                     "type": "string"
                 },
-                "exhibitionSectionsID": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "exhibitionTags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "isLike": {
-                    "type": "boolean"
+                "roomId": {
+                    "type": "string"
                 },
-                "isPublic": {
-                    "type": "boolean"
+                "sectionId": {
+                    "type": "string"
                 },
-                "layoutUsed": {
+                "title": {
                     "type": "string"
                 },
-                "likeList": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.TourStopTranslation"
                     }
                 },
-                "rooms": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Room"
-                    }
+                "wall": {
+                    "type": "string",
+                    "enum": [
+                        "left",
+                        "center",
+                        "right"
+                    ]
+                }
+            }
+        },
+        "model.TourStopTranslation": {
+            "type": "object",
+            "properties": {
+                "audio": {
+                    "type": "string"
                 },
-                "roomsID": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "duration": {
+                    "type": "integer"
                 },
-                "startDate": {
+                "narration": {
                     "type": "string"
                 },
-                "status": {
+                "title": {
                     "type": "string"
-                },
-                "thumbnailImg": {
+                }
+            }
+        },
+        "model.TourTranslation": {
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string"
                 },
-                "userId": {
-                    "$ref": "#/definitions/model.UserID"
-                },
-                "visitedNumber": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "model.RequestUpdateExhibitionRoom": {
+        "model.UserID": {
             "type": "object",
             "required": [
-                "exhibitionId"
+                "userId"
             ],
-            "properties": {
-                "center": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.CenterItem"
-                    }
+            "properties": {
+                "firstName": {
+                    "type": "string"
                 },
-                "exhibitionId": {
+                "lastName": {
                     "type": "string"
                 },
-                "left": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.LeftRightItem"
-                    }
+                "profile": {
+                    "type": "string"
                 },
-                "mapThumbnail": {
+                "userId": {
                     "type": "string"
                 },
-                "right": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.LeftRightItem"
-                    }
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "model.RequestUpdateExhibitionSection": {
+        "model.UserProfile": {
             "type": "object",
             "required": [
-                "exhibitionID",
-                "sectionType"
+                "changedAt",
+                "userId"
             ],
             "properties": {
-                "background": {
+                "changedAt": {
+                    "description": "ChangedAt is when the profile changed in the user service. Changes reported out of\norder are ignored when they are older than the stored profile.",
                     "type": "string"
                 },
-                "contentType": {
+                "firstName": {
                     "type": "string"
                 },
-                "exhibitionID": {
+                "lastName": {
                     "type": "string"
                 },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "leftCol": {
-                    "$ref": "#/definitions/model.LeftColumn"
+                "profile": {
+                    "type": "string"
                 },
-                "rightCol": {
-                    "$ref": "#/definitions/model.RightColumn"
+                "updatedAt": {
+                    "type": "string"
                 },
-                "sectionType": {
+                "userId": {
                     "type": "string"
                 },
-                "text": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Venue": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "title": {
+                "location": {
+                    "$ref": "#/definitions/model.GeoPoint"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "model.ResponseExhibition": {
+        "model.Visitor": {
             "type": "object",
             "required": [
-                "_id",
-                "exhibitionName",
-                "layoutUsed",
-                "status",
                 "userId"
             ],
             "properties": {
-                "_id": {
+                "firstName": {
                     "type": "string"
                 },
-                "endDate": {
+                "lastName": {
                     "type": "string"
                 },
-                "exhibitionCategories": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "position": {
+                    "$ref": "#/definitions/model.Position"
                 },
-                "exhibitionDescription": {
+                "profile": {
                     "type": "string"
                 },
-                "exhibitionName": {
+                "roomId": {
                     "type": "string"
                 },
-                "exhibitionSections": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.ExhibitionSection"
-                    }
-                },
-                "exhibitionSectionsID": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "sessionId": {
+                    "type": "string"
                 },
-                "exhibitionTags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "userId": {
+                    "type": "string"
                 },
-                "isLike": {
-                    "type": "boolean"
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Webhook": {
+            "type": "object",
+            "properties": {
+                "_id": {
+                    "type": "string"
                 },
-                "isPublic": {
+                "active": {
                     "type": "boolean"
                 },
-                "layoutUsed": {
+                "createdAt": {
                     "type": "string"
                 },
-                "likeCount": {
-                    "type": "integer"
-                },
-                "likeList": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "createdBy": {
+                    "type": "string"
                 },
-                "rooms": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Room"
-                    }
+                "description": {
+                    "type": "string"
                 },
-                "roomsID": {
+                "events": {
+                    "description": "Events lists the event types the webhook receives; empty receives every type.",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "startDate": {
-                    "type": "string"
-                },
-                "status": {
+                "updatedAt": {
                     "type": "string"
                 },
-                "thumbnailImg": {
+                "url": {
                     "type": "string"
-                },
-                "userId": {
-                    "$ref": "#/definitions/model.UserID"
-                },
-                "visitedNumber": {
-                    "type": "integer"
                 }
             }
         },
-        "model.ResponseExhibitionRoom": {
+        "model.WebhookDelivery": {
             "type": "object",
-            "required": [
-                "exhibitionId"
-            ],
             "properties": {
                 "_id": {
                     "type": "string"
                 },
-                "center": {
+                "attempts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.CenterItem"
+                        "$ref": "#/definitions/model.DeliveryAttempt"
                     }
                 },
-                "exhibitionId": {
+                "createdAt": {
                     "type": "string"
                 },
-                "left": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.LeftRightItem"
-                    }
+                "deliveredAt": {
+                    "type": "string"
                 },
-                "mapThumbnail": {
+                "event": {
+                    "$ref": "#/definitions/model.Event"
+                },
+                "failures": {
+                    "description": "Failures counts the failed attempts since the delivery was created or redelivered.",
+                    "type": "integer"
+                },
+                "nextAttemptAt": {
                     "type": "string"
                 },
-                "right": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.LeftRightItem"
-                    }
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "delivered",
+                        "dead"
+                    ]
+                },
+                "webhookId": {
+                    "type": "string"
                 }
             }
         },
-        "model.ResponseExhibitionSection": {
+        "seo.Event": {
             "type": "object",
-            "required": [
-                "exhibitionID",
-                "sectionType"
-            ],
             "properties": {
-                "_id": {
+                "@context": {
                     "type": "string"
                 },
-                "background": {
+                "@type": {
                     "type": "string"
                 },
-                "contentType": {
+                "description": {
                     "type": "string"
                 },
-                "exhibitionID": {
+                "endDate": {
                     "type": "string"
                 },
-                "images": {
+                "eventAttendanceMode": {
+                    "type": "string"
+                },
+                "eventStatus": {
+                    "type": "string"
+                },
+                "image": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "leftCol": {
-                    "$ref": "#/definitions/model.LeftColumn"
+                "keywords": {
+                    "type": "string"
                 },
-                "rightCol": {
-                    "$ref": "#/definitions/model.RightColumn"
+                "location": {
+                    "description": "Location holds the VirtualLocation of the page, preceded by the Place of the venue when\nthe exhibition is also shown at one.",
+                    "type": "array",
+                    "items": {}
                 },
-                "sectionType": {
+                "name": {
                     "type": "string"
                 },
-                "text": {
-                    "type": "string"
+                "organizer": {
+                    "$ref": "#/definitions/seo.Person"
                 },
-                "title": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.ResponseGetExhibitionId": {
-            "type": "object",
-            "required": [
-                "_id"
-            ],
-            "properties": {
-                "_id": {
+                "startDate": {
                     "type": "string"
-                }
-            }
-        },
-        "model.ResponseGetExhibitionSectionId": {
-            "type": "object",
-            "required": [
-                "_id"
-            ],
-            "properties": {
-                "_id": {
+                },
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "model.RightColumn": {
+        "seo.MetaTag": {
             "type": "object",
             "properties": {
-                "contentType": {
-                    "type": "string"
-                },
-                "image": {
-                    "type": "string"
-                },
-                "imageDescription": {
+                "content": {
                     "type": "string"
                 },
-                "text": {
+                "name": {
                     "type": "string"
                 },
-                "title": {
+                "property": {
                     "type": "string"
                 }
             }
         },
-        "model.Room": {
+        "seo.Metadata": {
             "type": "object",
-            "required": [
-                "_id",
-                "exhibitionId"
-            ],
             "properties": {
-                "_id": {
-                    "type": "string"
-                },
-                "center": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.CenterItem"
-                    }
-                },
-                "exhibitionId": {
-                    "type": "string"
-                },
-                "left": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.LeftRightItem"
-                    }
-                },
-                "mapThumbnail": {
-                    "type": "string"
+                "jsonLd": {
+                    "$ref": "#/definitions/seo.Event"
                 },
-                "right": {
+                "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.LeftRightItem"
+                        "$ref": "#/definitions/seo.MetaTag"
                     }
                 }
             }
         },
-        "model.UserID": {
+        "seo.Person": {
             "type": "object",
-            "required": [
-                "userId"
-            ],
             "properties": {
-                "firstName": {
-                    "type": "string"
-                },
-                "lastName": {
-                    "type": "string"
-                },
-                "profile": {
+                "@type": {
                     "type": "string"
                 },
-                "userId": {
+                "image": {
                     "type": "string"
                 },
-                "username": {
+                "name": {
                     "type": "string"
                 }
             }
@@ -1746,6 +10468,8 @@ var SwaggerInfo = &swag.Spec{
 	Description:      "Exhibition Service สำหรับขอจัดการเกี่ยวกับ Exhibition ทั้งการสร้าง แก้ไข ลบ exhibition",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
 }
 
 func init() {
@@ -6,20 +6,78 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	_ "atommuse/backend/exhibition-service/cmd/exhibition/doc"
+	"atommuse/backend/exhibition-service/handler/artworkhandler"
+	"atommuse/backend/exhibition-service/handler/audithandler"
+	"atommuse/backend/exhibition-service/handler/bundlehandler"
+	"atommuse/backend/exhibition-service/handler/collabhandler"
+	"atommuse/backend/exhibition-service/handler/eventhandler"
 	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/handler/moderationhandler"
+	"atommuse/backend/exhibition-service/handler/poihandler"
+	"atommuse/backend/exhibition-service/handler/presencehandler"
+	"atommuse/backend/exhibition-service/handler/profilehandler"
+	"atommuse/backend/exhibition-service/handler/publishhandler"
+	"atommuse/backend/exhibition-service/handler/quizhandler"
+	"atommuse/backend/exhibition-service/handler/reservationhandler"
 	"atommuse/backend/exhibition-service/handler/roomhandler"
+	"atommuse/backend/exhibition-service/handler/screeninghandler"
 	"atommuse/backend/exhibition-service/handler/sectionhandler"
+	"atommuse/backend/exhibition-service/handler/sharehandler"
+	"atommuse/backend/exhibition-service/handler/templatehandler"
+	"atommuse/backend/exhibition-service/handler/timelinehandler"
+	"atommuse/backend/exhibition-service/handler/tourhandler"
+	"atommuse/backend/exhibition-service/handler/webhookhandler"
+	"atommuse/backend/exhibition-service/pkg/comment"
+	"atommuse/backend/exhibition-service/pkg/eventbus"
 	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/presence"
+	"atommuse/backend/exhibition-service/pkg/repositorty/artworkrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/auditrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/collabrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/commentrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/eventrepo"
 	"atommuse/backend/exhibition-service/pkg/repositorty/exhibirepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/moderationrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/poirepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/profilerepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/quizrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/reservationrepo"
 	"atommuse/backend/exhibition-service/pkg/repositorty/roomrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/screeningrepo"
 	"atommuse/backend/exhibition-service/pkg/repositorty/sectionrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/sharerepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/templaterepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/timelinerepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/tourrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/webhookrepo"
+	"atommuse/backend/exhibition-service/pkg/reservation"
+	"atommuse/backend/exhibition-service/pkg/service/artworksvc"
+	"atommuse/backend/exhibition-service/pkg/service/auditsvc"
+	"atommuse/backend/exhibition-service/pkg/service/bundlesvc"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/commentsvc"
+	"atommuse/backend/exhibition-service/pkg/service/eventsvc"
 	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/service/moderationsvc"
+	"atommuse/backend/exhibition-service/pkg/service/outboxsvc"
+	"atommuse/backend/exhibition-service/pkg/service/poisvc"
+	"atommuse/backend/exhibition-service/pkg/service/profilesvc"
+	"atommuse/backend/exhibition-service/pkg/service/quizsvc"
+	"atommuse/backend/exhibition-service/pkg/service/reservationsvc"
 	"atommuse/backend/exhibition-service/pkg/service/roomsvc"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
 	"atommuse/backend/exhibition-service/pkg/service/sectionsvc"
+	"atommuse/backend/exhibition-service/pkg/service/sharesvc"
+	"atommuse/backend/exhibition-service/pkg/service/templatesvc"
+	"atommuse/backend/exhibition-service/pkg/service/timelinesvc"
+	"atommuse/backend/exhibition-service/pkg/service/toursvc"
+	"atommuse/backend/exhibition-service/pkg/service/webhooksvc"
 	"atommuse/backend/exhibition-service/pkg/utils"
 
 	"github.com/dgrijalva/jwt-go"
@@ -29,6 +87,7 @@ import (
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -59,8 +118,27 @@ func main() {
 		}
 	}()
 
+	// The outbox records events in the transactions of the writes
+	if err := outboxrepo.CheckTransactions(context.Background(), client); err != nil {
+		log.Fatal("Error checking MongoDB:", err)
+	}
+
 	router := setupRouter(client)
 
+	// Deliver the events to the webhooks of other services in the background
+	go initWebhookDispatcher(client).Run(context.Background())
+
+	// Publish the events recorded in the outbox to the event bus in the background
+	go initOutboxRelay(client).Run(context.Background())
+
+	// Retry the comment cleanups queued while the comment service was down
+	if worker := initCommentCleanupWorker(client); worker != nil {
+		go worker.Run(context.Background())
+	}
+
+	// Repair the copies of user profiles that drifted from the profiles the user service reported
+	go initProfileReconciler(client).Run(context.Background())
+
 	url := ginSwagger.URL("/swagger/doc.json")
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
 
@@ -121,6 +199,17 @@ func initializeEnvironment() {
 	}
 }
 
+// queryTokenMiddleware accepts the bearer token as the access_token query parameter, for
+// WebSocket clients in browsers that cannot set the Authorization header
+func queryTokenMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token := c.Query("access_token"); token != "" && c.GetHeader("Authorization") == "" {
+			c.Request.Header.Set("Authorization", "Bearer "+token)
+		}
+		c.Next()
+	}
+}
+
 // authMiddleware is middleware to validate the token and check the role
 func authMiddleware(role string) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -172,6 +261,7 @@ func authMiddleware(role string) gin.HandlerFunc {
 			c.Set("user_last_name", claims.LastName)
 			c.Set("user_image", claims.ProfileImage)
 			c.Set("user_username", claims.UserName)
+			c.Set("user_role", claims.Role)
 
 			fmt.Println("User ID:", claims.ID)
 
@@ -213,7 +303,8 @@ func setupRouter(client *mongo.Client) *gin.Engine {
 	router.Use(func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*") // Replace "*" with allowed origins
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID")
+		c.Header("Access-Control-Expose-Headers", "X-Request-ID")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(200)
@@ -224,9 +315,31 @@ func setupRouter(client *mongo.Client) *gin.Engine {
 	})
 
 	// Initialize handlers and services
-	exhibitionHandler := initExhibitionHandler(client)
-	sectionHandler := initSectionHandler(client)
-	roomHandler := initRoomHandler(client)
+	collaboratorService := initCollaboratorService(client)
+	shareLinkService := initShareLinkService(client, collaboratorService)
+	artworkService := initArtworkService(client)
+	screeningService := initScreeningService(client)
+	exhibitionHandler := initExhibitionHandler(client, collaboratorService, shareLinkService, artworkService, screeningService)
+	sectionHandler := initSectionHandler(client, collaboratorService, exhibitionHandler.ExhibitionService, artworkService, screeningService)
+	roomHandler := initRoomHandler(client, collaboratorService, exhibitionHandler.ExhibitionService, artworkService, screeningService)
+	collaboratorHandler := &collabhandler.Handler{CollaboratorService: collaboratorService}
+	shareLinkHandler := &sharehandler.Handler{ShareLinkService: shareLinkService, ExhibitionService: exhibitionHandler.ExhibitionService}
+	templateHandler := initTemplateHandler(client, collaboratorService, screeningService, artworkService)
+	bundleHandler := initBundleHandler(client, collaboratorService, screeningService, artworkService)
+	publishHandler := &publishhandler.Handler{ExhibitionService: exhibitionHandler.ExhibitionService}
+	artworkHandler := &artworkhandler.Handler{ArtworkService: artworkService}
+	timelineHandler := initTimelineHandler(client, collaboratorService)
+	pointOfInterestHandler := initPointOfInterestHandler(client, collaboratorService)
+	tourHandler := initTourHandler(client, collaboratorService, exhibitionHandler.ExhibitionService)
+	quizHandler := initQuizHandler(client, collaboratorService, exhibitionHandler.ExhibitionService)
+	reservationHandler := initReservationHandler(client, collaboratorService, exhibitionHandler.ExhibitionService)
+	presenceHandler := &presencehandler.Handler{Registry: presence.NewRegistry(), ExhibitionService: exhibitionHandler.ExhibitionService}
+	eventHandler := initEventHandler(client, collaboratorService)
+	webhookHandler := initWebhookHandler(client)
+	profileHandler := initProfileHandler(client)
+	moderationHandler := initModerationHandler(client, collaboratorService, exhibitionHandler.ExhibitionService)
+	auditHandler := initAuditHandler(client)
+	screeningHandler := &screeninghandler.Handler{ScreeningService: screeningService}
 
 	// Add CORS middleware
 	config := cors.DefaultConfig()
@@ -235,15 +348,20 @@ func setupRouter(client *mongo.Client) *gin.Engine {
 
 	// Group routes
 	api := router.Group("/api")
+	api.Use(auditHandler.Record)
 	{
 		//Exhibitions
 		api.GET("/exhibitions/all", authMiddleware("admin"), exhibitionHandler.GetAllExhibitions)
 		api.GET("/exhibitions/:id", authMiddleware(""), exhibitionHandler.GetExhibitionByID)
 		api.GET("/exhibitions", exhibitionHandler.GetExhibitionsIsPublic)
+		api.GET("/exhibitions/search", exhibitionHandler.SearchExhibitions)
+		api.GET("/exhibitions/nearby", exhibitionHandler.GetNearbyExhibitions)
 		api.GET("/:userId/exhibitions", authMiddleware("exhibitor"), exhibitionHandler.GetExhibitionByUserID)
 		api.POST("/exhibitions", authMiddleware("exhibitor"), exhibitionHandler.CreateExhibition)
 		api.DELETE("/exhibitions/:id", authMiddleware("exhibitor"), exhibitionHandler.DeleteExhibition)
 		api.PUT("/exhibitions/:id", authMiddleware("exhibitor"), exhibitionHandler.UpdateExhibition)
+		api.PUT("/exhibitions/:id/media-rights", authMiddleware("exhibitor"), exhibitionHandler.UpdateMediaRights)
+		api.GET("/exhibitions/:id/translations", authMiddleware("exhibitor"), exhibitionHandler.GetExhibitionTranslations)
 		//ExhibitionSections
 		api.POST("/sections", authMiddleware("exhibitor"), sectionHandler.CreateExhibitionSection)
 		api.DELETE("/sections/:id", authMiddleware("exhibitor"), sectionHandler.DeleteExhibitionSectionByID)
@@ -262,32 +380,394 @@ func setupRouter(client *mongo.Client) *gin.Engine {
 		api.PUT("/exhibitions/:id/like", authMiddleware("exhibitor"), exhibitionHandler.LikeExhibition)
 		api.PUT("/exhibitions/:id/unlike", authMiddleware("exhibitor"), exhibitionHandler.UnlikeExhibition)
 		//ban
-		api.POST("/exhibitions/:id/ban", authMiddleware("admin"), exhibitionHandler.BanExhibition)
+		api.POST("/exhibitions/:id/ban", authMiddleware("admin"), moderationHandler.BanExhibition)
+		//Collaborators
+		api.GET("/exhibitions/:id/collaborators", authMiddleware("exhibitor"), collaboratorHandler.GetCollaborators)
+		api.POST("/exhibitions/:id/collaborators", authMiddleware("exhibitor"), collaboratorHandler.InviteCollaborator)
+		api.POST("/exhibitions/:id/collaborators/accept", authMiddleware("exhibitor"), collaboratorHandler.AcceptInvitation)
+		api.PUT("/exhibitions/:id/collaborators/:userId", authMiddleware("exhibitor"), collaboratorHandler.UpdateCollaboratorRole)
+		api.DELETE("/exhibitions/:id/collaborators/:userId", authMiddleware("exhibitor"), collaboratorHandler.RemoveCollaborator)
+		api.POST("/exhibitions/:id/transfer", authMiddleware("exhibitor"), collaboratorHandler.TransferOwnership)
+		//Share links
+		api.GET("/exhibitions/:id/share-links", authMiddleware("exhibitor"), shareLinkHandler.GetShareLinks)
+		api.POST("/exhibitions/:id/share-links", authMiddleware("exhibitor"), shareLinkHandler.CreateShareLink)
+		api.DELETE("/exhibitions/:id/share-links/:linkId", authMiddleware("exhibitor"), shareLinkHandler.RevokeShareLink)
+		api.GET("/preview/:token", authMiddleware(""), shareLinkHandler.GetSharedExhibition)
+		//Cloning & templates
+		api.POST("/exhibitions/:id/clone", authMiddleware("exhibitor"), templateHandler.CloneExhibition)
+		api.GET("/templates", authMiddleware("exhibitor"), templateHandler.GetTemplates)
+		api.GET("/templates/:id", authMiddleware("exhibitor"), templateHandler.GetTemplateByID)
+		api.POST("/templates", authMiddleware("exhibitor"), templateHandler.CreateTemplate)
+		api.DELETE("/templates/:id", authMiddleware("exhibitor"), templateHandler.DeleteTemplate)
+		api.POST("/templates/:id/instantiate", authMiddleware("exhibitor"), templateHandler.InstantiateTemplate)
+		//Export & import
+		api.GET("/exhibitions/:id/export", authMiddleware("exhibitor"), bundleHandler.ExportExhibition)
+		api.GET("/exhibitions/:id/export/site", authMiddleware("exhibitor"), bundleHandler.ExportExhibitionSite)
+		api.POST("/exhibitions/import", authMiddleware("exhibitor"), bundleHandler.ImportExhibition)
+		//Publishing
+		api.GET("/exhibitions/:id/iiif/manifest", authMiddleware(""), publishHandler.GetIIIFManifest)
+		api.GET("/exhibitions/:id/metadata", publishHandler.GetExhibitionMetadata)
+		api.GET("/sitemap.xml", publishHandler.GetSitemapIndex)
+		api.GET("/sitemaps/:page", publishHandler.GetSitemap)
+		api.GET("/feeds/exhibitions.rss", publishHandler.GetRSSFeed)
+		api.GET("/feeds/exhibitions.atom", publishHandler.GetAtomFeed)
+		api.GET("/feeds/exhibitions.ics", publishHandler.GetCalendarFeed)
+		//Artworks
+		api.GET("/artworks", authMiddleware("exhibitor"), artworkHandler.GetArtworks)
+		api.GET("/artworks/:id", authMiddleware("exhibitor"), artworkHandler.GetArtworkByID)
+		api.POST("/artworks", authMiddleware("exhibitor"), artworkHandler.CreateArtwork)
+		api.PUT("/artworks/:id", authMiddleware("exhibitor"), artworkHandler.UpdateArtwork)
+		api.DELETE("/artworks/:id", authMiddleware("exhibitor"), artworkHandler.DeleteArtwork)
+		//Timeline
+		api.GET("/exhibitions/:id/timeline", authMiddleware(""), exhibitionHandler.GetExhibitionTimeline)
+		api.POST("/timeline-entries", authMiddleware("exhibitor"), timelineHandler.CreateTimelineEntry)
+		api.GET("/timeline-entries/:id", authMiddleware("exhibitor"), timelineHandler.GetTimelineEntryByID)
+		api.PUT("/timeline-entries/:id", authMiddleware("exhibitor"), timelineHandler.UpdateTimelineEntry)
+		api.DELETE("/timeline-entries/:id", authMiddleware("exhibitor"), timelineHandler.DeleteTimelineEntry)
+		//Map
+		api.POST("/points-of-interest", authMiddleware("exhibitor"), pointOfInterestHandler.CreatePointOfInterest)
+		api.GET("/points-of-interest/:id", authMiddleware("exhibitor"), pointOfInterestHandler.GetPointOfInterestByID)
+		api.PUT("/points-of-interest/:id", authMiddleware("exhibitor"), pointOfInterestHandler.UpdatePointOfInterest)
+		api.DELETE("/points-of-interest/:id", authMiddleware("exhibitor"), pointOfInterestHandler.DeletePointOfInterest)
+		//Tours
+		api.GET("/exhibitions/:id/tours", authMiddleware(""), tourHandler.GetExhibitionTours)
+		api.GET("/tours/:id/playback", authMiddleware(""), tourHandler.GetTourPlayback)
+		api.POST("/tours", authMiddleware("exhibitor"), tourHandler.CreateTour)
+		api.GET("/tours/:id", authMiddleware("exhibitor"), tourHandler.GetTourByID)
+		api.PUT("/tours/:id", authMiddleware("exhibitor"), tourHandler.UpdateTour)
+		api.DELETE("/tours/:id", authMiddleware("exhibitor"), tourHandler.DeleteTour)
+		api.POST("/tours/:id/stops/:index/audio", authMiddleware("exhibitor"), tourHandler.UploadTourStopAudio)
+		//Quizzes
+		api.GET("/exhibitions/:id/quizzes", authMiddleware(""), quizHandler.GetExhibitionQuizzes)
+		api.POST("/quizzes", authMiddleware("exhibitor"), quizHandler.CreateQuiz)
+		api.GET("/quizzes/:id", authMiddleware("exhibitor"), quizHandler.GetQuizByID)
+		api.PUT("/quizzes/:id", authMiddleware("exhibitor"), quizHandler.UpdateQuiz)
+		api.DELETE("/quizzes/:id", authMiddleware("exhibitor"), quizHandler.DeleteQuiz)
+		api.POST("/quizzes/:id/attempts", authMiddleware("exhibitor"), quizHandler.SubmitQuiz)
+		api.GET("/quizzes/:id/attempts", authMiddleware("exhibitor"), quizHandler.GetQuizAttempts)
+		api.GET("/quizzes/:id/statistics", authMiddleware("exhibitor"), quizHandler.GetQuizStatistics)
+		//Reservations
+		api.GET("/exhibitions/:id/time-slots", authMiddleware(""), reservationHandler.GetTimeSlots)
+		api.POST("/exhibitions/:id/time-slots", authMiddleware("exhibitor"), reservationHandler.CreateTimeSlot)
+		api.PUT("/time-slots/:id", authMiddleware("exhibitor"), reservationHandler.UpdateTimeSlot)
+		api.DELETE("/time-slots/:id", authMiddleware("exhibitor"), reservationHandler.DeleteTimeSlot)
+		api.POST("/exhibitions/:id/reservations", authMiddleware("exhibitor"), reservationHandler.CreateReservation)
+		api.GET("/reservations", authMiddleware("exhibitor"), reservationHandler.GetReservations)
+		api.GET("/reservations/:id", authMiddleware("exhibitor"), reservationHandler.GetReservationByID)
+		api.GET("/reservations/:id/qr", authMiddleware("exhibitor"), reservationHandler.GetReservationQRCode)
+		api.DELETE("/reservations/:id", authMiddleware("exhibitor"), reservationHandler.CancelReservation)
+		api.POST("/exhibitions/:id/check-in", authMiddleware("exhibitor"), reservationHandler.CheckInReservation)
+		//Presence
+		api.GET("/exhibitions/:id/presence", queryTokenMiddleware(), authMiddleware("exhibitor"), presenceHandler.JoinPresence)
+		api.GET("/exhibitions/:id/occupancy", authMiddleware(""), presenceHandler.GetOccupancy)
+		//Events
+		api.GET("/events", queryTokenMiddleware(), authMiddleware("exhibitor"), eventHandler.StreamEvents)
+		api.GET("/exhibitions/:id/events", queryTokenMiddleware(), authMiddleware("exhibitor"), eventHandler.StreamExhibitionEvents)
+		//Webhooks
+		api.POST("/webhooks", authMiddleware("admin"), webhookHandler.CreateWebhook)
+		api.GET("/webhooks", authMiddleware("admin"), webhookHandler.GetWebhooks)
+		api.GET("/webhooks/:id", authMiddleware("admin"), webhookHandler.GetWebhookByID)
+		api.PUT("/webhooks/:id", authMiddleware("admin"), webhookHandler.UpdateWebhook)
+		api.DELETE("/webhooks/:id", authMiddleware("admin"), webhookHandler.DeleteWebhook)
+		api.GET("/webhook-deliveries", authMiddleware("admin"), webhookHandler.GetDeliveries)
+		api.GET("/webhook-deliveries/:id", authMiddleware("admin"), webhookHandler.GetDeliveryByID)
+		api.POST("/webhook-deliveries/:id/redeliver", authMiddleware("admin"), webhookHandler.Redeliver)
+		//Moderation
+		api.POST("/exhibitions/:id/reports", authMiddleware("exhibitor"), moderationHandler.ReportExhibition)
+		api.GET("/exhibitions/:id/moderation", authMiddleware("exhibitor"), moderationHandler.GetHistory)
+		api.POST("/exhibitions/:id/appeals", authMiddleware("exhibitor"), moderationHandler.AppealAction)
+		api.GET("/moderation/queue", authMiddleware("admin"), moderationHandler.GetQueue)
+		api.GET("/moderation/exhibitions/:id/reports", authMiddleware("admin"), moderationHandler.GetReports)
+		api.POST("/moderation/exhibitions/:id/actions", authMiddleware("admin"), moderationHandler.TakeAction)
+		api.GET("/moderation/appeals", authMiddleware("admin"), moderationHandler.GetAppeals)
+		api.POST("/moderation/appeals/:id/decision", authMiddleware("admin"), moderationHandler.DecideAppeal)
+		//Profiles
+		api.POST("/internal/profile-changes", profileHandler.ProfileChanged)
+		api.POST("/profiles/reconcile", authMiddleware("admin"), profileHandler.ReconcileProfiles)
+		//Screening
+		api.GET("/screening/rules", authMiddleware("admin"), screeningHandler.GetRules)
+		api.POST("/screening/rules", authMiddleware("admin"), screeningHandler.CreateRule)
+		api.PUT("/screening/rules/:id", authMiddleware("admin"), screeningHandler.UpdateRule)
+		api.DELETE("/screening/rules/:id", authMiddleware("admin"), screeningHandler.DeleteRule)
+		//Audit
+		api.GET("/audit-log", authMiddleware("admin"), auditHandler.GetAuditLog)
 	}
 
 	return router
 }
 
+// initCollaboratorService initializes the collaborator service shared by the handlers
+func initCollaboratorService(client *mongo.Client) *collabsvc.CollaboratorServices {
+	dbCollection := client.Database("atommuse").Collection("exhibitions")
+	repo := &collabrepo.CollaboratorRepository{Collection: dbCollection}
+	return &collabsvc.CollaboratorServices{Repository: repo}
+}
+
+// initShareLinkService initializes the share link service and its indexes
+func initShareLinkService(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices) *sharesvc.ShareLinkServices {
+	dbCollection := client.Database("atommuse").Collection("exhibitionShareLinks")
+	repo := &sharerepo.ShareLinkRepository{Collection: dbCollection}
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating share link indexes:", err)
+	}
+	return &sharesvc.ShareLinkServices{Repository: repo, CollaboratorService: collaboratorService}
+}
+
+// initArtworkService initializes the artwork catalogue service and its indexes
+func initArtworkService(client *mongo.Client) *artworksvc.ArtworkServices {
+	repo := artworkrepo.NewArtworkRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating artwork indexes:", err)
+	}
+	return &artworksvc.ArtworkServices{Repository: repo}
+}
+
+// initCommentCleaner initializes the cleaner removing the comments of deleted exhibitions
+// through the comment service at COMMENT_SERVICE_URL, queueing the cleanups while it is down.
+// Without a comment service every cleanup is queued until one is configured
+func initCommentCleaner(client *mongo.Client) comment.CommentCleaner {
+	repo := commentrepo.NewCleanupRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating comment cleanup indexes:", err)
+	}
+
+	baseURL := os.Getenv("COMMENT_SERVICE_URL")
+	if baseURL == "" {
+		log.Println("COMMENT_SERVICE_URL not set, comments of deleted exhibitions are queued for cleanup until it is")
+		return &comment.QueueCleaner{Queue: repo}
+	}
+	return &comment.FallbackCleaner{
+		Cleaner: comment.NewHTTPCleaner(baseURL, os.Getenv("COMMENT_SERVICE_TOKEN")),
+		Queue:   repo,
+	}
+}
+
+// initCommentCleanupWorker initializes the worker retrying queued comment cleanups, or returns
+// nil when no comment service is configured
+func initCommentCleanupWorker(client *mongo.Client) *commentsvc.CleanupWorker {
+	baseURL := os.Getenv("COMMENT_SERVICE_URL")
+	if baseURL == "" {
+		return nil
+	}
+	return &commentsvc.CleanupWorker{
+		Repository: commentrepo.NewCleanupRepository(client, "atommuse"),
+		Cleaner:    comment.NewHTTPCleaner(baseURL, os.Getenv("COMMENT_SERVICE_TOKEN")),
+	}
+}
+
 // initExhibitionHandler initializes the exhibition handler with required dependencies
-func initExhibitionHandler(client *mongo.Client) *exhibihandler.Handler {
+func initExhibitionHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, shareLinkService sharesvc.IShareLinkServices, artworkService artworksvc.IArtworkServices, screeningService screeningsvc.IScreeningServices) *exhibihandler.Handler {
 	dbCollection := client.Database("atommuse").Collection("exhibitions")
-	repo := &exhibirepo.ExhibitionRepository{Collection: dbCollection}
-	service := &exhibisvc.ExhibitionServices{Repository: repo}
-	return &exhibihandler.Handler{ExhibitionService: service}
+	repo := &exhibirepo.ExhibitionRepository{Collection: dbCollection, Outbox: outboxrepo.NewOutboxRepository(client, "atommuse")}
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating exhibition search index:", err)
+	}
+	service := &exhibisvc.ExhibitionServices{
+		Repository:       repo,
+		ShareLinkService: shareLinkService,
+		ArtworkService:   artworkService,
+		TreeRepository:   templaterepo.NewTemplateRepository(client, "atommuse"),
+		CommentCleaner:   initCommentCleaner(client),
+		ScreeningService: screeningService,
+	}
+	return &exhibihandler.Handler{ExhibitionService: service, CollaboratorService: collaboratorService}
 }
 
 // initSectionHandler initializes the section handler with required dependencies
-func initSectionHandler(client *mongo.Client) *sectionhandler.Handler {
+func initSectionHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, exhibitionService exhibisvc.IExhibitionServices, artworkService artworksvc.IArtworkServices, screeningService screeningsvc.IScreeningServices) *sectionhandler.Handler {
 	dbCollection := client.Database("atommuse").Collection("exhibitionSections")
-	repo := &sectionrepo.SectionRepository{Collection: dbCollection}
-	service := &sectionsvc.SectionServices{Repository: repo}
-	return &sectionhandler.Handler{SectionService: service}
+	repo := &sectionrepo.SectionRepository{Collection: dbCollection, Outbox: outboxrepo.NewOutboxRepository(client, "atommuse")}
+	service := &sectionsvc.SectionServices{Repository: repo, ScreeningService: screeningService}
+	return &sectionhandler.Handler{SectionService: service, ExhibitionService: exhibitionService, CollaboratorService: collaboratorService, ArtworkService: artworkService}
+}
+
+// initTimelineHandler initializes the timeline entry handler and its indexes
+func initTimelineHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices) *timelinehandler.Handler {
+	repo := timelinerepo.NewTimelineRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating timeline indexes:", err)
+	}
+	service := &timelinesvc.TimelineServices{Repository: repo}
+	return &timelinehandler.Handler{TimelineService: service, CollaboratorService: collaboratorService}
+}
+
+// initPointOfInterestHandler initializes the point of interest handler and its indexes
+func initPointOfInterestHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices) *poihandler.Handler {
+	repo := poirepo.NewPointOfInterestRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating point of interest indexes:", err)
+	}
+	service := &poisvc.PointOfInterestServices{Repository: repo}
+	return &poihandler.Handler{PointOfInterestService: service, CollaboratorService: collaboratorService}
+}
+
+// initTourHandler initializes the tour handler and its indexes
+func initTourHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, exhibitionService exhibisvc.IExhibitionServices) *tourhandler.Handler {
+	repo := tourrepo.NewTourRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating tour indexes:", err)
+	}
+	service := &toursvc.TourServices{
+		Repository:     repo,
+		TreeRepository: templaterepo.NewTemplateRepository(client, "atommuse"),
+	}
+	return &tourhandler.Handler{TourService: service, ExhibitionService: exhibitionService, CollaboratorService: collaboratorService}
+}
+
+// initQuizHandler initializes the quiz handler and its indexes
+func initQuizHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, exhibitionService exhibisvc.IExhibitionServices) *quizhandler.Handler {
+	repo := quizrepo.NewQuizRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating quiz indexes:", err)
+	}
+	service := &quizsvc.QuizServices{
+		Repository:     repo,
+		TreeRepository: templaterepo.NewTemplateRepository(client, "atommuse"),
+	}
+	return &quizhandler.Handler{QuizService: service, ExhibitionService: exhibitionService, CollaboratorService: collaboratorService}
+}
+
+// initReservationHandler initializes the reservation handler and its indexes
+func initReservationHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, exhibitionService exhibisvc.IExhibitionServices) *reservationhandler.Handler {
+	repo := reservationrepo.NewReservationRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating reservation indexes:", err)
+	}
+	secret, err := reservation.Secret()
+	if err != nil {
+		log.Fatal("Error configuring reservations:", err)
+	}
+	service := &reservationsvc.ReservationServices{Repository: repo, Secret: secret}
+	return &reservationhandler.Handler{ReservationService: service, ExhibitionService: exhibitionService, CollaboratorService: collaboratorService}
+}
+
+// initEventHandler initializes the handler of the event streams
+func initEventHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices) *eventhandler.Handler {
+	repo := eventrepo.NewEventRepository(client, "atommuse")
+	service := &eventsvc.EventServices{Repository: repo}
+	return &eventhandler.Handler{EventService: service, CollaboratorService: collaboratorService}
+}
+
+// initWebhookHandler initializes the handler of the webhooks and their indexes
+func initWebhookHandler(client *mongo.Client) *webhookhandler.Handler {
+	repo := webhookrepo.NewWebhookRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating webhook indexes:", err)
+	}
+	return &webhookhandler.Handler{WebhookService: &webhooksvc.WebhookServices{Repository: repo}}
+}
+
+// initWebhookDispatcher initializes the dispatcher delivering the events to the webhooks
+func initWebhookDispatcher(client *mongo.Client) *webhooksvc.Dispatcher {
+	return &webhooksvc.Dispatcher{
+		Repository:   webhookrepo.NewWebhookRepository(client, "atommuse"),
+		EventService: &eventsvc.EventServices{Repository: eventrepo.NewEventRepository(client, "atommuse")},
+		Client:       &http.Client{},
+		Workers:      4,
+	}
+}
+
+// initOutboxRelay initializes the relay publishing the outbox to the event bus configured with
+// EVENT_BUS (nats, kafka or memory), EVENT_BUS_URL and EVENT_BUS_TOPIC
+func initOutboxRelay(client *mongo.Client) *outboxsvc.Relay {
+	repo := outboxrepo.NewOutboxRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating outbox indexes:", err)
+	}
+
+	kind := os.Getenv("EVENT_BUS")
+	publisher, err := eventbus.Open(kind, os.Getenv("EVENT_BUS_URL"), os.Getenv("EVENT_BUS_TOPIC"))
+	if err != nil {
+		log.Fatal("Error opening event bus:", err)
+	}
+	if kind == "" {
+		log.Println("EVENT_BUS not set, events stay within the service")
+	}
+
+	return &outboxsvc.Relay{Repository: repo, Publisher: publisher, Owner: primitive.NewObjectID().Hex()}
+}
+
+// initModerationHandler initializes the handler of reports, moderation actions and appeals
+// and their indexes
+func initModerationHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, exhibitionService exhibisvc.IExhibitionServices) *moderationhandler.Handler {
+	repo := moderationrepo.NewModerationRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating moderation indexes:", err)
+	}
+	return &moderationhandler.Handler{
+		ModerationService:   &moderationsvc.ModerationServices{Repository: repo},
+		ExhibitionService:   exhibitionService,
+		CollaboratorService: collaboratorService,
+	}
+}
+
+// initAuditHandler initializes the audit log and its query handler. Entries expire after
+// AUDIT_RETENTION_DAYS, 365 by default
+func initAuditHandler(client *mongo.Client) *audithandler.Handler {
+	days := 365
+	if value := os.Getenv("AUDIT_RETENTION_DAYS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			log.Fatal("Invalid AUDIT_RETENTION_DAYS:", value)
+		}
+		days = parsed
+	}
+
+	repo := auditrepo.NewAuditRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background(), time.Duration(days)*24*time.Hour); err != nil {
+		log.Println("Error creating audit log indexes:", err)
+	}
+	return &audithandler.Handler{AuditService: &auditsvc.AuditServices{Repository: repo}}
+}
+
+// initScreeningService initializes the service screening the texts of exhibitions, sections
+// and rooms against the rules moderators manage
+func initScreeningService(client *mongo.Client) *screeningsvc.ScreeningServices {
+	return &screeningsvc.ScreeningServices{Repository: screeningrepo.NewScreeningRepository(client, "atommuse")}
+}
+
+// initProfileService initializes the service keeping the copies of user profiles in line and
+// the indexes it finds them with
+func initProfileService(client *mongo.Client) *profilesvc.ProfileServices {
+	repo := profilerepo.NewProfileRepository(client, "atommuse")
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		log.Println("Error creating profile indexes:", err)
+	}
+	return &profilesvc.ProfileServices{Repository: repo}
+}
+
+// initProfileHandler initializes the handler of the profile changes the user service signs
+// with PROFILE_EVENTS_SECRET
+func initProfileHandler(client *mongo.Client) *profilehandler.Handler {
+	secret := os.Getenv("PROFILE_EVENTS_SECRET")
+	if secret == "" {
+		log.Println("PROFILE_EVENTS_SECRET not set, profile changes are rejected")
+	}
+	return &profilehandler.Handler{ProfileService: initProfileService(client), Secret: secret}
+}
+
+// initProfileReconciler initializes the job repairing the copies of user profiles
+func initProfileReconciler(client *mongo.Client) *profilesvc.Reconciler {
+	return &profilesvc.Reconciler{Service: initProfileService(client)}
 }
 
 // initRoomHandler initializes the Room handler with required dependencies
-func initRoomHandler(client *mongo.Client) *roomhandler.Handler {
+func initRoomHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, exhibitionService exhibisvc.IExhibitionServices, artworkService artworksvc.IArtworkServices, screeningService screeningsvc.IScreeningServices) *roomhandler.Handler {
 	dbCollection := client.Database("atommuse").Collection("exhibitionRooms")
-	repo := &roomrepo.RoomRepository{Collection: dbCollection}
-	service := &roomsvc.RoomServices{Repository: repo}
-	return &roomhandler.Handler{RoomService: service}
+	repo := &roomrepo.RoomRepository{Collection: dbCollection, Outbox: outboxrepo.NewOutboxRepository(client, "atommuse")}
+	service := &roomsvc.RoomServices{Repository: repo, ScreeningService: screeningService}
+	return &roomhandler.Handler{RoomService: service, ExhibitionService: exhibitionService, CollaboratorService: collaboratorService, ArtworkService: artworkService}
+}
+
+// initTemplateHandler initializes the cloning and template handler with required dependencies
+func initTemplateHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, screeningService screeningsvc.IScreeningServices, artworkService artworksvc.IArtworkServices) *templatehandler.Handler {
+	repo := templaterepo.NewTemplateRepository(client, "atommuse")
+	service := &templatesvc.TemplateServices{Repository: repo, CollaboratorService: collaboratorService, ScreeningService: screeningService, ArtworkService: artworkService}
+	return &templatehandler.Handler{TemplateService: service}
+}
+
+func initBundleHandler(client *mongo.Client, collaboratorService collabsvc.ICollaboratorServices, screeningService screeningsvc.IScreeningServices, artworkService artworksvc.IArtworkServices) *bundlehandler.Handler {
+	repo := templaterepo.NewTemplateRepository(client, "atommuse")
+	service := &bundlesvc.BundleServices{Repository: repo, CollaboratorService: collaboratorService, ScreeningService: screeningService, ArtworkService: artworkService}
+	return &bundlehandler.Handler{BundleService: service}
 }
@@ -0,0 +1,78 @@
+// Command sitegen renders an exhibition into a static HTML site for offline kiosks.
+//
+//	sitegen -id <exhibitionID> -o site.zip
+package main
+
+import (
+	"atommuse/backend/exhibition-service/pkg/repositorty/templaterepo"
+	"atommuse/backend/exhibition-service/pkg/rights"
+	"atommuse/backend/exhibition-service/pkg/sitegen"
+	"atommuse/backend/exhibition-service/pkg/utils"
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	exhibitionID := flag.String("id", "", "ID of the exhibition to render")
+	output := flag.String("o", "site.zip", "path of the zip archive to write")
+	offline := flag.Bool("download", true, "download remote media into the site")
+	flag.Parse()
+
+	if *exhibitionID == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// The .env file is optional here, the environment may already be set
+	_ = godotenv.Load()
+
+	mongoURI := os.Getenv("MONGO_URI")
+	if mongoURI == "" {
+		log.Fatal("MONGO_URI environment variable not set.")
+	}
+
+	client, err := utils.ConnectToMongoDB(mongoURI)
+	if err != nil {
+		log.Fatal("Error connecting to MongoDB:", err)
+	}
+	defer func() {
+		if err := client.Disconnect(context.Background()); err != nil {
+			log.Println("Error disconnecting from MongoDB:", err)
+		}
+	}()
+
+	repo := templaterepo.NewTemplateRepository(client, "atommuse")
+	exhibition, err := repo.GetExhibitionTree(context.Background(), *exhibitionID)
+	if err != nil {
+		log.Fatalf("Error loading exhibition %s: %v", *exhibitionID, err)
+	}
+	rights.Redact(exhibition, time.Now())
+
+	file, err := os.Create(*output)
+	if err != nil {
+		log.Fatal("Error creating output file:", err)
+	}
+
+	options := sitegen.Options{}
+	if *offline {
+		options.Client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	if err := sitegen.Write(file, exhibition, options); err != nil {
+		file.Close()
+		os.Remove(*output)
+		log.Fatalf("Error rendering exhibition %s: %v", *exhibitionID, err)
+	}
+
+	if err := file.Close(); err != nil {
+		log.Fatal("Error writing output file:", err)
+	}
+
+	log.Printf("Wrote %s", *output)
+}
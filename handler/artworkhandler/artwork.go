@@ -0,0 +1,28 @@
+package artworkhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/service/artworksvc"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	ArtworkService artworksvc.IArtworkServices
+}
+
+// respondError writes the HTTP response matching an artwork service error.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, cerr.ErrArtworkNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrArtworkExists), errors.Is(err, cerr.ErrArtworkInUse):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		helper.RespondAccessError(c, err)
+	}
+}
@@ -0,0 +1,60 @@
+package artworkhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Create an artwork
+//	@Description	Add an object to the catalogue of the current user. Accession numbers are unique per owner.
+//	@Tags			Artworks
+//	@Security		BearerAuth
+//	@ID				CreateArtwork
+//	@Accept			json
+//	@Produce		json
+//	@Param			requestArtwork	body		model.RequestArtwork			true	"Artwork data"
+//	@Success		201				{object}	model.ResponseGetExhibitionId	"Success"
+//	@Failure		400				{object}	helper.APIError					"Invalid request body"
+//	@Failure		409				{object}	helper.APIError					"Accession number already exists"
+//	@Router			/api/artworks [post]
+func (h *Handler) CreateArtwork(c *gin.Context) {
+	var requestArtwork model.RequestArtwork
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestArtwork); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestArtwork); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	objectID, err := h.ArtworkService.CreateArtwork(c.Request.Context(), actor, &requestArtwork)
+	if err != nil {
+		log.Printf("Error creating artwork: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": objectID.Hex()})
+}
@@ -0,0 +1,38 @@
+package artworkhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete artwork by ID
+//	@Description	Delete an artwork. Artworks still referenced by a section or room item cannot be deleted.
+//	@Tags			Artworks
+//	@Security		BearerAuth
+//	@ID				DeleteArtwork
+//	@Produce		json
+//	@Param			id	path		string							true	"Artwork ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete Artwork Success"
+//	@Failure		404	{object}	helper.APIError					"Artwork not found"
+//	@Failure		409	{object}	helper.APIError					"Artwork is used in exhibitions"
+//	@Router			/api/artworks/{id} [delete]
+func (h *Handler) DeleteArtwork(c *gin.Context) {
+	artworkID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	if err := h.ArtworkService.DeleteArtwork(c.Request.Context(), artworkID, actor); err != nil {
+		log.Printf("Error deleting artwork %s: %v", artworkID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": artworkID + " has been deleted."})
+}
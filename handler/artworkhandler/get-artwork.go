@@ -0,0 +1,66 @@
+package artworkhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get artworks
+//	@Description	Get the catalogue of the current user sorted by title. Admins may list the catalogue of another owner.
+//	@Tags			Artworks
+//	@Security		BearerAuth
+//	@ID				GetArtworks
+//	@Produce		json
+//	@Param			search	query		string	false	"Matches title, creator or accession number"
+//	@Param			ownerId	query		string	false	"Owner of the catalogue (admins only)"
+//	@Success		200		{object}	[]model.Artwork
+//	@Failure		403		{object}	helper.APIError	"Insufficient permissions"
+//	@Router			/api/artworks [get]
+func (h *Handler) GetArtworks(c *gin.Context) {
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	artworks, err := h.ArtworkService.GetArtworks(c.Request.Context(), actor, c.Query("ownerId"), c.Query("search"))
+	if err != nil {
+		log.Printf("Error retrieving artworks: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, artworks)
+}
+
+//	@Summary		Get artwork by ID
+//	@Description	Get an artwork of the current user's catalogue
+//	@Tags			Artworks
+//	@Security		BearerAuth
+//	@ID				GetArtworkByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Artwork ID"
+//	@Success		200	{object}	model.Artwork
+//	@Failure		404	{object}	helper.APIError	"Artwork not found"
+//	@Router			/api/artworks/{id} [get]
+func (h *Handler) GetArtworkByID(c *gin.Context) {
+	artworkID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	artwork, err := h.ArtworkService.GetArtworkByID(c.Request.Context(), artworkID, actor)
+	if err != nil {
+		log.Printf("Error retrieving artwork %s: %v", artworkID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, artwork)
+}
@@ -0,0 +1,63 @@
+package artworkhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update artwork by ID
+//	@Description	Replace the catalogue fields of an artwork. Exhibitions referencing it show the new metadata.
+//	@Tags			Artworks
+//	@Security		BearerAuth
+//	@ID				UpdateArtwork
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string					true	"Artwork ID"
+//	@Param			requestArtwork	body		model.RequestArtwork	true	"Artwork data"
+//	@Success		200				{object}	model.Artwork
+//	@Failure		400				{object}	helper.APIError	"Invalid request body"
+//	@Failure		404				{object}	helper.APIError	"Artwork not found"
+//	@Failure		409				{object}	helper.APIError	"Accession number already exists"
+//	@Router			/api/artworks/{id} [put]
+func (h *Handler) UpdateArtwork(c *gin.Context) {
+	artworkID := c.Param("id")
+	var requestArtwork model.RequestArtwork
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestArtwork); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestArtwork); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	artwork, err := h.ArtworkService.UpdateArtwork(c.Request.Context(), artworkID, actor, &requestArtwork)
+	if err != nil {
+		log.Printf("Error updating artwork %s: %v", artworkID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, artwork)
+}
@@ -0,0 +1,18 @@
+package audithandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/service/auditsvc"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	AuditService auditsvc.IAuditServices
+}
+
+// respondError writes the HTTP response matching an audit service error.
+func respondError(c *gin.Context, err error) {
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+}
@@ -0,0 +1,100 @@
+package audithandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Default and largest number of entries listed at once.
+const (
+	defaultEntryLimit = 50
+	maxEntryLimit     = 200
+)
+
+//	@Summary		Get audit log
+//	@Description	Get the entries of the audit log, most recent first. Every request changing something is recorded, including the rejected ones, with its actor, IP, request ID, response status and hashes of the target before and after. Page with before, the ID of the last entry of the previous page. Entries expire after the retention set by AUDIT_RETENTION_DAYS.
+//	@Tags			Audit
+//	@Security		BearerAuth
+//	@ID				GetAuditLog
+//	@Produce		json
+//	@Param			actorId		query		string	false	"Actor user ID"
+//	@Param			action		query		string	false	"Action, such as BanExhibition"
+//	@Param			targetType	query		string	false	"Target type, such as exhibition"
+//	@Param			targetId	query		string	false	"Target ID"
+//	@Param			from		query		string	false	"Earliest time, in RFC 3339"
+//	@Param			to			query		string	false	"Time before which entries were recorded, in RFC 3339"
+//	@Param			before		query		string	false	"ID of the entry to list the entries before"
+//	@Param			limit		query		int		false	"Number of entries, 50 by default and at most 200"
+//	@Success		200			{object}	[]model.AuditEntry
+//	@Failure		400			{object}	helper.APIError	"Invalid filter or limit"
+//	@Failure		403			{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500			{object}	helper.APIError	"Internal server error"
+//	@Router			/api/audit-log [get]
+func (h *Handler) GetAuditLog(c *gin.Context) {
+	query := model.AuditQuery{
+		Action:     c.Query("action"),
+		TargetType: c.Query("targetType"),
+		TargetID:   c.Query("targetId"),
+		Limit:      defaultEntryLimit,
+	}
+
+	if actorID := c.Query("actorId"); actorID != "" {
+		id, err := primitive.ObjectIDFromHex(actorID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid actor ID"})
+			return
+		}
+		query.ActorID = &id
+	}
+
+	if before := c.Query("before"); before != "" {
+		id, err := primitive.ObjectIDFromHex(before)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid entry ID"})
+			return
+		}
+		query.Before = &id
+	}
+
+	if from := c.Query("from"); from != "" {
+		at, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from time"})
+			return
+		}
+		query.From = &at
+	}
+
+	if to := c.Query("to"); to != "" {
+		at, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to time"})
+			return
+		}
+		query.To = &at
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		value, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || value < 1 || value > maxEntryLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+		query.Limit = value
+	}
+
+	entries, err := h.AuditService.GetEntries(c.Request.Context(), query)
+	if err != nil {
+		log.Printf("Error retrieving audit log: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
@@ -0,0 +1,109 @@
+package audithandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/audit"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// maxCapturedBody is the most of a response kept to find the ID of the resource it created.
+const maxCapturedBody = 4096
+
+// recordTimeout bounds recording an entry once the response is written.
+const recordTimeout = 5 * time.Second
+
+// capturingWriter keeps the start of the response body.
+type capturingWriter struct {
+	gin.ResponseWriter
+	body []byte
+}
+
+func (w *capturingWriter) Write(data []byte) (int, error) {
+	if room := maxCapturedBody - len(w.body); room > 0 {
+		if len(data) < room {
+			room = len(data)
+		}
+		w.body = append(w.body, data[:room]...)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// Record is the middleware tagging every request with an ID and appending the requests that
+// change something to the audit log: who made them, from where, the route and its handler,
+// the response status and hashes of the target resource before and after. Entries are
+// recorded for rejected requests too. Request IDs given by the client are only kept when
+// audit.ValidRequestID accepts them.
+func (h *Handler) Record(c *gin.Context) {
+	requestID := c.GetHeader(audit.RequestIDHeader)
+	if !audit.ValidRequestID(requestID) {
+		requestID = primitive.NewObjectID().Hex()
+	}
+	c.Set("request_id", requestID)
+	c.Header(audit.RequestIDHeader, requestID)
+
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		c.Next()
+		return
+	}
+
+	entry := model.AuditEntry{
+		Action:    audit.Action(c.HandlerName()),
+		Method:    c.Request.Method,
+		Route:     c.FullPath(),
+		IP:        c.ClientIP(),
+		RequestID: requestID,
+	}
+
+	target, found := audit.Resolve(entry.Route)
+	if found {
+		entry.TargetType = target.Type
+		if target.Param != "" {
+			entry.TargetID = c.Param(target.Param)
+			hash, err := h.AuditService.Snapshot(c.Request.Context(), target.Kind, entry.TargetID)
+			if err != nil {
+				log.Printf("Error hashing %s %s for the audit log: %v", entry.TargetType, entry.TargetID, err)
+			}
+			entry.BeforeHash = hash
+		}
+	}
+
+	writer := &capturingWriter{ResponseWriter: c.Writer}
+	c.Writer = writer
+	c.Next()
+
+	entry.Status = c.Writer.Status()
+	if actor, ok := helper.GetActor(c); ok {
+		entry.ActorID = &actor.UserID.UserID
+		entry.ActorUsername = actor.UserID.Username
+		entry.Role = actor.Role
+	}
+
+	// The request may be over, but its entry must still be written
+	ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+	defer cancel()
+
+	if found {
+		if entry.TargetID == "" && entry.Status >= 200 && entry.Status < 300 {
+			entry.TargetID = audit.CreatedID(writer.body)
+		}
+		if entry.TargetID != "" {
+			hash, err := h.AuditService.Snapshot(ctx, target.Kind, entry.TargetID)
+			if err != nil {
+				log.Printf("Error hashing %s %s for the audit log: %v", entry.TargetType, entry.TargetID, err)
+			}
+			entry.AfterHash = hash
+		}
+	}
+
+	if err := h.AuditService.Record(ctx, &entry); err != nil {
+		log.Printf("Error recording request %s in the audit log: %v", requestID, err)
+	}
+}
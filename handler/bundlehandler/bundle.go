@@ -0,0 +1,34 @@
+package bundlehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/bundle"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/service/bundlesvc"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	BundleService bundlesvc.IBundleServices
+}
+
+// respondError writes the HTTP response matching a bundle service error.
+func respondError(c *gin.Context, err error) {
+	if helper.RespondBlockedContent(c, err) {
+		return
+	}
+
+	var validationErr *bundle.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Bundle", "problems": validationErr.Problems})
+	case errors.Is(err, cerr.ErrUnsupportedLayout):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		helper.RespondAccessError(c, err)
+	}
+}
@@ -0,0 +1,49 @@
+package bundlehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/bundle"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Export an exhibition
+//	@Description	Export an exhibition with its sections, rooms and locally stored media as a versioned zip bundle
+//	@Tags			Bundles
+//	@Security		BearerAuth
+//	@ID				ExportExhibition
+//	@Produce		application/zip
+//	@Param			id	path		string			true	"Exhibition ID"
+//	@Success		200	{file}		file			"Bundle archive"
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/export [get]
+func (h *Handler) ExportExhibition(c *gin.Context) {
+	exhibitionID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	manifest, files, err := h.BundleService.ExportExhibition(c.Request.Context(), exhibitionID, actor)
+	if err != nil {
+		log.Printf("Error exporting exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"exhibition-%s.zip\"", exhibitionID))
+	c.Status(http.StatusOK)
+
+	// Headers are sent at this point, so a failure can only be logged
+	if err := bundle.Write(c.Writer, manifest, files); err != nil {
+		log.Printf("Error writing bundle for exhibition %s: %v", exhibitionID, err)
+	}
+}
@@ -0,0 +1,63 @@
+package bundlehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/rights"
+	"atommuse/backend/exhibition-service/pkg/sitegen"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Export an exhibition as a static site
+//	@Description	Render a blogLayout or liveLayout exhibition into a static HTML site packaged as a zip archive. Stored media is copied into the site, remote media stays linked to where it is hosted
+//	@Tags			Bundles
+//	@Security		BearerAuth
+//	@ID				ExportExhibitionSite
+//	@Produce		application/zip
+//	@Param			id	path		string			true	"Exhibition ID"
+//	@Success		200	{file}		file			"Site archive"
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
+//	@Failure		422	{object}	helper.APIError	"Unsupported layout"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/export/site [get]
+func (h *Handler) ExportExhibitionSite(c *gin.Context) {
+	exhibitionID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	exhibition, err := h.BundleService.LoadExhibition(c.Request.Context(), exhibitionID, actor)
+	if err != nil {
+		log.Printf("Error loading exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	if !sitegen.Supports(exhibition.LayoutUsed) {
+		respondError(c, cerr.ErrUnsupportedLayout)
+		return
+	}
+
+	// The site is meant to be published, so embargoed media is left out
+	rights.Redact(exhibition, time.Now())
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"exhibition-%s-site.zip\"", exhibitionID))
+	c.Status(http.StatusOK)
+
+	// Remote media is linked rather than downloaded: fetching URLs editors entered from the
+	// server would let them read internal services through the archive.
+	// Headers are sent at this point, so a failure can only be logged
+	if err := sitegen.Write(c.Writer, exhibition, sitegen.Options{}); err != nil {
+		log.Printf("Error writing site for exhibition %s: %v", exhibitionID, err)
+	}
+}
@@ -0,0 +1,71 @@
+package bundlehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/bundle"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Import an exhibition
+//	@Description	Import an exhibition bundle as a new private exhibition owned by the current user. IDs are reassigned and conflicts are reported. Use dryRun to only validate the bundle. Bundles may hold at most 512 MiB, and unpack to at most 1 GiB with no file over 200 MiB. Its texts are screened as for a new exhibition: an exhibition with flagged texts is held for review and blocked texts are rejected. References to artworks outside the catalogue of the current user are dropped.
+//	@Tags			Bundles
+//	@Security		BearerAuth
+//	@ID				ImportExhibition
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			bundle	formData	file							true	"Bundle archive"
+//	@Param			dryRun	query		bool							false	"Validate without importing"
+//	@Success		200		{object}	model.ResponseImportExhibition	"Dry run result"
+//	@Success		201		{object}	model.ResponseImportExhibition	"Imported"
+//	@Failure		400		{object}	helper.APIError					"Invalid bundle"
+//	@Failure		413		{object}	helper.APIError					"Bundle too large"
+//	@Failure		422		{object}	helper.APIError					"Unsupported layout or content blocked by screening"
+//	@Failure		500		{object}	helper.APIError					"Internal server error"
+//	@Router			/api/exhibitions/import [post]
+func (h *Handler) ImportExhibition(c *gin.Context) {
+	dryRun := c.Query("dryRun") == "true"
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Leave room for the multipart envelope around the file
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, bundle.MaxSize+1<<20)
+	header, err := c.FormFile("bundle")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Bundle is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bundle file is required"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		log.Printf("Error opening uploaded bundle: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read bundle"})
+		return
+	}
+	defer file.Close()
+
+	result, err := h.BundleService.ImportExhibition(c.Request.Context(), actor, file, header.Size, dryRun)
+	if err != nil {
+		log.Printf("Error importing exhibition: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if dryRun {
+		status = http.StatusOK
+	}
+	c.JSON(status, result)
+}
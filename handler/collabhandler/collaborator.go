@@ -0,0 +1,30 @@
+package collabhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	CollaboratorService collabsvc.ICollaboratorServices
+}
+
+// respondError writes the HTTP response matching a collaborator service error.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, cerr.ErrCollaboratorNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrCollaboratorExists):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrInvalidRole):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		helper.RespondAccessError(c, err)
+	}
+}
@@ -0,0 +1,40 @@
+package collabhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Remove a collaborator
+//	@Description	Remove a collaborator or revoke a pending invitation. Collaborators can also remove themselves.
+//	@Tags			Collaborators
+//	@Security		BearerAuth
+//	@ID				RemoveCollaborator
+//	@Produce		json
+//	@Param			id		path	string	true	"Exhibition ID"
+//	@Param			userId	path	string	true	"Collaborator user ID"
+//	@Success		200
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Collaborator not found"
+//	@Router			/api/exhibitions/{id}/collaborators/{userId} [delete]
+func (h *Handler) RemoveCollaborator(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	userID := c.Param("userId")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	if err := h.CollaboratorService.RemoveCollaborator(c.Request.Context(), exhibitionID, userID, actor); err != nil {
+		log.Printf("Error removing collaborator %s from exhibition %s: %v", userID, exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Collaborator removed successfully"})
+}
@@ -0,0 +1,40 @@
+package collabhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get collaborators of an exhibition
+//	@Description	Get the owner and collaborators of an exhibition
+//	@Tags			Collaborators
+//	@Security		BearerAuth
+//	@ID				GetCollaborators
+//	@Produce		json
+//	@Param			id	path		string	true	"Exhibition ID"
+//	@Success		200	{object}	[]model.Collaborator
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/collaborators [get]
+func (h *Handler) GetCollaborators(c *gin.Context) {
+	exhibitionID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	collaborators, err := h.CollaboratorService.GetCollaborators(c.Request.Context(), exhibitionID, actor)
+	if err != nil {
+		log.Printf("Error retrieving collaborators of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, collaborators)
+}
@@ -0,0 +1,90 @@
+package collabhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Invite a collaborator
+//	@Description	Invite a user to co-curate an exhibition as editor or viewer. Only the owner can invite.
+//	@Tags			Collaborators
+//	@Security		BearerAuth
+//	@ID				InviteCollaborator
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		string								true	"Exhibition ID"
+//	@Param			requestCollaborator	body		model.RequestInviteCollaborator	true	"Collaborator to invite"
+//	@Success		201
+//	@Failure		400	{object}	helper.APIError	"Invalid request body"
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		409	{object}	helper.APIError	"Collaborator already exists"
+//	@Router			/api/exhibitions/{id}/collaborators [post]
+func (h *Handler) InviteCollaborator(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	var requestCollaborator model.RequestInviteCollaborator
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestCollaborator); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestCollaborator); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	if err := h.CollaboratorService.InviteCollaborator(c.Request.Context(), exhibitionID, actor, &requestCollaborator); err != nil {
+		log.Printf("Error inviting collaborator to exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"message": "Collaborator invited successfully"})
+}
+
+//	@Summary		Accept a collaboration invitation
+//	@Description	Accept a pending invitation to collaborate on an exhibition
+//	@Tags			Collaborators
+//	@Security		BearerAuth
+//	@ID				AcceptInvitation
+//	@Produce		json
+//	@Param			id	path	string	true	"Exhibition ID"
+//	@Success		200
+//	@Failure		404	{object}	helper.APIError	"Invitation not found"
+//	@Router			/api/exhibitions/{id}/collaborators/accept [post]
+func (h *Handler) AcceptInvitation(c *gin.Context) {
+	exhibitionID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	if err := h.CollaboratorService.AcceptInvitation(c.Request.Context(), exhibitionID, actor); err != nil {
+		log.Printf("Error accepting invitation to exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Invitation accepted successfully"})
+}
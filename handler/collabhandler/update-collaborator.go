@@ -0,0 +1,113 @@
+package collabhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Change a collaborator role
+//	@Description	Change the role of a collaborator. Only the owner can change roles.
+//	@Tags			Collaborators
+//	@Security		BearerAuth
+//	@ID				UpdateCollaboratorRole
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string								true	"Exhibition ID"
+//	@Param			userId			path	string								true	"Collaborator user ID"
+//	@Param			updateRequest	body	model.RequestUpdateCollaboratorRole	true	"New role"
+//	@Success		200
+//	@Failure		400	{object}	helper.APIError	"Invalid request body"
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Collaborator not found"
+//	@Router			/api/exhibitions/{id}/collaborators/{userId} [put]
+func (h *Handler) UpdateCollaboratorRole(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	userID := c.Param("userId")
+	var updateRequest model.RequestUpdateCollaboratorRole
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&updateRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(updateRequest); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	if err := h.CollaboratorService.UpdateCollaboratorRole(c.Request.Context(), exhibitionID, userID, actor, updateRequest.Role); err != nil {
+		log.Printf("Error updating collaborator %s of exhibition %s: %v", userID, exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Collaborator updated successfully"})
+}
+
+//	@Summary		Transfer exhibition ownership
+//	@Description	Transfer ownership to an accepted collaborator. The previous owner stays on as editor.
+//	@Tags			Collaborators
+//	@Security		BearerAuth
+//	@ID				TransferOwnership
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string							true	"Exhibition ID"
+//	@Param			transferRequest	body	model.RequestTransferOwnership	true	"New owner"
+//	@Success		200
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Collaborator not found"
+//	@Router			/api/exhibitions/{id}/transfer [post]
+func (h *Handler) TransferOwnership(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	var transferRequest model.RequestTransferOwnership
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&transferRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(transferRequest); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	if err := h.CollaboratorService.TransferOwnership(c.Request.Context(), exhibitionID, actor, &transferRequest); err != nil {
+		log.Printf("Error transferring ownership of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Ownership transferred successfully"})
+}
@@ -0,0 +1,87 @@
+package eventhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/eventsvc"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	EventService        eventsvc.IEventServices
+	CollaboratorService collabsvc.ICollaboratorServices
+}
+
+// stream sends the events selected by the query as Server-Sent Events until the client goes
+// away. Clients resume with the Last-Event-ID header, which browsers send when reconnecting,
+// or the lastEventId query parameter. Errors raised before the stream is open are written as
+// JSON responses, later ones as an error event.
+func (h *Handler) stream(c *gin.Context, query model.EventQuery) {
+	types, err := event.ParseTypes(c.Query("types"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	query.Types = types
+	query.ResumeToken = c.GetHeader("Last-Event-ID")
+	if query.ResumeToken == "" {
+		query.ResumeToken = c.Query("lastEventId")
+	}
+
+	actor, _ := helper.GetActor(c)
+	open := false
+	err = h.EventService.StreamEvents(c.Request.Context(), actor, query, func(domainEvent *model.Event, token string) error {
+		if !open {
+			c.Header("Content-Type", "text/event-stream")
+			c.Header("Cache-Control", "no-cache")
+			c.Header("X-Accel-Buffering", "no")
+			c.Status(http.StatusOK)
+			if err := event.WriteOpen(c.Writer); err != nil {
+				return err
+			}
+			open = true
+		}
+
+		if err := event.WriteEvent(c.Writer, domainEvent, token); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err == nil {
+		return
+	}
+
+	log.Printf("Error streaming events: %v", err)
+	if !open {
+		respondError(c, err)
+		return
+	}
+	if err := event.WriteError(c.Writer, err); err == nil {
+		c.Writer.Flush()
+	}
+}
+
+// respondError writes the HTTP response matching an event service error.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, cerr.ErrInvalidEventType), errors.Is(err, cerr.ErrInvalidResumeToken):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrEventHistoryLost):
+		c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrEventsUnavailable):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
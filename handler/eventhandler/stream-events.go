@@ -0,0 +1,40 @@
+package eventhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//	@Summary		Stream the changes of exhibitions
+//	@Description	Stream the changes of exhibitions as Server-Sent Events for dashboards: created, updated, published, unpublished, banned, moderated and like counts. Events resulting from a moderation action carry it, so exhibitors learn the outcome of reports. Exhibitors follow their own exhibitions, admins every exhibition or those of the owner given by ownerId. Deleted exhibitions and changes of sections and rooms are only streamed to admins following every exhibition. Events, resuming and authentication work as for GET /api/exhibitions/{id}/events.
+//	@Tags			Events
+//	@Security		BearerAuth
+//	@ID				StreamEvents
+//	@Produce		text/event-stream
+//	@Param			ownerId			query		string			false	"Owner of the exhibitions to follow"
+//	@Param			types			query		string			false	"Comma-separated event types to receive, all by default"
+//	@Param			Last-Event-ID	header		string			false	"ID of the last event received, to resume after"
+//	@Param			lastEventId		query		string			false	"ID of the last event received, to resume after"
+//	@Param			access_token	query		string			false	"Bearer token, for clients that cannot set the Authorization header"
+//	@Success		200				{object}	model.Event		"Stream of events"
+//	@Failure		400				{object}	helper.APIError	"Invalid owner ID, event type or last event ID"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		410				{object}	helper.APIError	"Events after the last event ID are no longer available"
+//	@Failure		503				{object}	helper.APIError	"Event streams are not available"
+//	@Router			/api/events [get]
+func (h *Handler) StreamEvents(c *gin.Context) {
+	var query model.EventQuery
+	if ownerID := c.Query("ownerId"); ownerID != "" {
+		id, err := primitive.ObjectIDFromHex(ownerID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid owner ID"})
+			return
+		}
+		query.OwnerID = &id
+	}
+
+	h.stream(c, query)
+}
@@ -0,0 +1,37 @@
+package eventhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Stream the changes of an exhibition
+//	@Description	Stream the changes of an exhibition, its sections and its rooms as Server-Sent Events, so editors see the edits of other collaborators without polling. Every event is named after its type and carries a model.Event as data. section.changed and room.changed carry the ID of the section or room that was created or edited, or none when sections or rooms were added, removed or reordered. Collaborators with any role may follow an exhibition. Browsers pass the bearer token as the access_token query parameter. Reconnecting clients send the ID of the last event they received in the Last-Event-ID header, which EventSource does by itself, and receive the events they missed; the stream also sends ID-only messages while idle so the resume position stays recent.
+//	@Tags			Events
+//	@Security		BearerAuth
+//	@ID				StreamExhibitionEvents
+//	@Produce		text/event-stream
+//	@Param			id				path		string			true	"Exhibition ID"
+//	@Param			types			query		string			false	"Comma-separated event types to receive, all by default"
+//	@Param			Last-Event-ID	header		string			false	"ID of the last event received, to resume after"
+//	@Param			lastEventId		query		string			false	"ID of the last event received, to resume after"
+//	@Param			access_token	query		string			false	"Bearer token, for clients that cannot set the Authorization header"
+//	@Success		200				{object}	model.Event		"Stream of events"
+//	@Failure		400				{object}	helper.APIError	"Invalid event type or last event ID"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError	"Exhibition not found"
+//	@Failure		410				{object}	helper.APIError	"Events after the last event ID are no longer available"
+//	@Failure		503				{object}	helper.APIError	"Event streams are not available"
+//	@Router			/api/exhibitions/{id}/events [get]
+func (h *Handler) StreamExhibitionEvents(c *gin.Context) {
+	actor, _ := helper.GetActor(c)
+	access, err := h.CollaboratorService.Authorize(c.Request.Context(), c.Param("id"), actor, model.RoleViewer)
+	if err != nil {
+		helper.RespondAccessError(c, err)
+		return
+	}
+
+	h.stream(c, model.EventQuery{ExhibitionID: &access.ID})
+}
@@ -1,8 +1,10 @@
 package exhibihandler
 
 import (
-	_ "atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
 	"atommuse/backend/exhibition-service/pkg/model"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -12,7 +14,7 @@ import (
 )
 
 // @Summary		Create a new exhibition
-// @Description	Create a new exhibition data
+// @Description	Create a new exhibition data. Its texts are screened: an exhibition with flagged texts is held for review by a moderator instead of being published, and blocked texts are rejected with the rules they matched.
 // @Tags			Exhibitions
 // @Security		BearerAuth
 // @ID				CreateExhibition
@@ -20,7 +22,8 @@ import (
 // @Produce		json
 // @Param			requestExhibition	body		model.RequestCreateExhibition	true	"Exhibition data to create"
 // @Success		201					{object}	model.ResponseGetExhibitionId	"Success"
-// @Failure		400					{object}	helper.APIError					"Invalid request body"
+// @Failure		400					{object}	helper.APIError					"Invalid request body, unsupported layout or invalid venue location"
+// @Failure		422					{object}	helper.APIError					"Content blocked by screening"
 // @Router			/api/exhibitions [post]
 func (h *Handler) CreateExhibition(c *gin.Context) {
 
@@ -59,6 +62,13 @@ func (h *Handler) CreateExhibition(c *gin.Context) {
 
 	// Call use case to create exhibition
 	objectID, err := h.ExhibitionService.CreateExhibition(c.Request.Context(), &requestExhibition)
+	if helper.RespondBlockedContent(c, err) {
+		return
+	}
+	if errors.Is(err, cerr.ErrUnsupportedLayout) || errors.Is(err, cerr.ErrInvalidLocation) {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"errorMessage": "Failed to create exhibition"})
 		return
@@ -1,6 +1,8 @@
 package exhibihandler
 
 import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
 	"errors"
 	"log"
 	"net/http"
@@ -17,11 +19,19 @@ import (
 //	@Produce		json
 //	@Param			id	path		string							true	"Exhibition ID"
 //	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete Exhibition Success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
 //	@Failure		500	{object}	helper.APIError					"Internal server error"
 //	@Router			/api/exhibitions/{id} [delete]
 func (h *Handler) DeleteExhibition(c *gin.Context) {
 	exhibitionID := c.Param("id")
 
+	// Only the owner may delete the exhibition
+	actor, _ := helper.GetActor(c)
+	if _, err := h.CollaboratorService.Authorize(c.Request.Context(), exhibitionID, actor, model.RoleOwner); err != nil {
+		helper.RespondAccessError(c, err)
+		return
+	}
+
 	err := h.ExhibitionService.DeleteExhibition(c.Request.Context(), exhibitionID)
 	if err != nil {
 		log.Printf("Error deleting exhibition %s: %v", exhibitionID, err)
@@ -1,10 +1,12 @@
 package exhibihandler
 
 import (
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
 	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
 )
 
 // Handler is responsible for handling HTTP requests.
 type Handler struct {
-	ExhibitionService exhibisvc.IExhibitionServices
+	ExhibitionService   exhibisvc.IExhibitionServices
+	CollaboratorService collabsvc.ICollaboratorServices
 }
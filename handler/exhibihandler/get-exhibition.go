@@ -1,13 +1,15 @@
 package exhibihandler
 
 import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
 	"atommuse/backend/exhibition-service/pkg/model"
-	"fmt"
+	"errors"
 	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // @Summary		Get all exhibitions
@@ -28,44 +30,36 @@ func (h *Handler) GetAllExhibitions(c *gin.Context) {
 		return
 	}
 
-	// Return the exhibition details
+	// Return the exhibition details in the language of the client
+	i18n.LocalizeAll(exhibitions, helper.Languages(c))
 	c.JSON(http.StatusOK, exhibitions)
 }
 
 // @Summary		Get exhibition by ID
-// @Description	Get exhibition data by exhibitionID
+// @Description	Get exhibition data by exhibitionID. Private exhibitions are only returned to the owner, collaborators, admins or holders of a valid share link.
 // @Tags			Exhibitions
 // @Security		BearerAuth
 // @ID				GetExhibitionByID
 // @Produce		json
-// @Param			id	path		string	true	"Exhibition ID"
-// @Success		200	{object}	model.ResponseExhibition
-// @Failure		500	{object}	helper.APIError	"Internal server error"
+// @Param			id					path		string	true	"Exhibition ID"
+// @Param			share				query		string	false	"Share link token"
+// @Param			X-Share-Token		header		string	false	"Share link token"
+// @Param			X-Share-Password	header		string	false	"Share link password"
+// @Param			lang				query		string	false	"Preferred locales, before those of the Accept-Language header"
+// @Success		200					{object}	model.ResponseExhibition
+// @Failure		401					{object}	helper.APIError	"Share link password required or invalid"
+// @Failure		404					{object}	helper.APIError	"Exhibition not found"
+// @Failure		410					{object}	helper.APIError	"Share link expired"
+// @Failure		500					{object}	helper.APIError	"Internal server error"
 // @Router			/api/exhibitions/{id} [get]
 func (h *Handler) GetExhibitionByID(c *gin.Context) {
-	userID, exists := c.Get("user_id")
-	var userIDString string
-	fmt.Println(userID)
-
-	if exists {
-		switch id := userID.(type) {
-		case primitive.ObjectID:
-			userIDString = id.Hex()
-		case string:
-			userIDString = id
-		default:
-			// Handle the case where userID is not a string or ObjectID
-			log.Println("User ID is not a string or ObjectID")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
-			return
-		}
-	}
+	viewer := helper.GetViewer(c)
 
 	exhibitionID := c.Param("id")
-	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, userIDString)
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, viewer)
 	if err != nil {
 		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		RespondViewError(c, err)
 		return
 	}
 
@@ -78,7 +72,9 @@ func (h *Handler) GetExhibitionByID(c *gin.Context) {
 		// Handle the error accordingly
 	}
 
-	// Return the exhibition details
+	// Return the exhibition details in the language of the client
+	i18n.Localize(exhibition, helper.Languages(c))
+	c.Header("Content-Language", exhibition.Locale)
 	c.JSON(http.StatusOK, exhibition)
 }
 
@@ -100,12 +96,13 @@ func (h *Handler) GetExhibitionsIsPublic(c *gin.Context) {
 		return
 	}
 
-	// Return the exhibition details
+	// Return the exhibition details in the language of the client
+	i18n.LocalizeAll(exhibitions, helper.Languages(c))
 	c.JSON(http.StatusOK, exhibitions)
 }
 
 // @Summary		Get exhibition by UserID
-// @Description	Get exhibition data by exhibitionUserID
+// @Description	Get the exhibitions a user owns or collaborates on. Other users than the user and admins only get the published ones.
 // @Tags			Exhibitions
 // @Security		BearerAuth
 // @ID				GetExhibitionByUserID
@@ -119,13 +116,18 @@ func (h *Handler) GetExhibitionByUserID(c *gin.Context) {
 	userID := c.Param("userId")
 
 	// Retrieve exhibitions by user ID from the service layer
-	exhibitions, err := h.ExhibitionService.GetExhibitionByUserID(c.Request.Context(), userID)
+	exhibitions, err := h.ExhibitionService.GetExhibitionByUserID(c.Request.Context(), userID, helper.GetViewer(c))
 	if err != nil {
-		log.Printf("Error retrieving exhibitions for user ID %d: %v", userID, err)
+		log.Printf("Error retrieving exhibitions for user ID %s: %v", userID, err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err})
 		return
 	}
 
+	languages := helper.Languages(c)
+	for _, exhibition := range exhibitions {
+		i18n.Localize(exhibition, languages)
+	}
+
 	c.JSON(http.StatusOK, exhibitions)
 }
 
@@ -140,7 +142,8 @@ func (h *Handler) GetExhibitionsByCategory(c *gin.Context) {
 		return
 	}
 
-	// Return the exhibition details
+	// Return the exhibition details in the language of the client
+	i18n.LocalizeAll(exhibitions, helper.Languages(c))
 	c.JSON(http.StatusOK, exhibitions)
 }
 
@@ -168,7 +171,8 @@ func (h *Handler) GetExhibitionsByStatus(c *gin.Context) {
 		return
 	}
 
-	// Return the exhibition details
+	// Return the exhibition details in the language of the client
+	i18n.LocalizeAll(exhibitions, helper.Languages(c))
 	c.JSON(http.StatusOK, exhibitions)
 }
 
@@ -199,6 +203,21 @@ func (h *Handler) GetExhibitionsByFilter(c *gin.Context) {
 		return
 	}
 
-	// Return the exhibition details
+	// Return the exhibition details in the language of the client
+	i18n.LocalizeAll(exhibitions, helper.Languages(c))
 	c.JSON(http.StatusOK, exhibitions)
 }
+
+// RespondViewError writes the HTTP response matching an error returned when viewing an exhibition.
+func RespondViewError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, cerr.ErrExhibitionNotFound), errors.Is(err, cerr.ErrShareLinkNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": cerr.ErrExhibitionNotFound.Error()})
+	case errors.Is(err, cerr.ErrShareLinkExpired):
+		c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrPasswordRequired), errors.Is(err, cerr.ErrPasswordInvalid):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,52 @@
+package exhibihandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/geo"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get exhibitions near a place
+//	@Description	Get published exhibitions whose venue lies within a radius of a place, nearest first, with the distance of the venue in meters
+//	@Tags			Exhibitions
+//	@ID				GetNearbyExhibitions
+//	@Produce		json
+//	@Param			lat		query		number	true	"Latitude"
+//	@Param			lng		query		number	true	"Longitude"
+//	@Param			radius	query		number	false	"Radius in meters (default 10000, at most 200000)"
+//	@Param			limit	query		int		false	"Maximum number of results (default 50)"
+//	@Param			lang	query		string	false	"Preferred locales, before those of the Accept-Language header"
+//	@Success		200		{object}	[]model.ResponseNearbyExhibition
+//	@Failure		400		{object}	helper.APIError	"Invalid location or radius"
+//	@Failure		500		{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/nearby [get]
+func (h *Handler) GetNearbyExhibitions(c *gin.Context) {
+	query, err := geo.ParseNearby(c.Query("lat"), c.Query("lng"), c.Query("radius"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	query.Limit = searchLimit
+	if value, err := strconv.Atoi(c.Query("limit")); err == nil && value > 0 && value < searchLimit {
+		query.Limit = value
+	}
+
+	exhibitions, err := h.ExhibitionService.GetNearbyExhibitions(c.Request.Context(), query)
+	if err != nil {
+		log.Printf("Error retrieving nearby exhibitions: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+
+	languages := helper.Languages(c)
+	for i := range exhibitions {
+		i18n.Localize(&exhibitions[i].ResponseExhibition, languages)
+	}
+	c.JSON(http.StatusOK, exhibitions)
+}
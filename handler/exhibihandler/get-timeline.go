@@ -0,0 +1,52 @@
+package exhibihandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/timeline"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the timeline of an exhibition
+//	@Description	Get the entries of a timelineLayout exhibition in chronological order, grouped by era. The exhibition is visible to the same users as GET /api/exhibitions/{id}.
+//	@Tags			Timeline
+//	@Security		BearerAuth
+//	@ID				GetExhibitionTimeline
+//	@Produce		json
+//	@Param			id					path		string	true	"Exhibition ID"
+//	@Param			share				query		string	false	"Share link token"
+//	@Param			X-Share-Token		header		string	false	"Share link token"
+//	@Param			X-Share-Password	header		string	false	"Share link password"
+//	@Param			lang				query		string	false	"Preferred locales, before those of the Accept-Language header"
+//	@Success		200					{object}	model.ResponseTimeline
+//	@Failure		404					{object}	helper.APIError	"Exhibition not found"
+//	@Failure		409					{object}	helper.APIError	"Exhibition does not use the timeline layout"
+//	@Router			/api/exhibitions/{id}/timeline [get]
+func (h *Handler) GetExhibitionTimeline(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		RespondViewError(c, err)
+		return
+	}
+
+	if exhibition.LayoutUsed != layout.Timeline {
+		c.JSON(http.StatusConflict, gin.H{"error": cerr.ErrLayoutMismatch.Error()})
+		return
+	}
+
+	i18n.Localize(exhibition, helper.Languages(c))
+	c.Header("Content-Language", exhibition.Locale)
+	c.JSON(http.StatusOK, model.ResponseTimeline{
+		ExhibitionID: exhibition.ID,
+		Locale:       exhibition.Locale,
+		Eras:         timeline.Eras(exhibition.Timeline),
+	})
+}
@@ -0,0 +1,50 @@
+package exhibihandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get translation status of an exhibition
+//	@Description	Report for every locale of an exhibition how many of its texts are translated and which are missing
+//	@Tags			Exhibitions
+//	@Security		BearerAuth
+//	@ID				GetExhibitionTranslations
+//	@Produce		json
+//	@Param			id	path		string	true	"Exhibition ID"
+//	@Success		200	{object}	model.ResponseTranslations
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
+//	@Router			/api/exhibitions/{id}/translations [get]
+func (h *Handler) GetExhibitionTranslations(c *gin.Context) {
+	exhibitionID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Translating is part of editing the exhibition
+	if _, err := h.CollaboratorService.Authorize(c.Request.Context(), exhibitionID, actor, model.RoleEditor); err != nil {
+		helper.RespondAccessError(c, err)
+		return
+	}
+
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		RespondViewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ResponseTranslations{
+		DefaultLocale: i18n.DefaultLocale(exhibition),
+		Locales:       i18n.Completeness(exhibition),
+	})
+}
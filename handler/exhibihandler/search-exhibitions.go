@@ -0,0 +1,56 @@
+package exhibihandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// searchLimit is the number of results returned unless the client asks for fewer.
+const searchLimit = 50
+
+//	@Summary		Search exhibitions
+//	@Description	Full text search over the texts of published exhibitions in every locale, best matches first. Search terms are analysed in the preferred locale of the client.
+//	@Tags			Exhibitions
+//	@ID				SearchExhibitions
+//	@Produce		json
+//	@Param			q		query		string	true	"Search terms"
+//	@Param			lang	query		string	false	"Preferred locales, before those of the Accept-Language header"
+//	@Param			limit	query		int		false	"Maximum number of results (default 50)"
+//	@Success		200		{object}	[]model.ResponseExhibition
+//	@Failure		400		{object}	helper.APIError	"Missing search terms"
+//	@Failure		500		{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/search [get]
+func (h *Handler) SearchExhibitions(c *gin.Context) {
+	text := c.Query("q")
+	if text == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Search terms are required"})
+		return
+	}
+
+	limit := searchLimit
+	if value, err := strconv.Atoi(c.Query("limit")); err == nil && value > 0 && value < searchLimit {
+		limit = value
+	}
+
+	languages := helper.Languages(c)
+	locale := model.DefaultLocale
+	if len(languages) > 0 {
+		locale = languages[0].String()
+	}
+
+	exhibitions, err := h.ExhibitionService.SearchExhibitions(c.Request.Context(), text, locale, limit)
+	if err != nil {
+		log.Printf("Error searching exhibitions: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+
+	i18n.LocalizeAll(exhibitions, languages)
+	c.JSON(http.StatusOK, exhibitions)
+}
@@ -1,19 +1,22 @@
 package exhibihandler
 
 import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
 	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
-	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // UpdateExhibition godoc
 //
 //	@Summary		Update exhibition by ID
-//	@Description	Update exhibition data by exhibitionID
+//	@Description	Update exhibition data by exhibitionID. Its texts are screened: flagged texts hold the exhibition for review by a moderator, unpublishing it until it is approved, and blocked texts are rejected with the rules they matched. Owners cannot change the status of an exhibition held for review.
 //	@Tags			Exhibitions
 //	@Security		BearerAuth
 //	@ID				UpdateExhibition
@@ -23,32 +26,30 @@ import (
 //	@Param			updateRequest	body		model.RequestUpdateExhibition	true	"Exhibition data to update"
 //
 //	@Success		200				{object}	model.ResponseExhibition
+//	@Failure		400				{object}	helper.APIError	"Invalid request body, unsupported layout, invalid venue location, or a section or room of another exhibition"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		422				{object}	helper.APIError	"Media is under embargo or content blocked by screening"
 //	@Failure		500				{object}	helper.APIError	"Internal server error"
 //	@Router			/api/exhibitions/{id} [put]
 func (h *Handler) UpdateExhibition(c *gin.Context) {
 
 	// Get user information from request context
-	userID, _ := c.Get("user_id")
-	firstName, _ := c.Get("user_first_name")
-	lastName, _ := c.Get("user_last_name")
-	profileImage, _ := c.Get("user_image")
-	username, _ := c.Get("user_username")
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID is invalid"})
+		return
+	}
 
 	exhibitionID := c.Param("id") // assuming exhibition ID is part of the URL
 	var validate = validator.New()
 	var updateRequest model.RequestUpdateExhibition
 
-	// Check if userID is nil or not of type primitive.ObjectID
-	objectID, ok := userID.(primitive.ObjectID)
-	if !ok {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID is invalid"})
+	// Only the owner and editors may update the exhibition
+	access, err := h.CollaboratorService.Authorize(c.Request.Context(), exhibitionID, actor, model.RoleEditor)
+	if err != nil {
+		helper.RespondAccessError(c, err)
 		return
 	}
-	updateRequest.UserID.UserID = objectID
-	updateRequest.UserID.FirstName = firstName.(string)
-	updateRequest.UserID.LastName = lastName.(string)
-	updateRequest.UserID.ProfileImage = profileImage.(string)
-	updateRequest.UserID.Username = username.(string)
 
 	// Parse request body
 	if err := c.BindJSON(&updateRequest); err != nil {
@@ -56,6 +57,12 @@ func (h *Handler) UpdateExhibition(c *gin.Context) {
 		return
 	}
 
+	// Refresh the owner profile only when the owner updates; editors leave it untouched
+	updateRequest.UserID = model.UserID{}
+	if collabsvc.RoleOf(access, actor.UserID.UserID) == model.RoleOwner {
+		updateRequest.UserID = actor.UserID
+	}
+
 	// Validate the request body
 	if err := validate.Struct(updateRequest); err != nil {
 		var validationErrors []string
@@ -69,6 +76,13 @@ func (h *Handler) UpdateExhibition(c *gin.Context) {
 	// Call use case to update exhibition
 	updatedObjectID, err := h.ExhibitionService.UpdateExhibition(c.Request.Context(), exhibitionID, &updateRequest)
 	if err != nil {
+		if respondEmbargoError(c, err) || helper.RespondBlockedContent(c, err) {
+			return
+		}
+		if errors.Is(err, cerr.ErrUnsupportedLayout) || errors.Is(err, cerr.ErrInvalidLocation) || errors.Is(err, cerr.ErrForeignContent) {
+			c.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update exhibition"})
 		return
 	}
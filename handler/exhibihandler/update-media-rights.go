@@ -0,0 +1,87 @@
+package exhibihandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/rights"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update media rights of an exhibition
+//	@Description	Replace the rights holder, licence, attribution and embargo date of the media an exhibition uses. Public exhibitions may not use embargoed media.
+//	@Tags			Exhibitions
+//	@Security		BearerAuth
+//	@ID				UpdateMediaRights
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		string						true	"Exhibition ID"
+//	@Param			requestMediaRights	body		model.RequestMediaRights	true	"Media rights"
+//	@Success		200					{object}	model.ResponseGetExhibitionId
+//	@Failure		400					{object}	helper.APIError	"Invalid request body"
+//	@Failure		403					{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		422					{object}	helper.APIError	"Media is under embargo"
+//	@Router			/api/exhibitions/{id}/media-rights [put]
+func (h *Handler) UpdateMediaRights(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	var requestMediaRights model.RequestMediaRights
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Only the owner and editors may change the rights metadata
+	if _, err := h.CollaboratorService.Authorize(c.Request.Context(), exhibitionID, actor, model.RoleEditor); err != nil {
+		helper.RespondAccessError(c, err)
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestMediaRights); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestMediaRights); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+	if problems := rights.Validate(requestMediaRights.MediaRights); len(problems) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": problems})
+		return
+	}
+
+	if err := h.ExhibitionService.UpdateMediaRights(c.Request.Context(), exhibitionID, requestMediaRights.MediaRights); err != nil {
+		log.Printf("Error updating media rights of exhibition %s: %v", exhibitionID, err)
+		if !respondEmbargoError(c, err) {
+			helper.RespondAccessError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": exhibitionID})
+}
+
+// respondEmbargoError writes a 422 response listing the embargoed media when err is an
+// embargo error. It reports whether a response was written.
+func respondEmbargoError(c *gin.Context, err error) bool {
+	var embargo *rights.EmbargoError
+	if !errors.As(err, &embargo) {
+		return false
+	}
+	c.JSON(http.StatusUnprocessableEntity, gin.H{"error": embargo.Error(), "embargoed": embargo.Refs})
+	return true
+}
@@ -0,0 +1,72 @@
+package moderationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Appeal a moderation action
+//	@Description	Ask the moderators to reconsider a warning, unpublishing or ban of an exhibition. Only the owner may appeal, once per action.
+//	@Tags			Moderation
+//	@Security		BearerAuth
+//	@ID				AppealModerationAction
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string				true	"Exhibition ID"
+//	@Param			requestAppeal	body		model.RequestAppeal	true	"Appeal"
+//	@Success		201				{object}	model.Appeal
+//	@Failure		400				{object}	helper.APIError	"Invalid request body or action cannot be appealed"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError	"Exhibition or action not found"
+//	@Failure		409				{object}	helper.APIError	"Action is already appealed"
+//	@Failure		500				{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/appeals [post]
+func (h *Handler) AppealAction(c *gin.Context) {
+	var requestAppeal model.RequestAppeal
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	exhibitionID := c.Param("id")
+
+	// Only the owner may appeal
+	if _, err := h.CollaboratorService.Authorize(c.Request.Context(), exhibitionID, actor, model.RoleOwner); err != nil {
+		helper.RespondAccessError(c, err)
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestAppeal); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestAppeal); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	appeal, err := h.ModerationService.AppealAction(c.Request.Context(), actor, exhibitionID, &requestAppeal)
+	if err != nil {
+		log.Printf("Error appealing moderation of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, appeal)
+}
@@ -0,0 +1,66 @@
+package moderationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		BanExhibition
+//	@Description	Ban an exhibition. This is the ban action of POST /api/moderation/exhibitions/{id}/actions: the reason is required, the ban is recorded with the moderator, the owner is told and can appeal it, and the open reports are resolved.
+//	@Tags			Ban
+//	@Security		BearerAuth
+//	@ID				BanExhibition
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		string						true	"Exhibition ID"
+//	@Param			requestBan			body		model.RequestBanExhibition	true	"Reason of the ban"
+//	@Success		201					{object}	model.ModerationAction
+//	@Failure		400					{object}	helper.APIError	"Invalid request body"
+//	@Failure		404					{object}	helper.APIError	"Exhibition not found"
+//	@Failure		409					{object}	helper.APIError	"Exhibition is already banned"
+//	@Failure		500					{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/ban [post]
+func (h *Handler) BanExhibition(c *gin.Context) {
+	var requestBan model.RequestBanExhibition
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	exhibitionID := c.Param("id")
+
+	// Parse request body
+	if err := c.BindJSON(&requestBan); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestBan); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	requestAction := model.RequestModerationAction{Action: model.ModerationBan, Reason: requestBan.Reason}
+	action, err := h.ModerationService.TakeAction(c.Request.Context(), actor, exhibitionID, &requestAction)
+	if err != nil {
+		log.Printf("Error banning exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, action)
+}
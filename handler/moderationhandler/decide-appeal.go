@@ -0,0 +1,98 @@
+package moderationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Get appeals
+//	@Description	Get the appeals against moderation actions, oldest first.
+//	@Tags			Moderation
+//	@Security		BearerAuth
+//	@ID				GetAppeals
+//	@Produce		json
+//	@Param			status	query		string	false	"Appeal status"	Enums(pending, granted, rejected)
+//	@Success		200		{object}	[]model.Appeal
+//	@Failure		400		{object}	helper.APIError	"Invalid status"
+//	@Failure		403		{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500		{object}	helper.APIError	"Internal server error"
+//	@Router			/api/moderation/appeals [get]
+func (h *Handler) GetAppeals(c *gin.Context) {
+	status := c.Query("status")
+
+	switch status {
+	case "", model.AppealPending, model.AppealGranted, model.AppealRejected:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid appeal status"})
+		return
+	}
+
+	appeals, err := h.ModerationService.GetAppeals(c.Request.Context(), status)
+	if err != nil {
+		log.Printf("Error retrieving appeals: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, appeals)
+}
+
+//	@Summary		Decide an appeal
+//	@Description	Grant or reject a pending appeal with a reason. Granting the appeal of a ban unbans the exhibition, which tells the owner through an event of the exhibition.
+//	@Tags			Moderation
+//	@Security		BearerAuth
+//	@ID				DecideAppeal
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		string						true	"Appeal ID"
+//	@Param			requestAppealDecision	body		model.RequestAppealDecision	true	"Decision"
+//	@Success		200						{object}	model.Appeal
+//	@Failure		400						{object}	helper.APIError	"Invalid request body"
+//	@Failure		403						{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404						{object}	helper.APIError	"Appeal not found"
+//	@Failure		409						{object}	helper.APIError	"Appeal is already decided"
+//	@Failure		500						{object}	helper.APIError	"Internal server error"
+//	@Router			/api/moderation/appeals/{id}/decision [post]
+func (h *Handler) DecideAppeal(c *gin.Context) {
+	var requestDecision model.RequestAppealDecision
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	appealID := c.Param("id")
+
+	// Parse request body
+	if err := c.BindJSON(&requestDecision); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestDecision); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	appeal, err := h.ModerationService.DecideAppeal(c.Request.Context(), actor, appealID, &requestDecision)
+	if err != nil {
+		log.Printf("Error deciding appeal %s: %v", appealID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, appeal)
+}
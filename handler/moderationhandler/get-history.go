@@ -0,0 +1,47 @@
+package moderationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the moderation history of an exhibition
+//	@Description	Get the moderation actions taken on an exhibition, most recent first, and the appeals against them. Available to the owner and admins.
+//	@Tags			Moderation
+//	@Security		BearerAuth
+//	@ID				GetModerationHistory
+//	@Produce		json
+//	@Param			id	path		string	true	"Exhibition ID"
+//	@Success		200	{object}	model.ModerationHistory
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/moderation [get]
+func (h *Handler) GetHistory(c *gin.Context) {
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	exhibitionID := c.Param("id")
+
+	// Only the owner learns about the moderation of the exhibition
+	if _, err := h.CollaboratorService.Authorize(c.Request.Context(), exhibitionID, actor, model.RoleOwner); err != nil {
+		helper.RespondAccessError(c, err)
+		return
+	}
+
+	history, err := h.ModerationService.GetHistory(c.Request.Context(), exhibitionID)
+	if err != nil {
+		log.Printf("Error retrieving moderation history of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
@@ -0,0 +1,93 @@
+package moderationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Default and largest number of cases listed at once.
+const (
+	defaultQueueLimit = 50
+	maxQueueLimit     = 200
+)
+
+//	@Summary		Get the moderation queue
+//	@Description	Get the exhibitions with open reports, the most reported first, including those the content screening held for review with a report in the screening category, with the number of open reports in every category, when they were first and last reported and the last action taken on them.
+//	@Tags			Moderation
+//	@Security		BearerAuth
+//	@ID				GetModerationQueue
+//	@Produce		json
+//	@Param			category	query		string	false	"Only exhibitions with open reports in this category"	Enums(spam, offensive, harassment, copyright, misleading, other, screening)
+//	@Param			limit		query		int		false	"Number of exhibitions, 50 by default and at most 200"
+//	@Success		200			{object}	[]model.ModerationCase
+//	@Failure		400			{object}	helper.APIError	"Invalid category or limit"
+//	@Failure		403			{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500			{object}	helper.APIError	"Internal server error"
+//	@Router			/api/moderation/queue [get]
+func (h *Handler) GetQueue(c *gin.Context) {
+	query := model.ModerationQuery{Category: c.Query("category"), Limit: defaultQueueLimit}
+
+	switch query.Category {
+	case "", model.ReportSpam, model.ReportOffensive, model.ReportHarassment, model.ReportCopyright, model.ReportMisleading, model.ReportOther, model.ReportScreening:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report category"})
+		return
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		value, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || value < 1 || value > maxQueueLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+		query.Limit = value
+	}
+
+	cases, err := h.ModerationService.GetQueue(c.Request.Context(), query)
+	if err != nil {
+		log.Printf("Error retrieving moderation queue: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cases)
+}
+
+//	@Summary		Get the reports of an exhibition
+//	@Description	Get the reports of an exhibition, most recent first. Resolved reports name the action that resolved them.
+//	@Tags			Moderation
+//	@Security		BearerAuth
+//	@ID				GetExhibitionReports
+//	@Produce		json
+//	@Param			id		path		string	true	"Exhibition ID"
+//	@Param			status	query		string	false	"Report status"	Enums(open, resolved)
+//	@Success		200		{object}	[]model.Report
+//	@Failure		400		{object}	helper.APIError	"Invalid status"
+//	@Failure		403		{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404		{object}	helper.APIError	"Exhibition not found"
+//	@Failure		500		{object}	helper.APIError	"Internal server error"
+//	@Router			/api/moderation/exhibitions/{id}/reports [get]
+func (h *Handler) GetReports(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	status := c.Query("status")
+
+	switch status {
+	case "", model.ReportOpen, model.ReportResolved:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report status"})
+		return
+	}
+
+	reports, err := h.ModerationService.GetReports(c.Request.Context(), exhibitionID, status)
+	if err != nil {
+		log.Printf("Error retrieving reports of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
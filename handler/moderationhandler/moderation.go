@@ -0,0 +1,34 @@
+package moderationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/service/moderationsvc"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	ModerationService   moderationsvc.IModerationServices
+	ExhibitionService   exhibisvc.IExhibitionServices
+	CollaboratorService collabsvc.ICollaboratorServices
+}
+
+// respondError writes the HTTP response matching a moderation service error.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, cerr.ErrExhibitionNotFound), errors.Is(err, cerr.ErrModerationNotFound), errors.Is(err, cerr.ErrAppealNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrAlreadyReported), errors.Is(err, cerr.ErrModerationNotApplicable),
+		errors.Is(err, cerr.ErrAlreadyAppealed), errors.Is(err, cerr.ErrAppealDecided):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrNotAppealable):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
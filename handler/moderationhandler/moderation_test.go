@@ -0,0 +1,59 @@
+package moderationhandler_test
+
+import (
+	"atommuse/backend/exhibition-service/handler/moderationhandler"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/moderationsvc"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type moderationService struct {
+	moderationsvc.IModerationServices
+	request *model.RequestModerationAction
+}
+
+func (s *moderationService) TakeAction(ctx context.Context, actor model.Actor, exhibitionID string, request *model.RequestModerationAction) (*model.ModerationAction, error) {
+	s.request = request
+	return &model.ModerationAction{Action: request.Action, Reason: request.Reason}, nil
+}
+
+func TestBanExhibition(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"with a reason", `{"reason":"Hate speech"}`, http.StatusCreated},
+		{"without a reason", `{}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &moderationService{}
+			handler := moderationhandler.Handler{ModerationService: service}
+			exhibitionID := primitive.NewObjectID().Hex()
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/exhibitions/"+exhibitionID+"/ban", strings.NewReader(tt.body))
+			c.Params = gin.Params{{Key: "id", Value: exhibitionID}}
+			c.Set("user_id", primitive.NewObjectID())
+
+			handler.BanExhibition(c)
+			assert.Equal(t, tt.status, recorder.Code)
+			if tt.status == http.StatusCreated {
+				assert.Equal(t, &model.RequestModerationAction{Action: model.ModerationBan, Reason: "Hate speech"}, service.request)
+			} else {
+				assert.Nil(t, service.request)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package moderationhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Report an exhibition
+//	@Description	Report an exhibition the user can see to the moderators, with a reason category and optional details. A user has one open report per exhibition; they may report it again once moderators acted on it.
+//	@Tags			Moderation
+//	@Security		BearerAuth
+//	@ID				ReportExhibition
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string				true	"Exhibition ID"
+//	@Param			requestReport	body		model.RequestReport	true	"Report"
+//	@Success		201				{object}	model.ResponseGetExhibitionId
+//	@Failure		400				{object}	helper.APIError	"Invalid request body"
+//	@Failure		401				{object}	helper.APIError	"Authorization token is required"
+//	@Failure		404				{object}	helper.APIError	"Exhibition not found"
+//	@Failure		409				{object}	helper.APIError	"Exhibition is already reported"
+//	@Failure		500				{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/reports [post]
+func (h *Handler) ReportExhibition(c *gin.Context) {
+	var requestReport model.RequestReport
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	exhibitionID := c.Param("id")
+
+	// Parse request body
+	if err := c.BindJSON(&requestReport); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestReport); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only exhibitions the user can see can be reported
+	if _, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c)); err != nil {
+		log.Printf("Error retrieving exhibition %s to report: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	id, err := h.ModerationService.ReportExhibition(c.Request.Context(), actor, exhibitionID, &requestReport)
+	if err != nil {
+		log.Printf("Error reporting exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": id})
+}
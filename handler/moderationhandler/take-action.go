@@ -0,0 +1,66 @@
+package moderationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Act on an exhibition
+//	@Description	Take a moderation action on an exhibition and resolve its open reports: dismiss the reports, warn the owner, unpublish the exhibition until the owner publishes it again, ban it, unban it, or approve an exhibition the content screening held for review. Only public exhibitions can be unpublished, only banned ones unbanned and only held ones approved. The action, the moderator, the reason and the time are recorded, and the owner is told through an event of the exhibition carrying the action.
+//	@Tags			Moderation
+//	@Security		BearerAuth
+//	@ID				TakeModerationAction
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		string							true	"Exhibition ID"
+//	@Param			requestModerationAction	body		model.RequestModerationAction	true	"Action"
+//	@Success		201						{object}	model.ModerationAction
+//	@Failure		400						{object}	helper.APIError	"Invalid request body"
+//	@Failure		403						{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404						{object}	helper.APIError	"Exhibition not found"
+//	@Failure		409						{object}	helper.APIError	"Action does not apply to the exhibition"
+//	@Failure		500						{object}	helper.APIError	"Internal server error"
+//	@Router			/api/moderation/exhibitions/{id}/actions [post]
+func (h *Handler) TakeAction(c *gin.Context) {
+	var requestAction model.RequestModerationAction
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	exhibitionID := c.Param("id")
+
+	// Parse request body
+	if err := c.BindJSON(&requestAction); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestAction); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	action, err := h.ModerationService.TakeAction(c.Request.Context(), actor, exhibitionID, &requestAction)
+	if err != nil {
+		log.Printf("Error taking moderation action on exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, action)
+}
@@ -0,0 +1,61 @@
+package poihandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Create a point of interest
+//	@Description	Add a point of interest to a mapLayout exhibition. The location is a GeoJSON point with coordinates in [longitude, latitude] order.
+//	@Tags			Map
+//	@Security		BearerAuth
+//	@ID				CreatePointOfInterest
+//	@Accept			json
+//	@Produce		json
+//	@Param			requestPointOfInterest	body		model.RequestCreatePointOfInterest	true	"Point of interest data to create"
+//	@Success		201						{object}	model.ResponseGetExhibitionId		"Success"
+//	@Failure		400						{object}	helper.APIError						"Invalid request body or location"
+//	@Failure		403						{object}	helper.APIError						"Insufficient permissions"
+//	@Failure		404						{object}	helper.APIError						"Exhibition not found"
+//	@Failure		409						{object}	helper.APIError						"Exhibition does not use the map layout"
+//	@Router			/api/points-of-interest [post]
+func (h *Handler) CreatePointOfInterest(c *gin.Context) {
+	var requestPointOfInterest model.RequestCreatePointOfInterest
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&requestPointOfInterest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestPointOfInterest); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may add to the exhibition
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, requestPointOfInterest.ExhibitionID.Hex(), model.RoleEditor); !ok {
+		return
+	}
+
+	objectID, err := h.PointOfInterestService.CreatePointOfInterest(c.Request.Context(), &requestPointOfInterest)
+	if err != nil {
+		log.Printf("Error creating point of interest: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": objectID.Hex()})
+}
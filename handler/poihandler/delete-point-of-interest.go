@@ -0,0 +1,36 @@
+package poihandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete point of interest by ID
+//	@Description	Remove a point of interest from the map of its exhibition
+//	@Tags			Map
+//	@Security		BearerAuth
+//	@ID				DeletePointOfInterest
+//	@Produce		json
+//	@Param			id	path		string							true	"Point of interest ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete point of interest success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError					"Point of interest not found"
+//	@Router			/api/points-of-interest/{id} [delete]
+func (h *Handler) DeletePointOfInterest(c *gin.Context) {
+	pointID := c.Param("id")
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizePoint(c, pointID) == nil {
+		return
+	}
+
+	if err := h.PointOfInterestService.DeletePointOfInterest(c.Request.Context(), pointID); err != nil {
+		log.Printf("Error deleting point of interest %s: %v", pointID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": pointID + " has been deleted."})
+}
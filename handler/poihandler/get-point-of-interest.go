@@ -0,0 +1,27 @@
+package poihandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get point of interest by ID
+//	@Description	Get a point of interest with all its translations for editing
+//	@Tags			Map
+//	@Security		BearerAuth
+//	@ID				GetPointOfInterestByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Point of interest ID"
+//	@Success		200	{object}	model.PointOfInterest
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Point of interest not found"
+//	@Router			/api/points-of-interest/{id} [get]
+func (h *Handler) GetPointOfInterestByID(c *gin.Context) {
+	point := h.authorizePoint(c, c.Param("id"))
+	if point == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, point)
+}
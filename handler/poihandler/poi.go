@@ -0,0 +1,50 @@
+package poihandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/poisvc"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	PointOfInterestService poisvc.IPointOfInterestServices
+	CollaboratorService    collabsvc.ICollaboratorServices
+}
+
+// authorizePoint checks that the current user may edit the exhibition owning the point and
+// returns the point. It writes the error response and returns nil when the request must stop.
+func (h *Handler) authorizePoint(c *gin.Context, pointID string) *model.PointOfInterest {
+	point, err := h.PointOfInterestService.GetPointOfInterestByID(c.Request.Context(), pointID)
+	if err != nil {
+		log.Printf("Error retrieving point of interest %s: %v", pointID, err)
+		respondError(c, err)
+		return nil
+	}
+
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, point.ExhibitionID.Hex(), model.RoleEditor); !ok {
+		return nil
+	}
+	return point
+}
+
+// respondError writes the HTTP response matching a point of interest service error.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, cerr.ErrInvalidLocation):
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+	case errors.Is(err, cerr.ErrPointOfInterestNotFound), errors.Is(err, cerr.ErrExhibitionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrLayoutMismatch):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,61 @@
+package poihandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update point of interest by ID
+//	@Description	Replace the location and content of a point of interest
+//	@Tags			Map
+//	@Security		BearerAuth
+//	@ID				UpdatePointOfInterest
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string								true	"Point of interest ID"
+//	@Param			updateRequest	body		model.RequestUpdatePointOfInterest	true	"Point of interest data"
+//	@Success		200				{object}	model.PointOfInterest
+//	@Failure		400				{object}	helper.APIError	"Invalid request body or location"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError	"Point of interest not found"
+//	@Router			/api/points-of-interest/{id} [put]
+func (h *Handler) UpdatePointOfInterest(c *gin.Context) {
+	pointID := c.Param("id")
+	var updateRequest model.RequestUpdatePointOfInterest
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&updateRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(updateRequest); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizePoint(c, pointID) == nil {
+		return
+	}
+
+	point, err := h.PointOfInterestService.UpdatePointOfInterest(c.Request.Context(), pointID, &updateRequest)
+	if err != nil {
+		log.Printf("Error updating point of interest %s: %v", pointID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, point)
+}
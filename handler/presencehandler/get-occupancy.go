@@ -0,0 +1,35 @@
+package presencehandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the occupancy of an exhibition
+//	@Description	Count the visitors connected to an exhibition, in total and per room. Users connected from several devices count once. The exhibition is visible to the same users as GET /api/exhibitions/{id}.
+//	@Tags			Presence
+//	@Security		BearerAuth
+//	@ID				GetOccupancy
+//	@Produce		json
+//	@Param			id					path		string	true	"Exhibition ID"
+//	@Param			share				query		string	false	"Share link token"
+//	@Param			X-Share-Token		header		string	false	"Share link token"
+//	@Param			X-Share-Password	header		string	false	"Share link password"
+//	@Success		200					{object}	model.Occupancy
+//	@Failure		404					{object}	helper.APIError	"Exhibition not found"
+//	@Router			/api/exhibitions/{id}/occupancy [get]
+func (h *Handler) GetOccupancy(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.Registry.Occupancy(exhibition.ID))
+}
@@ -0,0 +1,65 @@
+package presencehandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/presence"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//	@Summary		Join the visitors of an exhibition
+//	@Description	Upgrade to a WebSocket connection to walk a liveLayout exhibition together with other visitors. Browsers pass the bearer token as the access_token query parameter. Send {"type":"move","roomId":"...","position":{"x":0,"y":0,"z":0,"yaw":0}} to enter a room or move in it, and {"type":"chat","text":"..."} to talk to the visitors of your room. You receive welcome with your session, room with the visitors of a room you entered, joined, moved, left and chat events of the other visitors of your room, and error events for invalid messages. Visitors who read too slowly miss position updates and are disconnected when even chat messages cannot be queued.
+//	@Tags			Presence
+//	@Security		BearerAuth
+//	@ID				JoinPresence
+//	@Param			id					path		string				true	"Exhibition ID"
+//	@Param			access_token		query		string				false	"Bearer token, for clients that cannot set the Authorization header"
+//	@Param			share				query		string				false	"Share link token"
+//	@Param			X-Share-Token		header		string				false	"Share link token"
+//	@Param			X-Share-Password	header		string				false	"Share link password"
+//	@Success		101					{object}	model.PresenceEvent	"Switching protocols"
+//	@Failure		401					{object}	helper.APIError		"Authorization token is required"
+//	@Failure		404					{object}	helper.APIError		"Exhibition not found"
+//	@Failure		409					{object}	helper.APIError		"Exhibition does not use the live layout"
+//	@Router			/api/exhibitions/{id}/presence [get]
+func (h *Handler) JoinPresence(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	if exhibition.LayoutUsed != layout.Live {
+		c.JSON(http.StatusConflict, gin.H{"error": cerr.ErrLayoutMismatch.Error()})
+		return
+	}
+
+	rooms := make([]primitive.ObjectID, len(exhibition.Room))
+	for i, room := range exhibition.Room {
+		rooms[i] = room.ID
+	}
+
+	// The upgrader writes its own error response
+	conn, err := presence.Upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Printf("Error upgrading presence connection for exhibition %s: %v", exhibitionID, err)
+		return
+	}
+
+	client := h.Registry.Join(exhibition.ID, actor.UserID, rooms)
+	presence.Serve(conn, h.Registry, client)
+}
@@ -0,0 +1,12 @@
+package presencehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/presence"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	Registry          *presence.Registry
+	ExhibitionService exhibisvc.IExhibitionServices
+}
@@ -0,0 +1,75 @@
+package profilehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/webhook"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Apply a changed user profile
+//	@Description	Internal endpoint the user service posts changed profiles to. The body is signed like webhook payloads, with an X-AtomMuse-Signature header of the form t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with the shared secret>. The profile is copied into every exhibition the user owns or collaborates on. A change older than the last one received is ignored and reported as stale, so changes may be retried and arrive out of order.
+//	@Tags			Profiles
+//	@ID				ProfileChanged
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-AtomMuse-Signature	header		string				true	"Signature of the body"
+//	@Param			userProfile				body		model.UserProfile	true	"Changed profile"
+//	@Success		200						{object}	model.ProfileSync
+//	@Failure		400						{object}	helper.APIError	"Invalid request body"
+//	@Failure		401						{object}	helper.APIError	"Invalid signature"
+//	@Failure		500						{object}	helper.APIError	"Internal server error"
+//	@Failure		503						{object}	helper.APIError	"No shared secret is configured"
+//	@Router			/api/internal/profile-changes [post]
+func (h *Handler) ProfileChanged(c *gin.Context) {
+	var userProfile model.UserProfile
+	var validate = validator.New()
+
+	if h.Secret == "" {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Profile changes are not accepted"})
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Only the user service knows the secret
+	if err := webhook.Verify(h.Secret, c.GetHeader(webhook.SignatureHeader), body, time.Now()); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Parse request body
+	if err := json.Unmarshal(body, &userProfile); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(userProfile); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	sync, err := h.ProfileService.ApplyProfileChange(c.Request.Context(), &userProfile)
+	if err != nil {
+		log.Printf("Error applying profile of user %s: %v", userProfile.UserID.Hex(), err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sync)
+}
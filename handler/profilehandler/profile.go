@@ -0,0 +1,20 @@
+package profilehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/service/profilesvc"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	ProfileService profilesvc.IProfileServices
+	// Secret is shared with the user service, which signs the profile changes it posts with it.
+	Secret string
+}
+
+// respondError writes the HTTP response matching a profile service error.
+func respondError(c *gin.Context, err error) {
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+}
@@ -0,0 +1,29 @@
+package profilehandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Reconcile user profiles
+//	@Description	Copy every stored user profile into the exhibitions again right away, instead of waiting for the hourly reconciliation, and list the users whose copies had drifted with the number of exhibitions repaired.
+//	@Tags			Profiles
+//	@Security		BearerAuth
+//	@ID				ReconcileProfiles
+//	@Produce		json
+//	@Success		200	{array}		model.ProfileSync
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/profiles/reconcile [post]
+func (h *Handler) ReconcileProfiles(c *gin.Context) {
+	drifted, err := h.ProfileService.Reconcile(c.Request.Context())
+	if err != nil {
+		log.Printf("Error reconciling user profiles: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, drifted)
+}
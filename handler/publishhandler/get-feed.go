@@ -0,0 +1,219 @@
+package publishhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/feed"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// feedSize is the number of newest exhibitions listed in RSS and Atom feeds.
+const feedSize = 50
+
+//	@Summary		Get the sitemap index
+//	@Description	Get a sitemap index listing one sitemap per page of published exhibitions
+//	@Tags			Publishing
+//	@ID				GetSitemapIndex
+//	@Produce		xml
+//	@Success		200	{string}	string			"Sitemap index"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/sitemap.xml [get]
+func (h *Handler) GetSitemapIndex(c *gin.Context) {
+	count, err := h.ExhibitionService.CountPublishedExhibitions(c.Request.Context())
+	if err != nil {
+		log.Printf("Error counting published exhibitions: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+
+	pages := (count + feed.SitemapPageSize - 1) / feed.SitemapPageSize
+	if pages == 0 {
+		pages = 1
+	}
+
+	var sitemaps []string
+	for page := int64(1); page <= pages; page++ {
+		sitemaps = append(sitemaps, fmt.Sprintf("%s/api/sitemaps/%d.xml", helper.BaseURL(c), page))
+	}
+
+	writeFeed(c, "application/xml; charset=utf-8", func(w io.Writer) error {
+		return feed.SitemapIndex(w, sitemaps)
+	})
+}
+
+//	@Summary		Get a sitemap page
+//	@Description	Get a sitemap of the pages of published exhibitions
+//	@Tags			Publishing
+//	@ID				GetSitemap
+//	@Produce		xml
+//	@Param			page	path		string			true	"Page number followed by .xml, starting at 1.xml"
+//	@Success		200		{string}	string			"Sitemap"
+//	@Failure		404		{object}	helper.APIError	"Sitemap not found"
+//	@Failure		500		{object}	helper.APIError	"Internal server error"
+//	@Router			/api/sitemaps/{page} [get]
+func (h *Handler) GetSitemap(c *gin.Context) {
+	page, err := strconv.ParseInt(strings.TrimSuffix(c.Param("page"), ".xml"), 10, 64)
+	if err != nil || page < 1 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Sitemap Not Found"})
+		return
+	}
+
+	exhibitions, err := h.ExhibitionService.GetPublishedExhibitions(c.Request.Context(), model.PublishedQuery{
+		Skip:  (page - 1) * feed.SitemapPageSize,
+		Limit: feed.SitemapPageSize,
+	})
+	if err != nil {
+		log.Printf("Error retrieving published exhibitions: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+
+	if len(exhibitions) == 0 && page > 1 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Sitemap Not Found"})
+		return
+	}
+
+	urls := make([]string, len(exhibitions))
+	for i, exhibition := range exhibitions {
+		urls[i] = pageURL(c, exhibition.ID)
+	}
+
+	writeFeed(c, "application/xml; charset=utf-8", func(w io.Writer) error {
+		return feed.Sitemap(w, urls)
+	})
+}
+
+//	@Summary		Get the RSS feed of exhibitions
+//	@Description	Get an RSS 2.0 feed of newly published exhibitions, optionally of one category or exhibitor
+//	@Tags			Publishing
+//	@ID				GetRSSFeed
+//	@Produce		xml
+//	@Param			category	query		string			false	"Category"
+//	@Param			exhibitor	query		string			false	"User ID of the exhibitor"
+//	@Success		200			{string}	string			"RSS feed"
+//	@Failure		400			{object}	helper.APIError	"Invalid exhibitor"
+//	@Failure		500			{object}	helper.APIError	"Internal server error"
+//	@Router			/api/feeds/exhibitions.rss [get]
+func (h *Handler) GetRSSFeed(c *gin.Context) {
+	channel, entries, ok := h.feedEntries(c, model.PublishedQuery{Newest: true, Limit: feedSize})
+	if !ok {
+		return
+	}
+
+	writeFeed(c, "application/rss+xml; charset=utf-8", func(w io.Writer) error {
+		return feed.RSS(w, channel, entries)
+	})
+}
+
+//	@Summary		Get the Atom feed of exhibitions
+//	@Description	Get an Atom feed of newly published exhibitions, optionally of one category or exhibitor
+//	@Tags			Publishing
+//	@ID				GetAtomFeed
+//	@Produce		xml
+//	@Param			category	query		string			false	"Category"
+//	@Param			exhibitor	query		string			false	"User ID of the exhibitor"
+//	@Success		200			{string}	string			"Atom feed"
+//	@Failure		400			{object}	helper.APIError	"Invalid exhibitor"
+//	@Failure		500			{object}	helper.APIError	"Internal server error"
+//	@Router			/api/feeds/exhibitions.atom [get]
+func (h *Handler) GetAtomFeed(c *gin.Context) {
+	channel, entries, ok := h.feedEntries(c, model.PublishedQuery{Newest: true, Limit: feedSize})
+	if !ok {
+		return
+	}
+
+	writeFeed(c, "application/atom+xml; charset=utf-8", func(w io.Writer) error {
+		return feed.Atom(w, channel, entries)
+	})
+}
+
+//	@Summary		Get the calendar of exhibitions
+//	@Description	Get an iCalendar feed with the dates of published exhibitions that have not ended, optionally of one category or exhibitor
+//	@Tags			Publishing
+//	@ID				GetCalendarFeed
+//	@Produce		text/calendar
+//	@Param			category	query		string			false	"Category"
+//	@Param			exhibitor	query		string			false	"User ID of the exhibitor"
+//	@Success		200			{string}	string			"iCalendar feed"
+//	@Failure		400			{object}	helper.APIError	"Invalid exhibitor"
+//	@Failure		500			{object}	helper.APIError	"Internal server error"
+//	@Router			/api/feeds/exhibitions.ics [get]
+func (h *Handler) GetCalendarFeed(c *gin.Context) {
+	now := time.Now()
+	channel, entries, ok := h.feedEntries(c, model.PublishedQuery{EndingAfter: now})
+	if !ok {
+		return
+	}
+
+	writeFeed(c, "text/calendar; charset=utf-8", func(w io.Writer) error {
+		return feed.Calendar(w, channel, entries, now)
+	})
+}
+
+// feedEntries retrieves the exhibitions of a feed, narrowed by the category and exhibitor
+// query parameters, and describes the feed. It writes the error response itself.
+func (h *Handler) feedEntries(c *gin.Context, query model.PublishedQuery) (feed.Channel, []feed.Entry, bool) {
+	channel := feed.Channel{
+		Title:       "AtomMuse exhibitions",
+		Description: "Newly published exhibitions on AtomMuse",
+		Link:        helper.SiteURL(c),
+		Self:        helper.BaseURL(c) + c.Request.URL.RequestURI(),
+	}
+
+	if category := c.Query("category"); category != "" {
+		query.Category = category
+		channel.Title = "AtomMuse exhibitions: " + category
+		channel.Description = "Newly published " + category + " exhibitions on AtomMuse"
+	}
+
+	if exhibitor := c.Query("exhibitor"); exhibitor != "" {
+		userID, err := primitive.ObjectIDFromHex(exhibitor)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid exhibitor ID"})
+			return channel, nil, false
+		}
+		query.UserID = userID
+	}
+
+	exhibitions, err := h.ExhibitionService.GetPublishedExhibitions(c.Request.Context(), query)
+	if err != nil {
+		log.Printf("Error retrieving published exhibitions: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return channel, nil, false
+	}
+
+	i18n.LocalizeAll(exhibitions, helper.Languages(c))
+	entries := make([]feed.Entry, len(exhibitions))
+	for i := range exhibitions {
+		entries[i] = feed.FromExhibition(&exhibitions[i], pageURL(c, exhibitions[i].ID))
+	}
+
+	return channel, entries, true
+}
+
+func pageURL(c *gin.Context, exhibitionID primitive.ObjectID) string {
+	return helper.SiteURL(c) + "/exhibitions/" + exhibitionID.Hex()
+}
+
+// writeFeed renders a document before sending it, so a rendering error still gets an error status.
+func writeFeed(c *gin.Context, contentType string, render func(w io.Writer) error) {
+	var buf bytes.Buffer
+	if err := render(&buf); err != nil {
+		log.Printf("Error rendering %s: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
@@ -0,0 +1,53 @@
+package publishhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/iiif"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the IIIF manifest of an exhibition
+//	@Description	Get a IIIF Presentation 3.0 manifest of an exhibition. Sections and rooms become ranges, their images canvases.
+//	@Tags			Publishing
+//	@Security		BearerAuth
+//	@ID				GetIIIFManifest
+//	@Produce		json
+//	@Param			id					path		string			true	"Exhibition ID"
+//	@Param			share				query		string			false	"Share link token"
+//	@Param			X-Share-Token		header		string			false	"Share link token"
+//	@Param			X-Share-Password	header		string			false	"Share link password"
+//	@Param			lang				query		string			false	"Preferred locales, before those of the Accept-Language header"
+//	@Success		200					{object}	iiif.Manifest	"IIIF manifest"
+//	@Failure		404					{object}	helper.APIError	"Exhibition not found"
+//	@Failure		422					{object}	helper.APIError	"Exhibition has no images"
+//	@Failure		500					{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/iiif/manifest [get]
+func (h *Handler) GetIIIFManifest(c *gin.Context) {
+	exhibitionID := c.Param("id")
+
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	i18n.Localize(exhibition, helper.Languages(c))
+	manifestID := helper.BaseURL(c) + "/api/exhibitions/" + exhibitionID + "/iiif/manifest"
+	manifest := iiif.Build(exhibition, manifestID, helper.MediaURL(c))
+
+	// A manifest must contain at least one canvas
+	if len(manifest.Items) == 0 {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": cerr.ErrNoImages.Error()})
+		return
+	}
+
+	c.Header("Content-Type", `application/ld+json;profile="`+iiif.Context+`"`)
+	c.JSON(http.StatusOK, manifest)
+}
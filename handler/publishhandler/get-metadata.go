@@ -0,0 +1,61 @@
+package publishhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/seo"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the page metadata of an exhibition
+//	@Description	Get schema.org ExhibitionEvent JSON-LD with OpenGraph and Twitter card tags for a published exhibition. With format=html the metadata is returned as an HTML snippet for the page head.
+//	@Tags			Publishing
+//	@ID				GetExhibitionMetadata
+//	@Produce		json
+//	@Produce		html
+//	@Param			id		path		string			true	"Exhibition ID"
+//	@Param			format	query		string			false	"Response format"	Enums(json, html)
+//	@Param			lang	query		string			false	"Preferred locales, before those of the Accept-Language header"
+//	@Success		200		{object}	seo.Metadata	"Page metadata"
+//	@Failure		404		{object}	helper.APIError	"Exhibition not found"
+//	@Failure		500		{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/metadata [get]
+func (h *Handler) GetExhibitionMetadata(c *gin.Context) {
+	exhibitionID := c.Param("id")
+
+	// Only published exhibitions are described, whoever asks
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, model.ExhibitionViewer{})
+	if err == nil && !exhibisvc.IsPublished(exhibition) {
+		err = cerr.ErrExhibitionNotFound
+	}
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	i18n.Localize(exhibition, helper.Languages(c))
+	pageURL := helper.SiteURL(c) + "/exhibitions/" + exhibitionID
+	metadata := seo.Build(exhibition, pageURL, helper.MediaURL(c))
+
+	if c.Query("format") != "html" {
+		c.JSON(http.StatusOK, metadata)
+		return
+	}
+
+	snippet, err := metadata.HTML()
+	if err != nil {
+		log.Printf("Error rendering metadata of exhibition %s: %v", exhibitionID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(snippet))
+}
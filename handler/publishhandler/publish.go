@@ -0,0 +1,11 @@
+package publishhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+)
+
+// Handler is responsible for handling HTTP requests.
+// It publishes exhibitions in formats understood by other systems.
+type Handler struct {
+	ExhibitionService exhibisvc.IExhibitionServices
+}
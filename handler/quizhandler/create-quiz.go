@@ -0,0 +1,60 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Create a quiz
+//	@Description	Attach a quiz to an exhibition, or to one of its sections or rooms. Questions are multipleChoice, trueFalse or imagePick; correct lists the positions of the correct options and points defaults to 1.
+//	@Tags			Quizzes
+//	@Security		BearerAuth
+//	@ID				CreateQuiz
+//	@Accept			json
+//	@Produce		json
+//	@Param			requestQuiz	body		model.RequestQuiz				true	"Quiz data to create"
+//	@Success		201			{object}	model.ResponseGetExhibitionId	"Success"
+//	@Failure		400			{object}	helper.APIError					"Invalid request body or questions"
+//	@Failure		403			{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404			{object}	helper.APIError					"Exhibition not found"
+//	@Router			/api/quizzes [post]
+func (h *Handler) CreateQuiz(c *gin.Context) {
+	var requestQuiz model.RequestQuiz
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&requestQuiz); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestQuiz); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may add to the exhibition
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, requestQuiz.ExhibitionID.Hex(), model.RoleEditor); !ok {
+		return
+	}
+
+	objectID, err := h.QuizService.CreateQuiz(c.Request.Context(), &requestQuiz)
+	if err != nil {
+		log.Printf("Error creating quiz: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": objectID.Hex()})
+}
@@ -0,0 +1,37 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete quiz by ID
+//	@Description	Delete a quiz together with the attempts of visitors
+//	@Tags			Quizzes
+//	@Security		BearerAuth
+//	@ID				DeleteQuiz
+//	@Produce		json
+//	@Param			id	path		string							true	"Quiz ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete quiz success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError					"Quiz not found"
+//	@Router			/api/quizzes/{id} [delete]
+func (h *Handler) DeleteQuiz(c *gin.Context) {
+	quizID := c.Param("id")
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeQuiz(c, quizID, model.RoleEditor) == nil {
+		return
+	}
+
+	if err := h.QuizService.DeleteQuiz(c.Request.Context(), quizID); err != nil {
+		log.Printf("Error deleting quiz %s: %v", quizID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": quizID + " has been deleted."})
+}
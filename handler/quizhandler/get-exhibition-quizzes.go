@@ -0,0 +1,79 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/quiz"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//	@Summary		Get the quizzes of an exhibition
+//	@Description	Get the quizzes of an exhibition without their answers, optionally only those of a section or room. The exhibition is visible to the same users as GET /api/exhibitions/{id}.
+//	@Tags			Quizzes
+//	@Security		BearerAuth
+//	@ID				GetExhibitionQuizzes
+//	@Produce		json
+//	@Param			id					path		string	true	"Exhibition ID"
+//	@Param			sectionId			query		string	false	"Section ID"
+//	@Param			roomId				query		string	false	"Room ID"
+//	@Param			share				query		string	false	"Share link token"
+//	@Param			X-Share-Token		header		string	false	"Share link token"
+//	@Param			X-Share-Password	header		string	false	"Share link password"
+//	@Success		200					{array}		model.ResponseQuiz
+//	@Failure		400					{object}	helper.APIError	"Invalid section or room ID"
+//	@Failure		404					{object}	helper.APIError	"Exhibition not found"
+//	@Failure		500					{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/quizzes [get]
+func (h *Handler) GetExhibitionQuizzes(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	query := model.QuizQuery{ExhibitionID: exhibition.ID}
+	var ok bool
+	if query.SectionID, ok = optionalID(c, "sectionId"); !ok {
+		return
+	}
+	if query.RoomID, ok = optionalID(c, "roomId"); !ok {
+		return
+	}
+
+	quizzes, err := h.QuizService.GetQuizzes(c.Request.Context(), query)
+	if err != nil {
+		log.Printf("Error retrieving quizzes of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	public := make([]model.ResponseQuiz, len(quizzes))
+	for i := range quizzes {
+		public[i] = quiz.Public(&quizzes[i])
+	}
+
+	c.JSON(http.StatusOK, public)
+}
+
+// optionalID parses an optional ID query parameter. It writes the error response and returns
+// false when the parameter is not an ID.
+func optionalID(c *gin.Context, param string) (*primitive.ObjectID, bool) {
+	value := c.Query(param)
+	if value == "" {
+		return nil, true
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + param})
+		return nil, false
+	}
+	return &objectID, true
+}
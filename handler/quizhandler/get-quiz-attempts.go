@@ -0,0 +1,47 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get my attempts at a quiz
+//	@Description	Get the attempts of the current user at a quiz, newest first
+//	@Tags			Quizzes
+//	@Security		BearerAuth
+//	@ID				GetQuizAttempts
+//	@Produce		json
+//	@Param			id					path		string	true	"Quiz ID"
+//	@Param			share				query		string	false	"Share link token"
+//	@Param			X-Share-Token		header		string	false	"Share link token"
+//	@Param			X-Share-Password	header		string	false	"Share link password"
+//	@Success		200					{array}		model.QuizAttempt
+//	@Failure		401					{object}	helper.APIError	"Authorization token is required"
+//	@Failure		404					{object}	helper.APIError	"Quiz not found"
+//	@Failure		500					{object}	helper.APIError	"Internal server error"
+//	@Router			/api/quizzes/{id}/attempts [get]
+func (h *Handler) GetQuizAttempts(c *gin.Context) {
+	quizID := c.Param("id")
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	existing := h.visibleQuiz(c, quizID)
+	if existing == nil {
+		return
+	}
+
+	attempts, err := h.QuizService.GetAttempts(c.Request.Context(), existing.ID, actor.UserID.UserID)
+	if err != nil {
+		log.Printf("Error retrieving attempts at quiz %s: %v", quizID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attempts)
+}
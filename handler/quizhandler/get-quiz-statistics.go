@@ -0,0 +1,38 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the results of a quiz
+//	@Description	Get aggregate results of the attempts at a quiz: attempts, participants, average score, and per question the correct rate and how often each option was picked. Collaborators with any role may read them.
+//	@Tags			Quizzes
+//	@Security		BearerAuth
+//	@ID				GetQuizStatistics
+//	@Produce		json
+//	@Param			id	path		string	true	"Quiz ID"
+//	@Success		200	{object}	model.QuizStatistics
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Quiz not found"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/quizzes/{id}/statistics [get]
+func (h *Handler) GetQuizStatistics(c *gin.Context) {
+	quizID := c.Param("id")
+	existing := h.authorizeQuiz(c, quizID, model.RoleViewer)
+	if existing == nil {
+		return
+	}
+
+	statistics, err := h.QuizService.GetStatistics(c.Request.Context(), existing)
+	if err != nil {
+		log.Printf("Error retrieving statistics of quiz %s: %v", quizID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, statistics)
+}
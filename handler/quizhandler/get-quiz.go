@@ -0,0 +1,28 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get quiz by ID
+//	@Description	Get a quiz with its correct answers for editing
+//	@Tags			Quizzes
+//	@Security		BearerAuth
+//	@ID				GetQuizByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Quiz ID"
+//	@Success		200	{object}	model.Quiz
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Quiz not found"
+//	@Router			/api/quizzes/{id} [get]
+func (h *Handler) GetQuizByID(c *gin.Context) {
+	existing := h.authorizeQuiz(c, c.Param("id"), model.RoleEditor)
+	if existing == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, existing)
+}
@@ -0,0 +1,75 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/quiz"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/service/quizsvc"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	QuizService         quizsvc.IQuizServices
+	ExhibitionService   exhibisvc.IExhibitionServices
+	CollaboratorService collabsvc.ICollaboratorServices
+}
+
+// authorizeQuiz checks that the current user has at least the given role on the exhibition
+// owning the quiz and returns the quiz. It writes the error response and returns nil when the
+// request must stop.
+func (h *Handler) authorizeQuiz(c *gin.Context, quizID string, role string) *model.Quiz {
+	existing, err := h.QuizService.GetQuizByID(c.Request.Context(), quizID)
+	if err != nil {
+		log.Printf("Error retrieving quiz %s: %v", quizID, err)
+		respondError(c, err)
+		return nil
+	}
+
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, existing.ExhibitionID.Hex(), role); !ok {
+		return nil
+	}
+	return existing
+}
+
+// visibleQuiz returns a quiz whose exhibition the viewer may see. It writes the error response
+// and returns nil when the request must stop.
+func (h *Handler) visibleQuiz(c *gin.Context, quizID string) *model.Quiz {
+	existing, err := h.QuizService.GetQuizByID(c.Request.Context(), quizID)
+	if err != nil {
+		log.Printf("Error retrieving quiz %s: %v", quizID, err)
+		respondError(c, err)
+		return nil
+	}
+
+	if _, err := h.ExhibitionService.GetExhibitionByID(c, existing.ExhibitionID.Hex(), helper.GetViewer(c)); err != nil {
+		log.Printf("Error retrieving exhibition of quiz %s: %v", quizID, err)
+		exhibihandler.RespondViewError(c, err)
+		return nil
+	}
+	return existing
+}
+
+// respondError writes the HTTP response matching a quiz service error.
+func respondError(c *gin.Context, err error) {
+	var invalid *quiz.ValidationError
+	var invalidAnswers *quiz.AnswerError
+	switch {
+	case errors.As(err, &invalid):
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": invalid.Problems})
+	case errors.As(err, &invalidAnswers):
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": invalidAnswers.Problems})
+	case errors.Is(err, cerr.ErrQuizNotFound), errors.Is(err, cerr.ErrExhibitionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,71 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Answer a quiz
+//	@Description	Submit answers to a quiz. The answers are scored on the server and the attempt is returned with the correct answers and explanations. A question is only correct when exactly its correct options are picked.
+//	@Tags			Quizzes
+//	@Security		BearerAuth
+//	@ID				SubmitQuiz
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		string					true	"Quiz ID"
+//	@Param			requestSubmitQuiz	body		model.RequestSubmitQuiz	true	"Answers"
+//	@Param			share				query		string					false	"Share link token"
+//	@Param			X-Share-Token		header		string					false	"Share link token"
+//	@Param			X-Share-Password	header		string					false	"Share link password"
+//	@Success		201					{object}	model.QuizAttempt
+//	@Failure		400					{object}	helper.APIError	"Invalid request body or answers"
+//	@Failure		401					{object}	helper.APIError	"Authorization token is required"
+//	@Failure		404					{object}	helper.APIError	"Quiz not found"
+//	@Router			/api/quizzes/{id}/attempts [post]
+func (h *Handler) SubmitQuiz(c *gin.Context) {
+	quizID := c.Param("id")
+	var requestSubmitQuiz model.RequestSubmitQuiz
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestSubmitQuiz); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestSubmitQuiz); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	existing := h.visibleQuiz(c, quizID)
+	if existing == nil {
+		return
+	}
+
+	attempt, err := h.QuizService.SubmitAttempt(c.Request.Context(), existing, actor.UserID.UserID, &requestSubmitQuiz)
+	if err != nil {
+		log.Printf("Error submitting quiz %s: %v", quizID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, attempt)
+}
@@ -0,0 +1,61 @@
+package quizhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update quiz by ID
+//	@Description	Replace the questions of a quiz or move it to another section or room of its exhibition. Earlier attempts keep their scores.
+//	@Tags			Quizzes
+//	@Security		BearerAuth
+//	@ID				UpdateQuiz
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string				true	"Quiz ID"
+//	@Param			updateRequest	body		model.RequestQuiz	true	"Quiz data"
+//	@Success		200				{object}	model.Quiz
+//	@Failure		400				{object}	helper.APIError	"Invalid request body or questions"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError	"Quiz not found"
+//	@Router			/api/quizzes/{id} [put]
+func (h *Handler) UpdateQuiz(c *gin.Context) {
+	quizID := c.Param("id")
+	var updateRequest model.RequestQuiz
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&updateRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(updateRequest); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeQuiz(c, quizID, model.RoleEditor) == nil {
+		return
+	}
+
+	updated, err := h.QuizService.UpdateQuiz(c.Request.Context(), quizID, &updateRequest)
+	if err != nil {
+		log.Printf("Error updating quiz %s: %v", quizID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
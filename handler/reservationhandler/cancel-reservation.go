@@ -0,0 +1,56 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Cancel reservation by ID
+//	@Description	Cancel a confirmed reservation and give its seats back to the time slot. Users cancel their own reservations; the owner and editors of the exhibition may cancel any.
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				CancelReservation
+//	@Produce		json
+//	@Param			id	path		string	true	"Reservation ID"
+//	@Success		200	{object}	model.ResponseReservation
+//	@Failure		401	{object}	helper.APIError	"Authorization token is required"
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Reservation not found"
+//	@Failure		409	{object}	helper.APIError	"Reservation is cancelled or checked in"
+//	@Router			/api/reservations/{id} [delete]
+func (h *Handler) CancelReservation(c *gin.Context) {
+	reservationID := c.Param("id")
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	booking, err := h.ReservationService.GetReservationByID(c.Request.Context(), reservationID)
+	if err != nil {
+		log.Printf("Error retrieving reservation %s: %v", reservationID, err)
+		respondError(c, err)
+		return
+	}
+
+	// Staff of the exhibition may cancel reservations of visitors
+	if booking.UserID != actor.UserID.UserID {
+		if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, booking.ExhibitionID.Hex(), model.RoleEditor); !ok {
+			return
+		}
+	}
+
+	if err := h.ReservationService.CancelReservation(c.Request.Context(), &booking.Reservation); err != nil {
+		log.Printf("Error cancelling reservation %s: %v", reservationID, err)
+		respondError(c, err)
+		return
+	}
+
+	booking.Status = model.ReservationCancelled
+	booking.Token = ""
+	c.JSON(http.StatusOK, booking)
+}
@@ -0,0 +1,70 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//	@Summary		Check in a booking token
+//	@Description	Validate the booking token scanned from the QR code of a reservation for this exhibition and consume it. A token can be checked in once; cancelled reservations are refused.
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				CheckInReservation
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string					true	"Exhibition ID"
+//	@Param			requestCheckIn	body		model.RequestCheckIn	true	"Booking token"
+//	@Success		200				{object}	model.ResponseReservation
+//	@Failure		400				{object}	helper.APIError	"Invalid request body or booking token"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		409				{object}	helper.APIError	"Reservation is cancelled or already checked in"
+//	@Router			/api/exhibitions/{id}/check-in [post]
+func (h *Handler) CheckInReservation(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	var requestCheckIn model.RequestCheckIn
+	var validate = validator.New()
+
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		respondError(c, cerr.ErrExhibitionNotFound)
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestCheckIn); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestCheckIn); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors staff the entrance
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, exhibitionID, model.RoleEditor); !ok {
+		return
+	}
+
+	booking, err := h.ReservationService.CheckIn(c.Request.Context(), objectID, requestCheckIn.Token)
+	if err != nil {
+		log.Printf("Error checking in at exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, booking)
+}
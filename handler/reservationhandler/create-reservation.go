@@ -0,0 +1,75 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Reserve a time slot
+//	@Description	Book seats in a time slot of an exhibition. Bookings never exceed the capacity of the slot, also when made at the same time, and a user holds at most one confirmed reservation per slot. The response carries the booking token to show at the entrance, also available as a QR code.
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				CreateReservation
+//	@Accept			json
+//	@Produce		json
+//	@Param			id							path		string							true	"Exhibition ID"
+//	@Param			requestCreateReservation	body		model.RequestCreateReservation	true	"Time slot and seats"
+//	@Param			share						query		string							false	"Share link token"
+//	@Param			X-Share-Token				header		string							false	"Share link token"
+//	@Param			X-Share-Password			header		string							false	"Share link password"
+//	@Success		201							{object}	model.ResponseReservation
+//	@Failure		400							{object}	helper.APIError	"Invalid request body"
+//	@Failure		401							{object}	helper.APIError	"Authorization token is required"
+//	@Failure		404							{object}	helper.APIError	"Exhibition or time slot not found"
+//	@Failure		409							{object}	helper.APIError	"Time slot is fully booked, has ended or is already reserved"
+//	@Router			/api/exhibitions/{id}/reservations [post]
+func (h *Handler) CreateReservation(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	var requestCreateReservation model.RequestCreateReservation
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestCreateReservation); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestCreateReservation); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	booking, err := h.ReservationService.Reserve(c.Request.Context(), exhibition.ID, actor.UserID.UserID, &requestCreateReservation)
+	if err != nil {
+		log.Printf("Error reserving time slot %s: %v", requestCreateReservation.SlotID.Hex(), err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, booking)
+}
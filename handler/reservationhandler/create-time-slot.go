@@ -0,0 +1,70 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//	@Summary		Create a time slot
+//	@Description	Add an entry time slot with a number of seats to an exhibition
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				CreateTimeSlot
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string							true	"Exhibition ID"
+//	@Param			requestTimeSlot	body		model.RequestTimeSlot			true	"Time slot data to create"
+//	@Success		201				{object}	model.ResponseGetExhibitionId	"Success"
+//	@Failure		400				{object}	helper.APIError					"Invalid request body or window"
+//	@Failure		403				{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError					"Exhibition not found"
+//	@Router			/api/exhibitions/{id}/time-slots [post]
+func (h *Handler) CreateTimeSlot(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	var requestTimeSlot model.RequestTimeSlot
+	var validate = validator.New()
+
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		respondError(c, cerr.ErrExhibitionNotFound)
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestTimeSlot); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestTimeSlot); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may add to the exhibition
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, exhibitionID, model.RoleEditor); !ok {
+		return
+	}
+
+	slotID, err := h.ReservationService.CreateTimeSlot(c.Request.Context(), objectID, &requestTimeSlot)
+	if err != nil {
+		log.Printf("Error creating time slot of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": slotID.Hex()})
+}
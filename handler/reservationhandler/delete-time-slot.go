@@ -0,0 +1,37 @@
+package reservationhandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete time slot by ID
+//	@Description	Delete a time slot nobody has booked. Booked slots must have their reservations cancelled first.
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				DeleteTimeSlot
+//	@Produce		json
+//	@Param			id	path		string							true	"Time slot ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete time slot success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError					"Time slot not found"
+//	@Failure		409	{object}	helper.APIError					"Time slot has reservations"
+//	@Router			/api/time-slots/{id} [delete]
+func (h *Handler) DeleteTimeSlot(c *gin.Context) {
+	slotID := c.Param("id")
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeSlot(c, slotID) == nil {
+		return
+	}
+
+	if err := h.ReservationService.DeleteTimeSlot(c.Request.Context(), slotID); err != nil {
+		log.Printf("Error deleting time slot %s: %v", slotID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": slotID + " has been deleted."})
+}
@@ -0,0 +1,45 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/reservation"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the QR code of a reservation
+//	@Description	Get the booking token of a confirmed reservation of the current user as a PNG QR code, to be scanned at check-in
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				GetReservationQRCode
+//	@Produce		png
+//	@Param			id	path		string			true	"Reservation ID"
+//	@Success		200	{file}		binary			"QR code"
+//	@Failure		401	{object}	helper.APIError	"Authorization token is required"
+//	@Failure		404	{object}	helper.APIError	"Reservation not found"
+//	@Failure		409	{object}	helper.APIError	"Reservation is cancelled or checked in"
+//	@Router			/api/reservations/{id}/qr [get]
+func (h *Handler) GetReservationQRCode(c *gin.Context) {
+	reservationID := c.Param("id")
+	booking := h.ownReservation(c, reservationID)
+	if booking == nil {
+		return
+	}
+
+	if booking.Token == "" {
+		respondError(c, cerr.ErrReservationNotActive)
+		return
+	}
+
+	png, err := reservation.QRCode(booking.Token)
+	if err != nil {
+		log.Printf("Error rendering QR code of reservation %s: %v", reservationID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, "image/png", png)
+}
@@ -0,0 +1,56 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get my reservations
+//	@Description	Get the reservations of the current user, newest first, with their time slots. Confirmed reservations carry their booking token.
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				GetReservations
+//	@Produce		json
+//	@Success		200	{array}		model.ResponseReservation
+//	@Failure		401	{object}	helper.APIError	"Authorization token is required"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/reservations [get]
+func (h *Handler) GetReservations(c *gin.Context) {
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	reservations, err := h.ReservationService.GetReservations(c.Request.Context(), actor.UserID.UserID)
+	if err != nil {
+		log.Printf("Error retrieving reservations of user %s: %v", actor.UserID.UserID.Hex(), err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, reservations)
+}
+
+//	@Summary		Get reservation by ID
+//	@Description	Get a reservation of the current user with its time slot and booking token
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				GetReservationByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Reservation ID"
+//	@Success		200	{object}	model.ResponseReservation
+//	@Failure		401	{object}	helper.APIError	"Authorization token is required"
+//	@Failure		404	{object}	helper.APIError	"Reservation not found"
+//	@Router			/api/reservations/{id} [get]
+func (h *Handler) GetReservationByID(c *gin.Context) {
+	booking := h.ownReservation(c, c.Param("id"))
+	if booking == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, booking)
+}
@@ -0,0 +1,43 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the time slots of an exhibition
+//	@Description	Get the entry time slots of an exhibition in chronological order with their remaining seats. The exhibition is visible to the same users as GET /api/exhibitions/{id}.
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				GetTimeSlots
+//	@Produce		json
+//	@Param			id					path		string	true	"Exhibition ID"
+//	@Param			share				query		string	false	"Share link token"
+//	@Param			X-Share-Token		header		string	false	"Share link token"
+//	@Param			X-Share-Password	header		string	false	"Share link password"
+//	@Success		200					{array}		model.TimeSlot
+//	@Failure		404					{object}	helper.APIError	"Exhibition not found"
+//	@Failure		500					{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/time-slots [get]
+func (h *Handler) GetTimeSlots(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	slots, err := h.ReservationService.GetTimeSlots(c.Request.Context(), exhibition.ID)
+	if err != nil {
+		log.Printf("Error retrieving time slots of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, slots)
+}
@@ -0,0 +1,78 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/reservation"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/service/reservationsvc"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	ReservationService  reservationsvc.IReservationServices
+	ExhibitionService   exhibisvc.IExhibitionServices
+	CollaboratorService collabsvc.ICollaboratorServices
+}
+
+// authorizeSlot checks that the current user may edit the exhibition owning the time slot and
+// returns the slot. It writes the error response and returns nil when the request must stop.
+func (h *Handler) authorizeSlot(c *gin.Context, slotID string) *model.TimeSlot {
+	slot, err := h.ReservationService.GetTimeSlotByID(c.Request.Context(), slotID)
+	if err != nil {
+		log.Printf("Error retrieving time slot %s: %v", slotID, err)
+		respondError(c, err)
+		return nil
+	}
+
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, slot.ExhibitionID.Hex(), model.RoleEditor); !ok {
+		return nil
+	}
+	return slot
+}
+
+// ownReservation returns a reservation of the current user. Reservations of other users are
+// reported as not found. It writes the error response and returns nil when the request must stop.
+func (h *Handler) ownReservation(c *gin.Context, reservationID string) *model.ResponseReservation {
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return nil
+	}
+
+	booking, err := h.ReservationService.GetReservationByID(c.Request.Context(), reservationID)
+	if err == nil && booking.UserID != actor.UserID.UserID {
+		err = cerr.ErrReservationNotFound
+	}
+	if err != nil {
+		log.Printf("Error retrieving reservation %s: %v", reservationID, err)
+		respondError(c, err)
+		return nil
+	}
+	return booking
+}
+
+// respondError writes the HTTP response matching a reservation service error.
+func respondError(c *gin.Context, err error) {
+	var invalid *reservation.SlotError
+	switch {
+	case errors.As(err, &invalid):
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": []string{invalid.Problem}})
+	case errors.Is(err, cerr.ErrInvalidBookingToken):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrTimeSlotNotFound), errors.Is(err, cerr.ErrReservationNotFound), errors.Is(err, cerr.ErrExhibitionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrTimeSlotFull), errors.Is(err, cerr.ErrTimeSlotClosed), errors.Is(err, cerr.ErrAlreadyReserved),
+		errors.Is(err, cerr.ErrReservationNotActive), errors.Is(err, cerr.ErrTimeSlotHasReservations), errors.Is(err, cerr.ErrCapacityBelowBookings):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
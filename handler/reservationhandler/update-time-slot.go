@@ -0,0 +1,62 @@
+package reservationhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update time slot by ID
+//	@Description	Change the window or capacity of a time slot. The capacity cannot drop below the seats already booked.
+//	@Tags			Reservations
+//	@Security		BearerAuth
+//	@ID				UpdateTimeSlot
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string					true	"Time slot ID"
+//	@Param			updateRequest	body		model.RequestTimeSlot	true	"Time slot data"
+//	@Success		200				{object}	model.TimeSlot
+//	@Failure		400				{object}	helper.APIError	"Invalid request body or window"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError	"Time slot not found"
+//	@Failure		409				{object}	helper.APIError	"Capacity is below the booked seats"
+//	@Router			/api/time-slots/{id} [put]
+func (h *Handler) UpdateTimeSlot(c *gin.Context) {
+	slotID := c.Param("id")
+	var updateRequest model.RequestTimeSlot
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&updateRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(updateRequest); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeSlot(c, slotID) == nil {
+		return
+	}
+
+	slot, err := h.ReservationService.UpdateTimeSlot(c.Request.Context(), slotID, &updateRequest)
+	if err != nil {
+		log.Printf("Error updating time slot %s: %v", slotID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, slot)
+}
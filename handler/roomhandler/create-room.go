@@ -1,6 +1,7 @@
 package roomhandler
 
 import (
+	"atommuse/backend/exhibition-service/pkg/helper"
 	"atommuse/backend/exhibition-service/pkg/model"
 	"fmt"
 	"net/http"
@@ -10,7 +11,7 @@ import (
 )
 
 //	@Summary		Create a new exhibitionRoom
-//	@Description	Create a new exhibitionRoom data
+//	@Description	Create a new exhibitionRoom data. The titles and texts of the items are screened like those of sections.
 //	@Tags			Rooms
 //	@Security		BearerAuth
 //
@@ -22,6 +23,8 @@ import (
 //	@Success		201						{object}	model.ResponseExhibitionRoom		"Success"
 //	@Failure		400						{object}	helper.APIError
 //	@Failure		401
+//	@Failure		403						{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		422						{object}	helper.APIError	"Content blocked by screening, or artwork from another catalogue"
 //	@Failure		500	"Invalid request body"
 //	@Router			/api/rooms [post]
 func (h *Handler) CreateExhibitionRoom(c *gin.Context) {
@@ -45,8 +48,20 @@ func (h *Handler) CreateExhibitionRoom(c *gin.Context) {
 		return
 	}
 
+	// Only the owner and editors may add to the exhibition
+	access, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, requestExhibitionRoom.ExhibitionID.Hex(), model.RoleEditor)
+	if !ok {
+		return
+	}
+	if !h.checkArtworks(c, access, requestExhibitionRoom.Left, requestExhibitionRoom.Center, requestExhibitionRoom.Right) {
+		return
+	}
+
 	// Call use case to create exhibition
 	objectID, err := h.RoomService.CreateExhibitionRoom(c.Request.Context(), &requestExhibitionRoom)
+	if helper.RespondBlockedContent(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"errorMessage": "Failed to create exhibition"})
 		return
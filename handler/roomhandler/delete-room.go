@@ -18,11 +18,17 @@ import (
 //	@Param			id	path		string							true	"Room ID"
 //	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete Room Success"
 //	@Failure		401
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
 //	@Failure		500	{object}	helper.APIError	"Internal server error"
 //	@Router			/api/rooms/{id} [delete]
 func (h *Handler) DeleteExhibitionRoomByID(c *gin.Context) {
 	RoomID := c.Param("id")
 
+	// Only the owner and editors may change the exhibition
+	if _, ok := h.authorizeRoom(c, RoomID); !ok {
+		return
+	}
+
 	err := h.RoomService.DeleteExhibitionRoomByID(c.Request.Context(), RoomID)
 	if err != nil {
 		log.Printf("Error deleting exhibitionRoom %s: %v", RoomID, err)
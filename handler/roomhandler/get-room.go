@@ -1,6 +1,10 @@
 package roomhandler
 
 import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
 	"log"
 	"net/http"
 
@@ -8,16 +12,18 @@ import (
 )
 
 //	@Summary		Get exhibitionRoom by ID
-//	@Description	Get exhibition data by RoomID
+//	@Description	Get exhibition data by RoomID. Rooms are only shown to those who may see their exhibition, without media under embargo.
 //	@Tags			Rooms
 //
 //	@Security		BearerAuth
 //
 //	@ID				GetExhibitionRoomByID
 //	@Produce		json
-//	@Param			id	path		string	true	"Exhibition Room ID"
+//	@Param			id		path		string	true	"Exhibition Room ID"
+//	@Param			lang	query		string	false	"Preferred locales, before those of the Accept-Language header"
 //	@Success		200	{object}	model.ResponseExhibitionRoom
 //	@Failure		401
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
 //	@Failure		500	{object}	helper.APIError	"Internal server error"
 //	@Router			/api/rooms/{id} [get]
 func (h *Handler) GetExhibitionRoomByID(c *gin.Context) {
@@ -31,8 +37,17 @@ func (h *Handler) GetExhibitionRoomByID(c *gin.Context) {
 		return
 	}
 
-	// Return the exhibition details
-	c.JSON(http.StatusOK, exhibitionRoom)
+	content, err := h.ExhibitionService.PrepareContent(c, exhibitionRoom.ExhibitionID.Hex(), helper.GetViewer(c), nil, []model.Room{model.Room(*exhibitionRoom)})
+	if err != nil {
+		log.Printf("Error retrieving exhibition of Room %s: %v", RoomID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	// Return the room in the language of the client
+	i18n.Localize(content, helper.Languages(c))
+	c.Header("Content-Language", content.Locale)
+	c.JSON(http.StatusOK, model.ResponseExhibitionRoom(content.Room[0]))
 }
 
 //	@Summary		Get all exhibitions Rooms
@@ -60,14 +75,16 @@ func (h *Handler) GetAllExhibitionRooms(c *gin.Context) {
 }
 
 //	@Summary		Get Rooms By exhibitionID
-//	@Description	Get Rooms By exhibitionID
+//	@Description	Get Rooms By exhibitionID. Rooms are only shown to those who may see the exhibition, without media under embargo.
 //	@Tags			Rooms
 //	@Security		BearerAuth
 //	@ID				GetRoomsByExhibitionID
 //	@Produce		json
-//	@Param			id	path		string	true	"Exhibition ID"
+//	@Param			id		path		string	true	"Exhibition ID"
+//	@Param			lang	query		string	false	"Preferred locales, before those of the Accept-Language header"
 //	@Success		200	{object}	[]model.ResponseExhibitionRoom
 //	@Failure		401
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
 //	@Failure		500	{object}	helper.APIError	"Internal server error"
 //	@Router			/api/exhibitions/{id}/rooms [get]
 func (h *Handler) GetRoomsByExhibitionID(c *gin.Context) {
@@ -81,6 +98,15 @@ func (h *Handler) GetRoomsByExhibitionID(c *gin.Context) {
 		return
 	}
 
-	// Return the Rooms as JSON response
-	c.JSON(http.StatusOK, Rooms)
+	content, err := h.ExhibitionService.PrepareContent(c, exhibitionID, helper.GetViewer(c), nil, Rooms)
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	// Return the Rooms in the language of the client
+	i18n.Localize(content, helper.Languages(c))
+	c.Header("Content-Language", content.Locale)
+	c.JSON(http.StatusOK, content.Room)
 }
@@ -1,8 +1,63 @@
 package roomhandler
 
-import "atommuse/backend/exhibition-service/pkg/service/roomsvc"
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/artworksvc"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/service/roomsvc"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
 
 // Handler is responsible for handling HTTP requests.
 type Handler struct {
-	RoomService roomsvc.IRoomServices
+	RoomService         roomsvc.IRoomServices
+	ExhibitionService   exhibisvc.IExhibitionServices
+	CollaboratorService collabsvc.ICollaboratorServices
+	// ArtworkService checks the artworks the items of rooms reference. They are not checked when
+	// it is nil.
+	ArtworkService artworksvc.IArtworkServices
+}
+
+// authorizeRoom checks that the current user may edit the exhibition owning the room.
+// It writes the error response and returns false when the request must stop.
+func (h *Handler) authorizeRoom(c *gin.Context, RoomID string) (*model.ExhibitionAccess, bool) {
+	room, err := h.RoomService.GetExhibitionRoomByID(c.Request.Context(), RoomID)
+	if err != nil {
+		log.Printf("Error retrieving exhibition Room %s: %v", RoomID, err)
+		c.JSON(http.StatusNotFound, gin.H{"error": "Exhibition room not found"})
+		return nil, false
+	}
+
+	return helper.AuthorizeExhibition(c, h.CollaboratorService, room.ExhibitionID.Hex(), model.RoleEditor)
+}
+
+// checkArtworks checks that the artworks the items of a room reference come from the catalogue
+// of the exhibition owner or of the current user. It writes the error response and returns
+// false when the request must stop.
+func (h *Handler) checkArtworks(c *gin.Context, access *model.ExhibitionAccess, left []model.LeftRightItem, center []model.CenterItem, right []model.LeftRightItem) bool {
+	if h.ArtworkService == nil {
+		return true
+	}
+
+	actor, _ := helper.GetActor(c)
+	room := model.Room{Left: left, Center: center, Right: right}
+	ids := artworksvc.ReferencedIDs(&model.ResponseExhibition{Room: []model.Room{room}})
+	err := h.ArtworkService.CheckReferences(c.Request.Context(), ids, access.UserID.UserID, actor.UserID.UserID)
+	if errors.Is(err, cerr.ErrArtworkNotAllowed) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return false
+	}
+	if err != nil {
+		log.Printf("Error checking artworks of exhibition %s: %v", access.ID.Hex(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return false
+	}
+	return true
 }
@@ -0,0 +1,70 @@
+package roomhandler_test
+
+import (
+	"atommuse/backend/exhibition-service/handler/roomhandler"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/roomsvc"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type roomService struct {
+	roomsvc.IRoomServices
+	room model.ResponseExhibitionRoom
+	updated bool
+}
+
+func (s *roomService) GetExhibitionRoomByID(ctx context.Context, RoomID string) (*model.ResponseExhibitionRoom, error) {
+	return &s.room, nil
+}
+
+func (s *roomService) UpdateExhibitionRoom(ctx context.Context, RoomID string, updatedRoom *model.RequestUpdateExhibitionRoom) (*primitive.ObjectID, error) {
+	s.updated = true
+	return &s.room.ID, nil
+}
+
+type collaboratorService struct {
+	collabsvc.ICollaboratorServices
+}
+
+func (collaboratorService) Authorize(ctx context.Context, exhibitionID string, actor model.Actor, required string) (*model.ExhibitionAccess, error) {
+	id, err := primitive.ObjectIDFromHex(exhibitionID)
+	return &model.ExhibitionAccess{ID: id}, err
+}
+
+func TestUpdateExhibitionRoomKeepsExhibition(t *testing.T) {
+	exhibitionID := primitive.NewObjectID()
+	tests := []struct {
+		name         string
+		exhibitionID primitive.ObjectID
+		status       int
+	}{
+		{"same exhibition", exhibitionID, http.StatusOK},
+		{"other exhibition", primitive.NewObjectID(), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &roomService{room: model.ResponseExhibitionRoom{ID: primitive.NewObjectID(), ExhibitionID: exhibitionID}}
+			handler := roomhandler.Handler{RoomService: service, CollaboratorService: collaboratorService{}}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			body := `{"exhibitionId":"` + tt.exhibitionID.Hex() + `"}`
+			c.Request = httptest.NewRequest(http.MethodPut, "/api/rooms/"+service.room.ID.Hex(), strings.NewReader(body))
+			c.Params = gin.Params{{Key: "id", Value: service.room.ID.Hex()}}
+
+			handler.UpdateExhibitionRoom(c)
+			assert.Equal(t, tt.status, recorder.Code)
+			assert.Equal(t, tt.status == http.StatusOK, service.updated)
+		})
+	}
+}
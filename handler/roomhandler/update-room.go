@@ -1,6 +1,7 @@
 package roomhandler
 
 import (
+	"atommuse/backend/exhibition-service/pkg/helper"
 	"atommuse/backend/exhibition-service/pkg/model"
 	"fmt"
 	"net/http"
@@ -10,7 +11,7 @@ import (
 )
 
 //	@Summary		Update exhibitionRoom by RoomID
-//	@Description	Update exhibitionRoom data by RoomID
+//	@Description	Update exhibitionRoom data by RoomID. The texts are screened as when the room is created.
 //	@Tags			Rooms
 //	@Security		BearerAuth
 //	@ID				UpdateExhibitionRoom
@@ -20,7 +21,10 @@ import (
 //	@Param			updateRequest	body		model.RequestUpdateExhibitionRoom	true	"ExhibitionRoom data to update"
 //
 //	@Success		200				{object}	model.ResponseExhibition
+//	@Failure		400	{object}	helper.APIError	"Invalid request body, or another exhibitionId than the current one"
 //	@Failure		401
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		422	{object}	helper.APIError	"Content blocked by screening, or artwork from another catalogue"
 //	@Failure		500	{object}	helper.APIError	"Internal server error"
 //	@Router			/api/rooms/{id} [put]
 func (h *Handler) UpdateExhibitionRoom(c *gin.Context) {
@@ -46,8 +50,26 @@ func (h *Handler) UpdateExhibitionRoom(c *gin.Context) {
 	// Get Room ID from the URL parameter
 	RoomID := c.Param("id")
 
+	// Only the owner and editors may change the exhibition
+	access, ok := h.authorizeRoom(c, RoomID)
+	if !ok {
+		return
+	}
+
+	// Rooms stay in their exhibition, editors of one exhibition may not move them to another
+	if requestUpdateExhibitionRoom.ExhibitionID != access.ID {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "The exhibition of a room cannot be changed"})
+		return
+	}
+	if !h.checkArtworks(c, access, requestUpdateExhibitionRoom.Left, requestUpdateExhibitionRoom.Center, requestUpdateExhibitionRoom.Right) {
+		return
+	}
+
 	// Call use case to update exhibition
 	objectID, err := h.RoomService.UpdateExhibitionRoom(c.Request.Context(), RoomID, &requestUpdateExhibitionRoom)
+	if helper.RespondBlockedContent(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update exhibition"})
 		return
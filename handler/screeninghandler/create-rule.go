@@ -0,0 +1,54 @@
+package screeninghandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Create screening rule
+//	@Description	Add a rule the texts of exhibitions, sections and rooms are screened against when they are created or updated. A words rule matches any of its words, ignoring case, on their own in English or anywhere in Thai text. A pattern rule matches a regular expression in RE2 syntax against the lower-cased text. Texts matching a flag rule hold the exhibition for review in the moderation queue, and texts matching a block rule are rejected.
+//	@Tags			Screening
+//	@Security		BearerAuth
+//	@ID				CreateScreeningRule
+//	@Accept			json
+//	@Produce		json
+//	@Param			requestScreeningRule	body		model.RequestScreeningRule	true	"Screening rule"
+//	@Success		201						{object}	model.ScreeningRule
+//	@Failure		400						{object}	helper.APIError	"Invalid request body or pattern"
+//	@Failure		403						{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500						{object}	helper.APIError	"Internal server error"
+//	@Router			/api/screening/rules [post]
+func (h *Handler) CreateRule(c *gin.Context) {
+	var requestRule model.RequestScreeningRule
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&requestRule); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestRule); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	rule, err := h.ScreeningService.CreateRule(c.Request.Context(), &requestRule)
+	if err != nil {
+		log.Printf("Error creating screening rule: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, rule)
+}
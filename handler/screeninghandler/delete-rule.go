@@ -0,0 +1,31 @@
+package screeninghandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete screening rule by ID
+//	@Description	Delete a screening rule. Exhibitions it held for review stay in the moderation queue.
+//	@Tags			Screening
+//	@Security		BearerAuth
+//	@ID				DeleteScreeningRule
+//	@Produce		json
+//	@Param			id	path		string							true	"Screening rule ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete Screening Rule Success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError					"Screening rule not found"
+//	@Router			/api/screening/rules/{id} [delete]
+func (h *Handler) DeleteRule(c *gin.Context) {
+	ruleID := c.Param("id")
+
+	if err := h.ScreeningService.DeleteRule(c.Request.Context(), ruleID); err != nil {
+		log.Printf("Error deleting screening rule %s: %v", ruleID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": ruleID + " has been deleted."})
+}
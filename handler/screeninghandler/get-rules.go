@@ -0,0 +1,29 @@
+package screeninghandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get screening rules
+//	@Description	Get the screening rules, enabled or not, in the order they were created.
+//	@Tags			Screening
+//	@Security		BearerAuth
+//	@ID				GetScreeningRules
+//	@Produce		json
+//	@Success		200	{object}	[]model.ScreeningRule
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/screening/rules [get]
+func (h *Handler) GetRules(c *gin.Context) {
+	rules, err := h.ScreeningService.GetRules(c.Request.Context())
+	if err != nil {
+		log.Printf("Error retrieving screening rules: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
@@ -0,0 +1,27 @@
+package screeninghandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	ScreeningService screeningsvc.IScreeningServices
+}
+
+// respondError writes the HTTP response matching a screening service error.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, cerr.ErrInvalidScreeningRule):
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+	case errors.Is(err, cerr.ErrScreeningRuleNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,56 @@
+package screeninghandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update screening rule by ID
+//	@Description	Replace the words or pattern, severity and state of a screening rule. Content already written is not screened again.
+//	@Tags			Screening
+//	@Security		BearerAuth
+//	@ID				UpdateScreeningRule
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		string						true	"Screening rule ID"
+//	@Param			requestScreeningRule	body		model.RequestScreeningRule	true	"Screening rule"
+//	@Success		200						{object}	model.ScreeningRule
+//	@Failure		400						{object}	helper.APIError	"Invalid request body or pattern"
+//	@Failure		403						{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404						{object}	helper.APIError	"Screening rule not found"
+//	@Router			/api/screening/rules/{id} [put]
+func (h *Handler) UpdateRule(c *gin.Context) {
+	ruleID := c.Param("id")
+	var requestRule model.RequestScreeningRule
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&requestRule); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestRule); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	rule, err := h.ScreeningService.UpdateRule(c.Request.Context(), ruleID, &requestRule)
+	if err != nil {
+		log.Printf("Error updating screening rule %s: %v", ruleID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
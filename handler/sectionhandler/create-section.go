@@ -1,6 +1,7 @@
 package sectionhandler
 
 import (
+	"atommuse/backend/exhibition-service/pkg/helper"
 	"atommuse/backend/exhibition-service/pkg/model"
 	"fmt"
 	"net/http"
@@ -10,7 +11,7 @@ import (
 )
 
 //	@Summary		Create a new exhibitionSection
-//	@Description	Create a new exhibitionSection data
+//	@Description	Create a new exhibitionSection data. Texts matching a screening rule hold the exhibition for review, or are rejected when the rule blocks them.
 //	@Tags			Sections
 //	@Security		BearerAuth
 //
@@ -22,6 +23,8 @@ import (
 //	@Success		201							{object}	model.ResponseGetExhibitionSectionId	"Success"
 //	@Failure		400							{object}	helper.APIError
 //	@Failure		401
+//	@Failure		403							{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		422							{object}	helper.APIError	"Content blocked by screening, or artwork from another catalogue"
 //	@Failure		500	"Invalid request body"
 //	@Router			/api/sections [post]
 func (h *Handler) CreateExhibitionSection(c *gin.Context) {
@@ -45,8 +48,20 @@ func (h *Handler) CreateExhibitionSection(c *gin.Context) {
 		return
 	}
 
+	// Only the owner and editors may add to the exhibition
+	access, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, requestExhibitionSection.ExhibitionID.Hex(), model.RoleEditor)
+	if !ok {
+		return
+	}
+	if !h.checkArtworks(c, access, requestExhibitionSection.LeftCol, requestExhibitionSection.RightCol) {
+		return
+	}
+
 	// Call use case to create exhibition
 	objectID, err := h.SectionService.CreateExhibitionSection(c.Request.Context(), &requestExhibitionSection)
+	if helper.RespondBlockedContent(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"errorMessage": "Failed to create exhibition"})
 		return
@@ -18,11 +18,17 @@ import (
 //	@Param			id	path		string							true	"Section ID"
 //	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete Section Success"
 //	@Failure		401
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
 //	@Failure		500	{object}	helper.APIError	"Internal server error"
 //	@Router			/api/sections/{id} [delete]
 func (h *Handler) DeleteExhibitionSectionByID(c *gin.Context) {
 	sectionID := c.Param("id")
 
+	// Only the owner and editors may change the exhibition
+	if _, ok := h.authorizeSection(c, sectionID); !ok {
+		return
+	}
+
 	err := h.SectionService.DeleteExhibitionSectionByID(c.Request.Context(), sectionID)
 	if err != nil {
 		log.Printf("Error deleting exhibitionSection %s: %v", sectionID, err)
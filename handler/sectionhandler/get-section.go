@@ -1,6 +1,10 @@
 package sectionhandler
 
 import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
 	"log"
 	"net/http"
 
@@ -8,16 +12,18 @@ import (
 )
 
 //	@Summary		Get exhibitionSection by ID
-//	@Description	Get exhibition data by sectionID
+//	@Description	Get exhibition data by sectionID. Sections are only shown to those who may see their exhibition, without media under embargo.
 //	@Tags			Sections
 //
 //	@Security		BearerAuth
 //
 //	@ID				GetExhibitionSectionByID
 //	@Produce		json
-//	@Param			id	path		string	true	"Exhibition Section ID"
+//	@Param			id		path		string	true	"Exhibition Section ID"
+//	@Param			lang	query		string	false	"Preferred locales, before those of the Accept-Language header"
 //	@Success		200	{object}	model.ResponseExhibitionSection
 //	@Failure		401
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
 //	@Failure		500	{object}	helper.APIError	"Internal server error"
 //	@Router			/api/sections/{id} [get]
 func (h *Handler) GetExhibitionSectionByID(c *gin.Context) {
@@ -31,8 +37,17 @@ func (h *Handler) GetExhibitionSectionByID(c *gin.Context) {
 		return
 	}
 
-	// Return the exhibition details
-	c.JSON(http.StatusOK, exhibitionSection)
+	content, err := h.ExhibitionService.PrepareContent(c, exhibitionSection.ExhibitionID.Hex(), helper.GetViewer(c), []model.ExhibitionSection{model.ExhibitionSection(*exhibitionSection)}, nil)
+	if err != nil {
+		log.Printf("Error retrieving exhibition of section %s: %v", sectionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	// Return the section in the language of the client
+	i18n.Localize(content, helper.Languages(c))
+	c.Header("Content-Language", content.Locale)
+	c.JSON(http.StatusOK, model.ResponseExhibitionSection(content.ExhibitionSections[0]))
 }
 
 //	@Summary		Get all exhibitions sections
@@ -60,14 +75,16 @@ func (h *Handler) GetAllExhibitionSections(c *gin.Context) {
 }
 
 //	@Summary		Get Sections By exhibitionID
-//	@Description	Get Sections By exhibitionID
+//	@Description	Get Sections By exhibitionID. Sections are only shown to those who may see the exhibition, without media under embargo.
 //	@Tags			Sections
 //	@Security		BearerAuth
 //	@ID				GetSectionsByExhibitionID
 //	@Produce		json
-//	@Param			id	path		string	true	"Exhibition ID"
+//	@Param			id		path		string	true	"Exhibition ID"
+//	@Param			lang	query		string	false	"Preferred locales, before those of the Accept-Language header"
 //	@Success		200	{object}	[]model.ResponseExhibitionSection
 //	@Failure		401
+//	@Failure		404	{object}	helper.APIError	"Exhibition not found"
 //	@Failure		500	{object}	helper.APIError	"Internal server error"
 //	@Router			/api/exhibitions/{id}/sections [get]
 func (h *Handler) GetSectionsByExhibitionID(c *gin.Context) {
@@ -81,6 +98,15 @@ func (h *Handler) GetSectionsByExhibitionID(c *gin.Context) {
 		return
 	}
 
-	// Return the sections as JSON response
-	c.JSON(http.StatusOK, sections)
+	content, err := h.ExhibitionService.PrepareContent(c, exhibitionID, helper.GetViewer(c), sections, nil)
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	// Return the sections in the language of the client
+	i18n.Localize(content, helper.Languages(c))
+	c.Header("Content-Language", content.Locale)
+	c.JSON(http.StatusOK, content.ExhibitionSections)
 }
@@ -1,8 +1,62 @@
 package sectionhandler
 
-import "atommuse/backend/exhibition-service/pkg/service/sectionsvc"
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/artworksvc"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/service/sectionsvc"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
 
 // Handler is responsible for handling HTTP requests.
 type Handler struct {
-	SectionService sectionsvc.ISectionServices
+	SectionService      sectionsvc.ISectionServices
+	ExhibitionService   exhibisvc.IExhibitionServices
+	CollaboratorService collabsvc.ICollaboratorServices
+	// ArtworkService checks the artworks sections reference. They are not checked when it is nil.
+	ArtworkService artworksvc.IArtworkServices
+}
+
+// authorizeSection checks that the current user may edit the exhibition owning the section.
+// It writes the error response and returns false when the request must stop.
+func (h *Handler) authorizeSection(c *gin.Context, sectionID string) (*model.ExhibitionAccess, bool) {
+	section, err := h.SectionService.GetExhibitionSectionByID(c.Request.Context(), sectionID)
+	if err != nil {
+		log.Printf("Error retrieving exhibition Section %s: %v", sectionID, err)
+		c.JSON(http.StatusNotFound, gin.H{"error": "Exhibition section not found"})
+		return nil, false
+	}
+
+	return helper.AuthorizeExhibition(c, h.CollaboratorService, section.ExhibitionID.Hex(), model.RoleEditor)
+}
+
+// checkArtworks checks that the artworks a section references come from the catalogue of the
+// exhibition owner or of the current user. It writes the error response and returns false when
+// the request must stop.
+func (h *Handler) checkArtworks(c *gin.Context, access *model.ExhibitionAccess, leftCol model.LeftColumn, rightCol model.RightColumn) bool {
+	if h.ArtworkService == nil {
+		return true
+	}
+
+	actor, _ := helper.GetActor(c)
+	section := model.ExhibitionSection{LeftCol: leftCol, RightCol: rightCol}
+	ids := artworksvc.ReferencedIDs(&model.ResponseExhibition{ExhibitionSections: []model.ExhibitionSection{section}})
+	err := h.ArtworkService.CheckReferences(c.Request.Context(), ids, access.UserID.UserID, actor.UserID.UserID)
+	if errors.Is(err, cerr.ErrArtworkNotAllowed) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return false
+	}
+	if err != nil {
+		log.Printf("Error checking artworks of exhibition %s: %v", access.ID.Hex(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return false
+	}
+	return true
 }
@@ -0,0 +1,100 @@
+package sectionhandler_test
+
+import (
+	"atommuse/backend/exhibition-service/handler/sectionhandler"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/service/sectionsvc"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type sectionService struct {
+	sectionsvc.ISectionServices
+	section model.ResponseExhibitionSection
+	updated bool
+}
+
+func (s *sectionService) GetExhibitionSectionByID(ctx context.Context, sectionID string) (*model.ResponseExhibitionSection, error) {
+	return &s.section, nil
+}
+
+func (s *sectionService) UpdateExhibitionSection(ctx context.Context, sectionID string, updatedSection *model.RequestUpdateExhibitionSection) (*primitive.ObjectID, error) {
+	s.updated = true
+	return &s.section.ID, nil
+}
+
+type exhibitionService struct {
+	exhibisvc.IExhibitionServices
+}
+
+func (exhibitionService) PrepareContent(ctx *gin.Context, exhibitionID string, viewer model.ExhibitionViewer, sections []model.ExhibitionSection, rooms []model.Room) (*model.ResponseExhibition, error) {
+	return &model.ResponseExhibition{DefaultLocale: "en", ExhibitionSections: sections, Room: rooms}, nil
+}
+
+type collaboratorService struct {
+	collabsvc.ICollaboratorServices
+}
+
+func (collaboratorService) Authorize(ctx context.Context, exhibitionID string, actor model.Actor, required string) (*model.ExhibitionAccess, error) {
+	id, err := primitive.ObjectIDFromHex(exhibitionID)
+	return &model.ExhibitionAccess{ID: id}, err
+}
+
+func TestUpdateExhibitionSectionKeepsExhibition(t *testing.T) {
+	exhibitionID := primitive.NewObjectID()
+	tests := []struct {
+		name         string
+		exhibitionID primitive.ObjectID
+		status       int
+	}{
+		{"same exhibition", exhibitionID, http.StatusOK},
+		{"other exhibition", primitive.NewObjectID(), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &sectionService{section: model.ResponseExhibitionSection{ID: primitive.NewObjectID(), ExhibitionID: exhibitionID}}
+			handler := sectionhandler.Handler{SectionService: service, CollaboratorService: collaboratorService{}}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			body := `{"sectionType":"text","exhibitionID":"` + tt.exhibitionID.Hex() + `"}`
+			c.Request = httptest.NewRequest(http.MethodPut, "/api/sections/"+service.section.ID.Hex(), strings.NewReader(body))
+			c.Params = gin.Params{{Key: "id", Value: service.section.ID.Hex()}}
+
+			handler.UpdateExhibitionSection(c)
+			assert.Equal(t, tt.status, recorder.Code)
+			assert.Equal(t, tt.status == http.StatusOK, service.updated)
+		})
+	}
+}
+
+func TestGetExhibitionSectionByIDLocalizes(t *testing.T) {
+	service := &sectionService{section: model.ResponseExhibitionSection{
+		ID:           primitive.NewObjectID(),
+		ExhibitionID: primitive.NewObjectID(),
+		Title:        "Celadon",
+		Translations: map[string]model.SectionTranslation{"th": {Title: "เซลาดอน"}},
+	}}
+	handler := sectionhandler.Handler{SectionService: service, ExhibitionService: exhibitionService{}}
+
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/sections/"+service.section.ID.Hex(), nil)
+	c.Request.Header.Set("Accept-Language", "th")
+	c.Params = gin.Params{{Key: "id", Value: service.section.ID.Hex()}}
+
+	handler.GetExhibitionSectionByID(c)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "th", recorder.Header().Get("Content-Language"))
+	assert.Contains(t, recorder.Body.String(), `"title":"เซลาดอน"`)
+}
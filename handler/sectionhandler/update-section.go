@@ -1,6 +1,7 @@
 package sectionhandler
 
 import (
+	"atommuse/backend/exhibition-service/pkg/helper"
 	"atommuse/backend/exhibition-service/pkg/model"
 	"fmt"
 	"net/http"
@@ -10,7 +11,7 @@ import (
 )
 
 //	@Summary		Update exhibitionSection by sectionID
-//	@Description	Update exhibitionSection data by sectionID
+//	@Description	Update exhibitionSection data by sectionID. The texts are screened as when the section is created.
 //	@Tags			Sections
 //	@Security		BearerAuth
 //	@ID				UpdateExhibitionSection
@@ -20,7 +21,10 @@ import (
 //	@Param			updateRequest	body		model.RequestUpdateExhibitionSection	true	"ExhibitionSection data to update"
 //
 //	@Success		200				{object}	model.ResponseExhibition
+//	@Failure		400	{object}	helper.APIError	"Invalid request body, or another exhibitionID than the current one"
 //	@Failure		401
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		422	{object}	helper.APIError	"Content blocked by screening, or artwork from another catalogue"
 //	@Failure		500	{object}	helper.APIError	"Internal server error"
 //	@Router			/api/sections/{id} [put]
 func (h *Handler) UpdateExhibitionSection(c *gin.Context) {
@@ -46,8 +50,26 @@ func (h *Handler) UpdateExhibitionSection(c *gin.Context) {
 	// Get section ID from the URL parameter
 	sectionID := c.Param("id")
 
+	// Only the owner and editors may change the exhibition
+	access, ok := h.authorizeSection(c, sectionID)
+	if !ok {
+		return
+	}
+
+	// Sections stay in their exhibition, editors of one exhibition may not move them to another
+	if requestUpdateExhibitionSection.ExhibitionID != access.ID {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "The exhibition of a section cannot be changed"})
+		return
+	}
+	if !h.checkArtworks(c, access, requestUpdateExhibitionSection.LeftCol, requestUpdateExhibitionSection.RightCol) {
+		return
+	}
+
 	// Call use case to update exhibition
 	objectID, err := h.SectionService.UpdateExhibitionSection(c.Request.Context(), sectionID, &requestUpdateExhibitionSection)
+	if helper.RespondBlockedContent(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update exhibition"})
 		return
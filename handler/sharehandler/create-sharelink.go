@@ -0,0 +1,68 @@
+package sharehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Create a share link
+//	@Description	Create a tokenised preview link to an exhibition, optionally with expiry and password. The token is only returned once.
+//	@Tags			Share Links
+//	@Security		BearerAuth
+//	@ID				CreateShareLink
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		string							true	"Exhibition ID"
+//	@Param			requestShareLink	body		model.RequestCreateShareLink	true	"Share link options"
+//	@Success		201					{object}	model.ResponseCreateShareLink
+//	@Failure		400					{object}	helper.APIError	"Invalid request body"
+//	@Failure		403					{object}	helper.APIError	"Insufficient permissions"
+//	@Router			/api/exhibitions/{id}/share-links [post]
+func (h *Handler) CreateShareLink(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	var requestShareLink model.RequestCreateShareLink
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestShareLink); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestShareLink); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	if requestShareLink.ExpiresAt != nil && !requestShareLink.ExpiresAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "expiresAt must be in the future"})
+		return
+	}
+
+	shareLink, err := h.ShareLinkService.CreateShareLink(c.Request.Context(), exhibitionID, actor, &requestShareLink)
+	if err != nil {
+		log.Printf("Error creating share link for exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, shareLink)
+}
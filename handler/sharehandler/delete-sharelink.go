@@ -0,0 +1,40 @@
+package sharehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Revoke a share link
+//	@Description	Revoke a share link so it can no longer be used
+//	@Tags			Share Links
+//	@Security		BearerAuth
+//	@ID				RevokeShareLink
+//	@Produce		json
+//	@Param			id		path	string	true	"Exhibition ID"
+//	@Param			linkId	path	string	true	"Share link ID"
+//	@Success		200
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Share link not found"
+//	@Router			/api/exhibitions/{id}/share-links/{linkId} [delete]
+func (h *Handler) RevokeShareLink(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	linkID := c.Param("linkId")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	if err := h.ShareLinkService.RevokeShareLink(c.Request.Context(), exhibitionID, linkID, actor); err != nil {
+		log.Printf("Error revoking share link %s of exhibition %s: %v", linkID, exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Share link revoked successfully"})
+}
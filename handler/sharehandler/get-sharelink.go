@@ -0,0 +1,83 @@
+package sharehandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get share links of an exhibition
+//	@Description	Get all share links of an exhibition, including expired and revoked ones
+//	@Tags			Share Links
+//	@Security		BearerAuth
+//	@ID				GetShareLinks
+//	@Produce		json
+//	@Param			id	path		string	true	"Exhibition ID"
+//	@Success		200	{object}	[]model.ShareLink
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/share-links [get]
+func (h *Handler) GetShareLinks(c *gin.Context) {
+	exhibitionID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	shareLinks, err := h.ShareLinkService.GetShareLinks(c.Request.Context(), exhibitionID, actor)
+	if err != nil {
+		log.Printf("Error retrieving share links of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, shareLinks)
+}
+
+//	@Summary		Preview an exhibition through a share link
+//	@Description	Get the exhibition a share link points to. Password-protected links need the X-Share-Password header.
+//	@Tags			Share Links
+//	@ID				GetSharedExhibition
+//	@Produce		json
+//	@Param			token				path		string	true	"Share link token"
+//	@Param			X-Share-Password	header		string	false	"Share link password"
+//	@Param			lang				query		string	false	"Preferred locales, before those of the Accept-Language header"
+//	@Success		200					{object}	model.ResponseExhibition
+//	@Failure		401					{object}	helper.APIError	"Password required or invalid"
+//	@Failure		404					{object}	helper.APIError	"Share link not found"
+//	@Failure		410					{object}	helper.APIError	"Share link expired"
+//	@Router			/api/preview/{token} [get]
+func (h *Handler) GetSharedExhibition(c *gin.Context) {
+	viewer := model.ExhibitionViewer{
+		ShareToken:    c.Param("token"),
+		SharePassword: c.GetHeader("X-Share-Password"),
+	}
+	if actor, ok := helper.GetActor(c); ok {
+		viewer.Actor = &actor
+	}
+
+	shareLink, err := h.ShareLinkService.ResolveShareLink(c.Request.Context(), viewer.ShareToken, viewer.SharePassword)
+	if err != nil {
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, shareLink.ExhibitionID.Hex(), viewer)
+	if err != nil {
+		log.Printf("Error retrieving shared exhibition %s: %v", shareLink.ExhibitionID.Hex(), err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	// Return the exhibition in the language of the client
+	i18n.Localize(exhibition, helper.Languages(c))
+	c.Header("Content-Language", exhibition.Locale)
+	c.JSON(http.StatusOK, exhibition)
+}
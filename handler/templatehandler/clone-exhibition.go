@@ -0,0 +1,53 @@
+package templatehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Clone an exhibition
+//	@Description	Deep-copy an exhibition with its sections and rooms into a new private exhibition owned by the current user. Its texts are screened as for a new exhibition: a copy with flagged texts is held for review and blocked texts are rejected. References to artworks outside the catalogue of the current user are left out of the copy.
+//	@Tags			Templates
+//	@Security		BearerAuth
+//	@ID				CloneExhibition
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string						true	"Exhibition ID"
+//	@Param			cloneRequest	body		model.RequestCloneExhibition	false	"Clone options"
+//	@Success		201				{object}	model.ResponseGetExhibitionId	"Success"
+//	@Failure		403				{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError					"Exhibition not found"
+//	@Failure		422				{object}	helper.APIError					"Content blocked by screening"
+//	@Failure		500				{object}	helper.APIError					"Internal server error"
+//	@Router			/api/exhibitions/{id}/clone [post]
+func (h *Handler) CloneExhibition(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	var cloneRequest model.RequestCloneExhibition
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// The body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.BindJSON(&cloneRequest); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+			return
+		}
+	}
+
+	objectID, err := h.TemplateService.CloneExhibition(c.Request.Context(), exhibitionID, actor, &cloneRequest)
+	if err != nil {
+		log.Printf("Error cloning exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": objectID.Hex()})
+}
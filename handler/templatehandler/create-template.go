@@ -0,0 +1,111 @@
+package templatehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Save an exhibition as a template
+//	@Description	Save an exhibition structure as a template. Content is replaced with placeholders unless keepContent is set. Only admins can create system templates.
+//	@Tags			Templates
+//	@Security		BearerAuth
+//	@ID				CreateTemplate
+//	@Accept			json
+//	@Produce		json
+//	@Param			requestTemplate	body		model.RequestCreateTemplate	true	"Template data"
+//	@Success		201				{object}	model.ResponseGetExhibitionId	"Success"
+//	@Failure		400				{object}	helper.APIError					"Invalid request body"
+//	@Failure		403				{object}	helper.APIError					"Insufficient permissions"
+//	@Router			/api/templates [post]
+func (h *Handler) CreateTemplate(c *gin.Context) {
+	var requestTemplate model.RequestCreateTemplate
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestTemplate); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestTemplate); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	objectID, err := h.TemplateService.CreateTemplate(c.Request.Context(), actor, &requestTemplate)
+	if err != nil {
+		log.Printf("Error creating template: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": objectID.Hex()})
+}
+
+//	@Summary		Create an exhibition from a template
+//	@Description	Create a new private exhibition owned by the current user from a template. Its texts are screened as for a new exhibition: an exhibition with flagged texts is held for review and blocked texts are rejected.
+//	@Tags			Templates
+//	@Security		BearerAuth
+//	@ID				InstantiateTemplate
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		string								true	"Template ID"
+//	@Param			requestInstantiate	body		model.RequestInstantiateTemplate	true	"New exhibition data"
+//	@Success		201					{object}	model.ResponseGetExhibitionId		"Success"
+//	@Failure		400					{object}	helper.APIError						"Invalid request body"
+//	@Failure		404					{object}	helper.APIError						"Template not found"
+//	@Failure		422					{object}	helper.APIError						"Content blocked by screening"
+//	@Router			/api/templates/{id}/instantiate [post]
+func (h *Handler) InstantiateTemplate(c *gin.Context) {
+	templateID := c.Param("id")
+	var requestInstantiate model.RequestInstantiateTemplate
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestInstantiate); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestInstantiate); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	objectID, err := h.TemplateService.InstantiateTemplate(c.Request.Context(), templateID, actor, &requestInstantiate)
+	if err != nil {
+		log.Printf("Error instantiating template %s: %v", templateID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": objectID.Hex()})
+}
@@ -0,0 +1,38 @@
+package templatehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete template by ID
+//	@Description	Delete a template. Users can delete their own templates, admins any template.
+//	@Tags			Templates
+//	@Security		BearerAuth
+//	@ID				DeleteTemplate
+//	@Produce		json
+//	@Param			id	path		string							true	"Template ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete Template Success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError					"Template not found"
+//	@Router			/api/templates/{id} [delete]
+func (h *Handler) DeleteTemplate(c *gin.Context) {
+	templateID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	if err := h.TemplateService.DeleteTemplate(c.Request.Context(), templateID, actor); err != nil {
+		log.Printf("Error deleting template %s: %v", templateID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": templateID + " has been deleted."})
+}
@@ -0,0 +1,64 @@
+package templatehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get templates
+//	@Description	Get the system templates and the templates saved by the current user
+//	@Tags			Templates
+//	@Security		BearerAuth
+//	@ID				GetTemplates
+//	@Produce		json
+//	@Success		200	{object}	[]model.ExhibitionTemplate
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/templates [get]
+func (h *Handler) GetTemplates(c *gin.Context) {
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	templates, err := h.TemplateService.GetTemplates(c.Request.Context(), actor)
+	if err != nil {
+		log.Printf("Error retrieving templates: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, templates)
+}
+
+//	@Summary		Get template by ID
+//	@Description	Get a template with its sections and rooms
+//	@Tags			Templates
+//	@Security		BearerAuth
+//	@ID				GetTemplateByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Template ID"
+//	@Success		200	{object}	model.ExhibitionTemplate
+//	@Failure		404	{object}	helper.APIError	"Template not found"
+//	@Router			/api/templates/{id} [get]
+func (h *Handler) GetTemplateByID(c *gin.Context) {
+	templateID := c.Param("id")
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	template, err := h.TemplateService.GetTemplateByID(c.Request.Context(), templateID, actor)
+	if err != nil {
+		log.Printf("Error retrieving template %s: %v", templateID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
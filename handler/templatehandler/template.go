@@ -0,0 +1,30 @@
+package templatehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/service/templatesvc"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	TemplateService templatesvc.ITemplateServices
+}
+
+// respondError writes the HTTP response matching a template service error.
+func respondError(c *gin.Context, err error) {
+	if helper.RespondBlockedContent(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, cerr.ErrTemplateNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		helper.RespondAccessError(c, err)
+	}
+}
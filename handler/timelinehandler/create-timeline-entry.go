@@ -0,0 +1,61 @@
+package timelinehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Create a timeline entry
+//	@Description	Add a dated entry to a timelineLayout exhibition. Dates are YYYY, YYYY-MM or YYYY-MM-DD, with negative years before the common era, and an entry may not end before it starts.
+//	@Tags			Timeline
+//	@Security		BearerAuth
+//	@ID				CreateTimelineEntry
+//	@Accept			json
+//	@Produce		json
+//	@Param			requestTimelineEntry	body		model.RequestCreateTimelineEntry	true	"Timeline entry data to create"
+//	@Success		201						{object}	model.ResponseGetExhibitionId		"Success"
+//	@Failure		400						{object}	helper.APIError						"Invalid request body or dates"
+//	@Failure		403						{object}	helper.APIError						"Insufficient permissions"
+//	@Failure		404						{object}	helper.APIError						"Exhibition not found"
+//	@Failure		409						{object}	helper.APIError						"Exhibition does not use the timeline layout"
+//	@Router			/api/timeline-entries [post]
+func (h *Handler) CreateTimelineEntry(c *gin.Context) {
+	var requestTimelineEntry model.RequestCreateTimelineEntry
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&requestTimelineEntry); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestTimelineEntry); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may add to the exhibition
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, requestTimelineEntry.ExhibitionID.Hex(), model.RoleEditor); !ok {
+		return
+	}
+
+	objectID, err := h.TimelineService.CreateTimelineEntry(c.Request.Context(), &requestTimelineEntry)
+	if err != nil {
+		log.Printf("Error creating timeline entry: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": objectID.Hex()})
+}
@@ -0,0 +1,36 @@
+package timelinehandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete timeline entry by ID
+//	@Description	Remove an entry from the timeline of its exhibition
+//	@Tags			Timeline
+//	@Security		BearerAuth
+//	@ID				DeleteTimelineEntry
+//	@Produce		json
+//	@Param			id	path		string							true	"Timeline entry ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete timeline entry success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError					"Timeline entry not found"
+//	@Router			/api/timeline-entries/{id} [delete]
+func (h *Handler) DeleteTimelineEntry(c *gin.Context) {
+	entryID := c.Param("id")
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeEntry(c, entryID) == nil {
+		return
+	}
+
+	if err := h.TimelineService.DeleteTimelineEntry(c.Request.Context(), entryID); err != nil {
+		log.Printf("Error deleting timeline entry %s: %v", entryID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": entryID + " has been deleted."})
+}
@@ -0,0 +1,27 @@
+package timelinehandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get timeline entry by ID
+//	@Description	Get a timeline entry with all its translations for editing
+//	@Tags			Timeline
+//	@Security		BearerAuth
+//	@ID				GetTimelineEntryByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Timeline entry ID"
+//	@Success		200	{object}	model.TimelineEntry
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Timeline entry not found"
+//	@Router			/api/timeline-entries/{id} [get]
+func (h *Handler) GetTimelineEntryByID(c *gin.Context) {
+	entry := h.authorizeEntry(c, c.Param("id"))
+	if entry == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
@@ -0,0 +1,52 @@
+package timelinehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/timelinesvc"
+	"atommuse/backend/exhibition-service/pkg/timeline"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	TimelineService     timelinesvc.ITimelineServices
+	CollaboratorService collabsvc.ICollaboratorServices
+}
+
+// authorizeEntry checks that the current user may edit the exhibition owning the entry and
+// returns the entry. It writes the error response and returns nil when the request must stop.
+func (h *Handler) authorizeEntry(c *gin.Context, entryID string) *model.TimelineEntry {
+	entry, err := h.TimelineService.GetTimelineEntryByID(c.Request.Context(), entryID)
+	if err != nil {
+		log.Printf("Error retrieving timeline entry %s: %v", entryID, err)
+		respondError(c, err)
+		return nil
+	}
+
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, entry.ExhibitionID.Hex(), model.RoleEditor); !ok {
+		return nil
+	}
+	return entry
+}
+
+// respondError writes the HTTP response matching a timeline service error.
+func respondError(c *gin.Context, err error) {
+	var invalid *timeline.ValidationError
+	switch {
+	case errors.As(err, &invalid):
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": invalid.Problems})
+	case errors.Is(err, cerr.ErrTimelineEntryNotFound), errors.Is(err, cerr.ErrExhibitionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrLayoutMismatch):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,61 @@
+package timelinehandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update timeline entry by ID
+//	@Description	Replace the dates and content of a timeline entry
+//	@Tags			Timeline
+//	@Security		BearerAuth
+//	@ID				UpdateTimelineEntry
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string								true	"Timeline entry ID"
+//	@Param			updateRequest	body		model.RequestUpdateTimelineEntry	true	"Timeline entry data"
+//	@Success		200				{object}	model.TimelineEntry
+//	@Failure		400				{object}	helper.APIError	"Invalid request body or dates"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError	"Timeline entry not found"
+//	@Router			/api/timeline-entries/{id} [put]
+func (h *Handler) UpdateTimelineEntry(c *gin.Context) {
+	entryID := c.Param("id")
+	var updateRequest model.RequestUpdateTimelineEntry
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&updateRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(updateRequest); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeEntry(c, entryID) == nil {
+		return
+	}
+
+	entry, err := h.TimelineService.UpdateTimelineEntry(c.Request.Context(), entryID, &updateRequest)
+	if err != nil {
+		log.Printf("Error updating timeline entry %s: %v", entryID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
@@ -0,0 +1,60 @@
+package tourhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Create a tour
+//	@Description	Add a guided tour to an exhibition. Every stop references either a section or an item on a wall of a room, by its position on the wall.
+//	@Tags			Tours
+//	@Security		BearerAuth
+//	@ID				CreateTour
+//	@Accept			json
+//	@Produce		json
+//	@Param			requestTour	body		model.RequestTour				true	"Tour data to create"
+//	@Success		201			{object}	model.ResponseGetExhibitionId	"Success"
+//	@Failure		400			{object}	helper.APIError					"Invalid request body or stops"
+//	@Failure		403			{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404			{object}	helper.APIError					"Exhibition not found"
+//	@Router			/api/tours [post]
+func (h *Handler) CreateTour(c *gin.Context) {
+	var requestTour model.RequestTour
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&requestTour); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestTour); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may add to the exhibition
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, requestTour.ExhibitionID.Hex(), model.RoleEditor); !ok {
+		return
+	}
+
+	objectID, err := h.TourService.CreateTour(c.Request.Context(), &requestTour)
+	if err != nil {
+		log.Printf("Error creating tour: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"_id": objectID.Hex()})
+}
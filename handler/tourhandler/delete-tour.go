@@ -0,0 +1,36 @@
+package tourhandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete tour by ID
+//	@Description	Remove a tour from its exhibition
+//	@Tags			Tours
+//	@Security		BearerAuth
+//	@ID				DeleteTour
+//	@Produce		json
+//	@Param			id	path		string							true	"Tour ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete tour success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError					"Tour not found"
+//	@Router			/api/tours/{id} [delete]
+func (h *Handler) DeleteTour(c *gin.Context) {
+	tourID := c.Param("id")
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeTour(c, tourID) == nil {
+		return
+	}
+
+	if err := h.TourService.DeleteTour(c.Request.Context(), tourID); err != nil {
+		log.Printf("Error deleting tour %s: %v", tourID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": tourID + " has been deleted."})
+}
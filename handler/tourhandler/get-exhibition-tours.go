@@ -0,0 +1,54 @@
+package tourhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/tour"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get the tours of an exhibition
+//	@Description	Get the tours of an exhibition sorted by name, resolved for playback in the negotiated locale. The exhibition is visible to the same users as GET /api/exhibitions/{id}.
+//	@Tags			Tours
+//	@Security		BearerAuth
+//	@ID				GetExhibitionTours
+//	@Produce		json
+//	@Param			id					path		string	true	"Exhibition ID"
+//	@Param			share				query		string	false	"Share link token"
+//	@Param			X-Share-Token		header		string	false	"Share link token"
+//	@Param			X-Share-Password	header		string	false	"Share link password"
+//	@Param			lang				query		string	false	"Preferred locales, before those of the Accept-Language header"
+//	@Success		200					{array}		model.ResponseTour
+//	@Failure		404					{object}	helper.APIError	"Exhibition not found"
+//	@Failure		500					{object}	helper.APIError	"Internal server error"
+//	@Router			/api/exhibitions/{id}/tours [get]
+func (h *Handler) GetExhibitionTours(c *gin.Context) {
+	exhibitionID := c.Param("id")
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, exhibitionID, helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition %s: %v", exhibitionID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	tours, err := h.TourService.GetToursByExhibitionID(c.Request.Context(), exhibition.ID)
+	if err != nil {
+		log.Printf("Error retrieving tours of exhibition %s: %v", exhibitionID, err)
+		respondError(c, err)
+		return
+	}
+
+	i18n.Localize(exhibition, helper.Languages(c))
+	resolved := make([]model.ResponseTour, len(tours))
+	for i := range tours {
+		resolved[i] = *tour.Resolve(&tours[i], exhibition)
+	}
+
+	c.Header("Content-Language", exhibition.Locale)
+	c.JSON(http.StatusOK, resolved)
+}
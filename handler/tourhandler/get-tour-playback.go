@@ -0,0 +1,48 @@
+package tourhandler
+
+import (
+	"atommuse/backend/exhibition-service/handler/exhibihandler"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/tour"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get a tour for playback
+//	@Description	Get a tour with the narration, audio and referenced section or room item of every stop in the negotiated locale. Stops whose section or item was removed are left out. The tour is visible to the same users as its exhibition.
+//	@Tags			Tours
+//	@Security		BearerAuth
+//	@ID				GetTourPlayback
+//	@Produce		json
+//	@Param			id					path		string	true	"Tour ID"
+//	@Param			share				query		string	false	"Share link token"
+//	@Param			X-Share-Token		header		string	false	"Share link token"
+//	@Param			X-Share-Password	header		string	false	"Share link password"
+//	@Param			lang				query		string	false	"Preferred locales, before those of the Accept-Language header"
+//	@Success		200					{object}	model.ResponseTour
+//	@Failure		404					{object}	helper.APIError	"Tour not found"
+//	@Failure		500					{object}	helper.APIError	"Internal server error"
+//	@Router			/api/tours/{id}/playback [get]
+func (h *Handler) GetTourPlayback(c *gin.Context) {
+	tourID := c.Param("id")
+	existing, err := h.TourService.GetTourByID(c.Request.Context(), tourID)
+	if err != nil {
+		log.Printf("Error retrieving tour %s: %v", tourID, err)
+		respondError(c, err)
+		return
+	}
+
+	exhibition, err := h.ExhibitionService.GetExhibitionByID(c, existing.ExhibitionID.Hex(), helper.GetViewer(c))
+	if err != nil {
+		log.Printf("Error retrieving exhibition of tour %s: %v", tourID, err)
+		exhibihandler.RespondViewError(c, err)
+		return
+	}
+
+	i18n.Localize(exhibition, helper.Languages(c))
+	c.Header("Content-Language", exhibition.Locale)
+	c.JSON(http.StatusOK, tour.Resolve(existing, exhibition))
+}
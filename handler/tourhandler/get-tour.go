@@ -0,0 +1,27 @@
+package tourhandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get tour by ID
+//	@Description	Get a tour with all its stops and translations for editing
+//	@Tags			Tours
+//	@Security		BearerAuth
+//	@ID				GetTourByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Tour ID"
+//	@Success		200	{object}	model.Tour
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Tour not found"
+//	@Router			/api/tours/{id} [get]
+func (h *Handler) GetTourByID(c *gin.Context) {
+	existing := h.authorizeTour(c, c.Param("id"))
+	if existing == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, existing)
+}
@@ -0,0 +1,56 @@
+package tourhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/service/toursvc"
+	"atommuse/backend/exhibition-service/pkg/tour"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	TourService         toursvc.ITourServices
+	ExhibitionService   exhibisvc.IExhibitionServices
+	CollaboratorService collabsvc.ICollaboratorServices
+}
+
+// authorizeTour checks that the current user may edit the exhibition owning the tour and
+// returns the tour. It writes the error response and returns nil when the request must stop.
+func (h *Handler) authorizeTour(c *gin.Context, tourID string) *model.Tour {
+	existing, err := h.TourService.GetTourByID(c.Request.Context(), tourID)
+	if err != nil {
+		log.Printf("Error retrieving tour %s: %v", tourID, err)
+		respondError(c, err)
+		return nil
+	}
+
+	if _, ok := helper.AuthorizeExhibition(c, h.CollaboratorService, existing.ExhibitionID.Hex(), model.RoleEditor); !ok {
+		return nil
+	}
+	return existing
+}
+
+// respondError writes the HTTP response matching a tour service error.
+func respondError(c *gin.Context, err error) {
+	var invalid *tour.ValidationError
+	switch {
+	case errors.As(err, &invalid):
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": invalid.Problems})
+	case errors.Is(err, cerr.ErrTourNotFound), errors.Is(err, cerr.ErrExhibitionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrUnsupportedAudio):
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrMediaStorageUnavailable):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
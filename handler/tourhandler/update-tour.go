@@ -0,0 +1,61 @@
+package tourhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update tour by ID
+//	@Description	Replace the name, stops and translations of a tour. The exhibition of a tour cannot change.
+//	@Tags			Tours
+//	@Security		BearerAuth
+//	@ID				UpdateTour
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string				true	"Tour ID"
+//	@Param			updateRequest	body		model.RequestTour	true	"Tour data"
+//	@Success		200				{object}	model.Tour
+//	@Failure		400				{object}	helper.APIError	"Invalid request body or stops"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError	"Tour not found"
+//	@Router			/api/tours/{id} [put]
+func (h *Handler) UpdateTour(c *gin.Context) {
+	tourID := c.Param("id")
+	var updateRequest model.RequestTour
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&updateRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(updateRequest); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeTour(c, tourID) == nil {
+		return
+	}
+
+	updated, err := h.TourService.UpdateTour(c.Request.Context(), tourID, &updateRequest)
+	if err != nil {
+		log.Printf("Error updating tour %s: %v", tourID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
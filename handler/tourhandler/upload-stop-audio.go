@@ -0,0 +1,94 @@
+package tourhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/tour"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Upload the narration of a tour stop
+//	@Description	Upload the audio recording of a stop, or of its translation when lang is given. MP3, M4A, AAC, Ogg, WAV, WebM and FLAC recordings of up to 50 MB are accepted. The duration of the stop is set with PUT /api/tours/{id}.
+//	@Tags			Tours
+//	@Security		BearerAuth
+//	@ID				UploadTourStopAudio
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id		path		string				true	"Tour ID"
+//	@Param			index	path		int					true	"Position of the stop in the tour, starting at 0"
+//	@Param			lang	query		string				false	"Locale of the translated narration"
+//	@Param			audio	formData	file				true	"Audio recording"
+//	@Success		200		{object}	map[string]string	"Media reference of the recording"
+//	@Failure		400		{object}	helper.APIError		"Missing file or unknown stop"
+//	@Failure		403		{object}	helper.APIError		"Insufficient permissions"
+//	@Failure		404		{object}	helper.APIError		"Tour not found"
+//	@Failure		413		{object}	helper.APIError		"Recording too large"
+//	@Failure		415		{object}	helper.APIError		"Unsupported audio format"
+//	@Failure		503		{object}	helper.APIError		"No media storage configured"
+//	@Router			/api/tours/{id}/stops/{index}/audio [post]
+func (h *Handler) UploadTourStopAudio(c *gin.Context) {
+	tourID := c.Param("id")
+	index, err := strconv.Atoi(c.Param("index"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid stop index"})
+		return
+	}
+
+	// Only the owner and editors may change the exhibition
+	if h.authorizeTour(c, tourID) == nil {
+		return
+	}
+
+	// Leave room for the multipart envelope around the file
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, tour.MaxAudioSize+1<<20)
+	header, err := c.FormFile("audio")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Audio file is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Audio file is required"})
+		return
+	}
+	if header.Size > tour.MaxAudioSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Audio file is too large"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		log.Printf("Error opening uploaded audio: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read audio file"})
+		return
+	}
+	defer file.Close()
+
+	// The declared type comes from the client, so the content is sniffed as well
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		log.Printf("Error reading uploaded audio: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read audio file"})
+		return
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		log.Printf("Error rewinding uploaded audio: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read audio file"})
+		return
+	}
+
+	sniffed := http.DetectContentType(head[:n])
+	ref, err := h.TourService.SetStopAudio(c.Request.Context(), tourID, index, c.Query("lang"), file, sniffed, header.Header.Get("Content-Type"))
+	if err != nil {
+		log.Printf("Error storing audio of tour %s stop %d: %v", tourID, index, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"audio": ref})
+}
@@ -0,0 +1,61 @@
+package webhookhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/helper"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Register a webhook
+//	@Description	Register an endpoint of another service to receive events, all of them or the types listed in events. Every event is posted as a model.WebhookPayload with the X-AtomMuse-Event and X-AtomMuse-Delivery headers and an X-AtomMuse-Signature header of the form t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with the secret>. The secret is only returned in this response. Deliveries that do not get a 2xx response within 10 seconds are retried after 30 seconds, doubling the wait up to 6 hours, and are dead after 10 failures. Receivers should deduplicate on the payload ID, which stays the same across retries.
+//	@Tags			Webhooks
+//	@Security		BearerAuth
+//	@ID				CreateWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			requestWebhook	body		model.RequestWebhook	true	"Webhook data"
+//	@Success		201				{object}	model.ResponseCreateWebhook
+//	@Failure		400				{object}	helper.APIError	"Invalid request body"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500				{object}	helper.APIError	"Internal server error"
+//	@Router			/api/webhooks [post]
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	var requestWebhook model.RequestWebhook
+	var validate = validator.New()
+
+	actor, ok := helper.GetActor(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token is required"})
+		return
+	}
+
+	// Parse request body
+	if err := c.BindJSON(&requestWebhook); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestWebhook); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	hook, err := h.WebhookService.CreateWebhook(c.Request.Context(), actor, &requestWebhook)
+	if err != nil {
+		log.Printf("Error creating webhook: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, hook)
+}
@@ -0,0 +1,31 @@
+package webhookhandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Delete webhook by ID
+//	@Description	Delete a webhook together with its deliveries.
+//	@Tags			Webhooks
+//	@Security		BearerAuth
+//	@ID				DeleteWebhook
+//	@Produce		json
+//	@Param			id	path		string							true	"Webhook ID"
+//	@Success		200	{object}	model.ResponseGetExhibitionId	"Delete Webhook Success"
+//	@Failure		403	{object}	helper.APIError					"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError					"Webhook not found"
+//	@Router			/api/webhooks/{id} [delete]
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	webhookID := c.Param("id")
+
+	if err := h.WebhookService.DeleteWebhook(c.Request.Context(), webhookID); err != nil {
+		log.Printf("Error deleting webhook %s: %v", webhookID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"_id": webhookID + " has been deleted."})
+}
@@ -0,0 +1,93 @@
+package webhookhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Default and largest number of deliveries listed at once.
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 200
+)
+
+//	@Summary		Get webhook deliveries
+//	@Description	Get the deliveries of webhooks, most recent first and without their attempts. Filter on status=dead for the dead-letter list of deliveries that gave up retrying. Deliveries are kept for 30 days.
+//	@Tags			Webhooks
+//	@Security		BearerAuth
+//	@ID				GetWebhookDeliveries
+//	@Produce		json
+//	@Param			webhookId	query		string	false	"Webhook ID"
+//	@Param			status		query		string	false	"Delivery status"	Enums(pending, delivered, dead)
+//	@Param			limit		query		int		false	"Number of deliveries, 50 by default and at most 200"
+//	@Success		200			{object}	[]model.WebhookDelivery
+//	@Failure		400			{object}	helper.APIError	"Invalid webhook ID, status or limit"
+//	@Failure		403			{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500			{object}	helper.APIError	"Internal server error"
+//	@Router			/api/webhook-deliveries [get]
+func (h *Handler) GetDeliveries(c *gin.Context) {
+	query := model.DeliveryQuery{Status: c.Query("status"), Limit: defaultDeliveryLimit}
+
+	if webhookID := c.Query("webhookId"); webhookID != "" {
+		id, err := primitive.ObjectIDFromHex(webhookID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+			return
+		}
+		query.WebhookID = &id
+	}
+
+	switch query.Status {
+	case "", model.DeliveryPending, model.DeliveryDelivered, model.DeliveryDead:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid delivery status"})
+		return
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		value, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || value < 1 || value > maxDeliveryLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+		query.Limit = value
+	}
+
+	deliveries, err := h.WebhookService.GetDeliveries(c.Request.Context(), query)
+	if err != nil {
+		log.Printf("Error retrieving webhook deliveries: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+//	@Summary		Get webhook delivery by ID
+//	@Description	Get a delivery with the log of its latest attempts: when they were made, the status and start of the response or the error, and how long they took.
+//	@Tags			Webhooks
+//	@Security		BearerAuth
+//	@ID				GetWebhookDeliveryByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Delivery ID"
+//	@Success		200	{object}	model.WebhookDelivery
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Delivery not found"
+//	@Router			/api/webhook-deliveries/{id} [get]
+func (h *Handler) GetDeliveryByID(c *gin.Context) {
+	deliveryID := c.Param("id")
+
+	delivery, err := h.WebhookService.GetDeliveryByID(c.Request.Context(), deliveryID)
+	if err != nil {
+		log.Printf("Error retrieving webhook delivery %s: %v", deliveryID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, delivery)
+}
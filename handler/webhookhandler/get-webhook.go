@@ -0,0 +1,53 @@
+package webhookhandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Get webhooks
+//	@Description	Get the registered webhooks in the order they were registered. Secrets are not returned.
+//	@Tags			Webhooks
+//	@Security		BearerAuth
+//	@ID				GetWebhooks
+//	@Produce		json
+//	@Success		200	{object}	[]model.Webhook
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		500	{object}	helper.APIError	"Internal server error"
+//	@Router			/api/webhooks [get]
+func (h *Handler) GetWebhooks(c *gin.Context) {
+	hooks, err := h.WebhookService.GetWebhooks(c.Request.Context())
+	if err != nil {
+		log.Printf("Error retrieving webhooks: %v", err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, hooks)
+}
+
+//	@Summary		Get webhook by ID
+//	@Description	Get a registered webhook. The secret is not returned.
+//	@Tags			Webhooks
+//	@Security		BearerAuth
+//	@ID				GetWebhookByID
+//	@Produce		json
+//	@Param			id	path		string	true	"Webhook ID"
+//	@Success		200	{object}	model.Webhook
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Webhook not found"
+//	@Router			/api/webhooks/{id} [get]
+func (h *Handler) GetWebhookByID(c *gin.Context) {
+	webhookID := c.Param("id")
+
+	hook, err := h.WebhookService.GetWebhookByID(c.Request.Context(), webhookID)
+	if err != nil {
+		log.Printf("Error retrieving webhook %s: %v", webhookID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, hook)
+}
@@ -0,0 +1,32 @@
+package webhookhandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//	@Summary		Redeliver a webhook delivery
+//	@Description	Send a delivery again right away, whatever its status, with a fresh series of retries. Dead deliveries leave the dead-letter list. The payload keeps its ID.
+//	@Tags			Webhooks
+//	@Security		BearerAuth
+//	@ID				RedeliverWebhookDelivery
+//	@Produce		json
+//	@Param			id	path		string	true	"Delivery ID"
+//	@Success		202	{object}	model.WebhookDelivery
+//	@Failure		403	{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404	{object}	helper.APIError	"Delivery not found"
+//	@Router			/api/webhook-deliveries/{id}/redeliver [post]
+func (h *Handler) Redeliver(c *gin.Context) {
+	deliveryID := c.Param("id")
+
+	delivery, err := h.WebhookService.Redeliver(c.Request.Context(), deliveryID)
+	if err != nil {
+		log.Printf("Error redelivering webhook delivery %s: %v", deliveryID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
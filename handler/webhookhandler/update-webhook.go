@@ -0,0 +1,56 @@
+package webhookhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+//	@Summary		Update webhook by ID
+//	@Description	Change the URL, description and event types of a webhook, and pause or resume it with active. The secret stays the same. Pending deliveries of a paused webhook become dead and can be redelivered once it is active again.
+//	@Tags			Webhooks
+//	@Security		BearerAuth
+//	@ID				UpdateWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string					true	"Webhook ID"
+//	@Param			requestWebhook	body		model.RequestWebhook	true	"Webhook data"
+//	@Success		200				{object}	model.Webhook
+//	@Failure		400				{object}	helper.APIError	"Invalid request body"
+//	@Failure		403				{object}	helper.APIError	"Insufficient permissions"
+//	@Failure		404				{object}	helper.APIError	"Webhook not found"
+//	@Router			/api/webhooks/{id} [put]
+func (h *Handler) UpdateWebhook(c *gin.Context) {
+	webhookID := c.Param("id")
+	var requestWebhook model.RequestWebhook
+	var validate = validator.New()
+
+	// Parse request body
+	if err := c.BindJSON(&requestWebhook); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid request body"})
+		return
+	}
+
+	// Validate the request body
+	if err := validate.Struct(requestWebhook); err != nil {
+		var validationErrors []string
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s", err.Field(), err.Tag()))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": validationErrors})
+		return
+	}
+
+	hook, err := h.WebhookService.UpdateWebhook(c.Request.Context(), webhookID, &requestWebhook)
+	if err != nil {
+		log.Printf("Error updating webhook %s: %v", webhookID, err)
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, hook)
+}
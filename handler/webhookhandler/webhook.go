@@ -0,0 +1,29 @@
+package webhookhandler
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/service/webhooksvc"
+	"atommuse/backend/exhibition-service/pkg/webhook"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler is responsible for handling HTTP requests.
+type Handler struct {
+	WebhookService webhooksvc.IWebhookServices
+}
+
+// respondError writes the HTTP response matching a webhook service error.
+func respondError(c *gin.Context, err error) {
+	var invalid *webhook.ValidationError
+	switch {
+	case errors.As(err, &invalid):
+		c.JSON(http.StatusBadRequest, gin.H{"errorMessage": invalid.Problems})
+	case errors.Is(err, cerr.ErrWebhookNotFound), errors.Is(err, cerr.ErrDeliveryNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,122 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// RequestIDHeader carries the ID of a request, given by the client or generated.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestID is the length of the longest request ID accepted from a client.
+const maxRequestID = 64
+
+// ValidRequestID reports whether a client may tag its request with id: at most 64 letters,
+// digits, dots, dashes and underscores, so that it can be logged and stored as it is.
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestID {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Kind is a type of resource and the collection it is stored in.
+type Kind struct {
+	Type       string
+	Collection string
+}
+
+// kinds maps the path segments naming resources to their kinds.
+var kinds = map[string]Kind{
+	"exhibitions":        {Type: "exhibition", Collection: "exhibitions"},
+	"sections":           {Type: "section", Collection: "exhibitionSections"},
+	"rooms":              {Type: "room", Collection: "exhibitionRooms"},
+	"share-links":        {Type: "shareLink", Collection: "exhibitionShareLinks"},
+	"templates":          {Type: "template", Collection: "exhibitionTemplates"},
+	"artworks":           {Type: "artwork", Collection: "artworks"},
+	"timeline-entries":   {Type: "timelineEntry", Collection: "exhibitionTimelineEntries"},
+	"points-of-interest": {Type: "pointOfInterest", Collection: "exhibitionPointsOfInterest"},
+	"tours":              {Type: "tour", Collection: "exhibitionTours"},
+	"quizzes":            {Type: "quiz", Collection: "exhibitionQuizzes"},
+	"attempts":           {Type: "quizAttempt", Collection: "quizAttempts"},
+	"time-slots":         {Type: "timeSlot", Collection: "exhibitionTimeSlots"},
+	"reservations":       {Type: "reservation", Collection: "reservations"},
+	"webhooks":           {Type: "webhook", Collection: "webhooks"},
+	"webhook-deliveries": {Type: "webhookDelivery", Collection: "webhookDeliveries"},
+	"reports":            {Type: "report", Collection: "exhibitionReports"},
+	"rules":              {Type: "screeningRule", Collection: "screeningRules"},
+	"actions":            {Type: "moderationAction", Collection: "moderationActions"},
+	"appeals":            {Type: "appeal", Collection: "moderationAppeals"},
+	"profiles":           {Type: "profile", Collection: "userProfiles"},
+	"profile-changes":    {Type: "profile", Collection: "userProfiles"},
+}
+
+// Target is the resource a route acts on. Param names the path parameter holding its ID, and
+// is empty when the route creates the resource or acts on all of them.
+type Target struct {
+	Kind
+	Param string
+}
+
+// Resolve finds the resource a route acts on from its path template: the last resource named
+// in the path, identified by the parameter following it. A resource named without a parameter
+// is created by the route or is every resource of its kind, as POST
+// /api/exhibitions/:id/time-slots creates a time slot. It returns false when the path names no
+// resource.
+func Resolve(path string) (Target, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		segment := segments[i]
+		if strings.HasPrefix(segment, ":") {
+			if i == 0 {
+				continue
+			}
+			if kind, ok := kinds[segments[i-1]]; ok {
+				return Target{Kind: kind, Param: segment[1:]}, true
+			}
+			continue
+		}
+		if kind, ok := kinds[segment]; ok {
+			return Target{Kind: kind}, true
+		}
+	}
+	return Target{}, false
+}
+
+// Action names what a route does after its handler, as BanExhibition for the handler method
+// exhibihandler.(*Handler).BanExhibition.
+func Action(handlerName string) string {
+	name := handlerName[strings.LastIndex(handlerName, ".")+1:]
+	return strings.TrimSuffix(name, "-fm")
+}
+
+// Hash returns the SHA-256 of a document in hex, or an empty string when there is none.
+func Hash(document bson.Raw) string {
+	if document == nil {
+		return ""
+	}
+	sum := sha256.Sum256(document)
+	return hex.EncodeToString(sum[:])
+}
+
+// CreatedID returns the ID in the _id field of a JSON response, if any.
+func CreatedID(body []byte) string {
+	var response struct {
+		ID string `json:"_id"`
+	}
+	if json.Unmarshal(body, &response) != nil {
+		return ""
+	}
+	return response.ID
+}
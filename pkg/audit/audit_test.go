@@ -0,0 +1,68 @@
+package audit_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/audit"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		path       string
+		targetType string
+		param      string
+		ok         bool
+	}{
+		{path: "/api/exhibitions", targetType: "exhibition", ok: true},
+		{path: "/api/exhibitions/:id", targetType: "exhibition", param: "id", ok: true},
+		{path: "/api/exhibitions/:id/ban", targetType: "exhibition", param: "id", ok: true},
+		{path: "/api/exhibitions/:id/collaborators/:userId", targetType: "exhibition", param: "id", ok: true},
+		{path: "/api/exhibitions/:id/time-slots", targetType: "timeSlot", ok: true},
+		{path: "/api/exhibitions/import", targetType: "exhibition", ok: true},
+		{path: "/api/tours/:id/stops/:index/audio", targetType: "tour", param: "id", ok: true},
+		{path: "/api/moderation/appeals/:id/decision", targetType: "appeal", param: "id", ok: true},
+		{path: "/api/quizzes/:id/attempts", targetType: "quizAttempt", ok: true},
+		{path: "/api/:userId/exhibitions", targetType: "exhibition", ok: true},
+		{path: "/api/unknown/:id", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			target, ok := audit.Resolve(tt.path)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.targetType, target.Type)
+			assert.Equal(t, tt.param, target.Param)
+		})
+	}
+}
+
+func TestAction(t *testing.T) {
+	assert.Equal(t, "BanExhibition", audit.Action("atommuse/backend/exhibition-service/handler/exhibihandler.(*Handler).BanExhibition-fm"))
+	assert.Equal(t, "func1", audit.Action("main.setupRouter.func1"))
+}
+
+func TestHash(t *testing.T) {
+	document, err := bson.Marshal(bson.M{"exhibitionName": "Silk Road"})
+	assert.NoError(t, err)
+	assert.Len(t, audit.Hash(document), 64)
+	assert.Equal(t, audit.Hash(document), audit.Hash(document))
+	assert.Empty(t, audit.Hash(nil))
+}
+
+func TestCreatedID(t *testing.T) {
+	assert.Equal(t, "65a1b2c3d4e5f6a7b8c9d0e1", audit.CreatedID([]byte(`{"_id":"65a1b2c3d4e5f6a7b8c9d0e1"}`)))
+	assert.Empty(t, audit.CreatedID([]byte(`{"_id":"x has been deleted."`)))
+	assert.Empty(t, audit.CreatedID([]byte(`{"message":"ok"}`)))
+}
+
+func TestValidRequestID(t *testing.T) {
+	assert.True(t, audit.ValidRequestID("65a1b2c3d4e5f6a7b8c9d0e1"))
+	assert.True(t, audit.ValidRequestID("trace_01.retry-2"))
+	assert.False(t, audit.ValidRequestID(""))
+	assert.False(t, audit.ValidRequestID(strings.Repeat("a", 65)))
+	assert.False(t, audit.ValidRequestID("id\nforged log line"))
+	assert.False(t, audit.ValidRequestID("<script>"))
+}
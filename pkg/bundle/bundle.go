@@ -0,0 +1,272 @@
+package bundle
+
+import (
+	"archive/zip"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/geo"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/timeline"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Bundle layout and schema version. Bump SchemaVersion when the manifest changes in a way
+// older importers cannot read.
+const (
+	Format        = "atommuse-exhibition-bundle"
+	SchemaVersion = 1
+	ManifestName  = "manifest.json"
+	MediaDir      = "media/"
+
+	maxManifestSize = 16 << 20
+)
+
+// Size limits of bundles, in bytes: the archive uploaded, every file it holds and all of its
+// files unpacked together.
+const (
+	MaxSize      = 512 << 20
+	MaxFileSize  = 200 << 20
+	MaxTotalSize = 1 << 30
+)
+
+// ValidationError lists the problems that make a bundle impossible to import.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", cerr.ErrInvalidBundle, strings.Join(e.Problems, "; "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return cerr.ErrInvalidBundle
+}
+
+// Write writes a bundle archive. files maps bundle paths of the manifest media to local files.
+func Write(w io.Writer, manifest *model.BundleManifest, files map[string]string) error {
+	archive := zip.NewWriter(w)
+
+	entry, err := archive.Create(ManifestName)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return err
+	}
+
+	for _, item := range manifest.Media {
+		if err := writeFile(archive, item.Path, files[item.Path]); err != nil {
+			return fmt.Errorf("error adding %s: %v", item.Ref, err)
+		}
+	}
+
+	return archive.Close()
+}
+
+func writeFile(archive *zip.Writer, name, localPath string) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	entry, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, file)
+	return err
+}
+
+// Read opens a bundle archive and returns its manifest along with the archive entries by path.
+func Read(r io.ReaderAt, size int64) (*model.BundleManifest, map[string]*zip.File, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, nil, &ValidationError{Problems: []string{"bundle is not a zip archive"}}
+	}
+
+	// The reader fails on entries holding more than their declared size, so checking the declared
+	// sizes bounds what unpacking the bundle writes
+	files := map[string]*zip.File{}
+	var total uint64
+	for _, file := range archive.File {
+		if file.UncompressedSize64 > MaxFileSize {
+			return nil, nil, &ValidationError{Problems: []string{fmt.Sprintf("%s is larger than %d bytes", file.Name, MaxFileSize)}}
+		}
+		total += file.UncompressedSize64
+		files[file.Name] = file
+	}
+	if total > MaxTotalSize {
+		return nil, nil, &ValidationError{Problems: []string{fmt.Sprintf("bundle unpacks to more than %d bytes", MaxTotalSize)}}
+	}
+
+	entry, ok := files[ManifestName]
+	if !ok {
+		return nil, nil, &ValidationError{Problems: []string{ManifestName + " is missing"}}
+	}
+
+	reader, err := entry.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close()
+
+	var manifest model.BundleManifest
+	if err := json.NewDecoder(io.LimitReader(reader, maxManifestSize)).Decode(&manifest); err != nil {
+		return nil, nil, &ValidationError{Problems: []string{fmt.Sprintf("%s is not valid JSON: %v", ManifestName, err)}}
+	}
+
+	return &manifest, files, nil
+}
+
+// Validate checks a manifest against the bundle schema and returns the problems found.
+func Validate(manifest *model.BundleManifest) []string {
+	var problems []string
+	addf := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if manifest.Format != Format {
+		addf("unknown bundle format %q", manifest.Format)
+	}
+	if manifest.SchemaVersion < 1 || manifest.SchemaVersion > SchemaVersion {
+		addf("unsupported schema version %d", manifest.SchemaVersion)
+	}
+
+	exhibition := manifest.Exhibition
+	if exhibition.ExhibitionName == "" {
+		addf("exhibition.exhibitionName is required")
+	}
+	if exhibition.LayoutUsed == "" {
+		addf("exhibition.layoutUsed is required")
+	} else if _, ok := layout.Lookup(exhibition.LayoutUsed); !ok {
+		addf("exhibition.layoutUsed %q is not a supported layout", exhibition.LayoutUsed)
+	}
+	for i, entry := range exhibition.Timeline {
+		if err := timeline.Validate(&entry); err != nil {
+			addf("exhibition.timeline[%d]: %v", i, err)
+		}
+	}
+	if err := geo.ValidateVenue(exhibition.Venue); err != nil {
+		addf("exhibition.venue: %v", err)
+	}
+	for i, point := range exhibition.PointsOfInterest {
+		if err := geo.Validate(point.Location); err != nil {
+			addf("exhibition.pointsOfInterest[%d]: %v", i, err)
+		}
+	}
+
+	sectionIDs := map[primitive.ObjectID]bool{}
+	for i, section := range manifest.Sections {
+		if section.ID.IsZero() {
+			addf("sections[%d]._id is required", i)
+		} else if sectionIDs[section.ID] {
+			addf("sections[%d]._id %s is duplicated", i, section.ID.Hex())
+		}
+		sectionIDs[section.ID] = true
+		if section.SectionType == "" {
+			addf("sections[%d].sectionType is required", i)
+		}
+	}
+	referenced := map[string]bool{}
+	for _, id := range exhibition.ExhibitionSectionsID {
+		if objectID, err := primitive.ObjectIDFromHex(id); err != nil || !sectionIDs[objectID] {
+			addf("exhibition.exhibitionSectionsID references unknown section %s", id)
+		} else if referenced[id] {
+			addf("exhibition.exhibitionSectionsID references section %s more than once", id)
+		}
+		referenced[id] = true
+	}
+
+	roomIDs := map[primitive.ObjectID]bool{}
+	for i, room := range manifest.Rooms {
+		if room.ID.IsZero() {
+			addf("rooms[%d]._id is required", i)
+		} else if roomIDs[room.ID] {
+			addf("rooms[%d]._id %s is duplicated", i, room.ID.Hex())
+		}
+		roomIDs[room.ID] = true
+	}
+	referenced = map[string]bool{}
+	for _, id := range exhibition.RoomsID {
+		if objectID, err := primitive.ObjectIDFromHex(id); err != nil || !roomIDs[objectID] {
+			addf("exhibition.roomsID references unknown room %s", id)
+		} else if referenced[id] {
+			addf("exhibition.roomsID references room %s more than once", id)
+		}
+		referenced[id] = true
+	}
+
+	paths := map[string]bool{}
+	for i, item := range manifest.Media {
+		if item.Ref == "" {
+			addf("media[%d].ref is required", i)
+		}
+		if !strings.HasPrefix(item.Path, MediaDir) || path.Clean(item.Path) != item.Path || strings.Contains(item.Path, "..") {
+			addf("media[%d].path %q must be a clean path under %s", i, item.Path, MediaDir)
+		} else if paths[item.Path] {
+			addf("media[%d].path %q is duplicated", i, item.Path)
+		}
+		paths[item.Path] = true
+	}
+
+	return problems
+}
+
+// Tree assembles the exhibition of a manifest with its sections and rooms in the order given by
+// exhibitionSectionsID and roomsID. Sections and rooms the exhibition does not reference are
+// left out and reported as conflicts.
+func Tree(manifest *model.BundleManifest) (*model.ResponseExhibition, []model.ImportConflict) {
+	exhibition := manifest.Exhibition
+	exhibition.ExhibitionSections = nil
+	exhibition.Room = nil
+
+	var conflicts []model.ImportConflict
+
+	sections := map[string]model.ExhibitionSection{}
+	for _, section := range manifest.Sections {
+		sections[section.ID.Hex()] = section
+	}
+	for _, id := range exhibition.ExhibitionSectionsID {
+		exhibition.ExhibitionSections = append(exhibition.ExhibitionSections, sections[id])
+		delete(sections, id)
+	}
+	for _, section := range manifest.Sections {
+		if _, orphan := sections[section.ID.Hex()]; orphan {
+			conflicts = append(conflicts, model.ImportConflict{
+				Type:    model.ConflictOrphanContent,
+				Ref:     section.ID.Hex(),
+				Message: "Section is not referenced by the exhibition and was skipped",
+			})
+		}
+	}
+
+	rooms := map[string]model.Room{}
+	for _, room := range manifest.Rooms {
+		rooms[room.ID.Hex()] = room
+	}
+	for _, id := range exhibition.RoomsID {
+		exhibition.Room = append(exhibition.Room, rooms[id])
+		delete(rooms, id)
+	}
+	for _, room := range manifest.Rooms {
+		if _, orphan := rooms[room.ID.Hex()]; orphan {
+			conflicts = append(conflicts, model.ImportConflict{
+				Type:    model.ConflictOrphanContent,
+				Ref:     room.ID.Hex(),
+				Message: "Room is not referenced by the exhibition and was skipped",
+			})
+		}
+	}
+
+	return &exhibition, conflicts
+}
@@ -0,0 +1,117 @@
+package bundle_test
+
+import (
+	"archive/zip"
+	"atommuse/backend/exhibition-service/pkg/bundle"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func manifest() *model.BundleManifest {
+	first := model.ExhibitionSection{ID: primitive.NewObjectID(), SectionType: "banner", Title: "First"}
+	second := model.ExhibitionSection{ID: primitive.NewObjectID(), SectionType: "text", Title: "Second"}
+	room := model.Room{ID: primitive.NewObjectID(), MapThumbnail: "/rooms/map.png"}
+
+	return &model.BundleManifest{
+		Format:        bundle.Format,
+		SchemaVersion: bundle.SchemaVersion,
+		ExportedAt:    time.Now().UTC(),
+		Exhibition: model.ResponseExhibition{
+			ID:                   primitive.NewObjectID(),
+			ExhibitionName:       "Siam Ceramics",
+			LayoutUsed:           "blogLayout",
+			ExhibitionSectionsID: []string{second.ID.Hex(), first.ID.Hex()},
+			RoomsID:              []string{room.ID.Hex()},
+		},
+		Sections: []model.ExhibitionSection{first, second},
+		Rooms:    []model.Room{room},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	local := filepath.Join(t.TempDir(), "map.png")
+	require.NoError(t, os.WriteFile(local, []byte("png"), 0o644))
+
+	m := manifest()
+	m.Media = []model.BundleMedia{{Ref: "/rooms/map.png", Path: "media/001-map.png", Size: 3}}
+
+	var buf bytes.Buffer
+	require.NoError(t, bundle.Write(&buf, m, map[string]string{"media/001-map.png": local}))
+
+	read, files, err := bundle.Read(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, m.Exhibition.ExhibitionName, read.Exhibition.ExhibitionName)
+	assert.Equal(t, m.Media, read.Media)
+	assert.Empty(t, bundle.Validate(read))
+
+	require.Contains(t, files, "media/001-map.png")
+	reader, err := files["media/001-map.png"].Open()
+	require.NoError(t, err)
+	content, _ := io.ReadAll(reader)
+	reader.Close()
+	assert.Equal(t, "png", string(content))
+}
+
+func TestReadRejectsNonBundle(t *testing.T) {
+	_, _, err := bundle.Read(bytes.NewReader([]byte("not a zip")), 9)
+	assert.ErrorIs(t, err, cerr.ErrInvalidBundle)
+}
+
+func TestValidate(t *testing.T) {
+	m := manifest()
+	m.SchemaVersion = bundle.SchemaVersion + 1
+	m.Exhibition.ExhibitionName = ""
+	m.Exhibition.RoomsID = append(m.Exhibition.RoomsID, primitive.NewObjectID().Hex())
+	m.Media = []model.BundleMedia{{Ref: "/a.png", Path: "media/../../etc/passwd"}}
+
+	problems := bundle.Validate(m)
+	assert.Len(t, problems, 4)
+}
+
+func TestValidateRejectsDuplicateReferences(t *testing.T) {
+	m := manifest()
+	m.Exhibition.ExhibitionSectionsID = append(m.Exhibition.ExhibitionSectionsID, m.Exhibition.ExhibitionSectionsID[0])
+	m.Exhibition.RoomsID = append(m.Exhibition.RoomsID, m.Exhibition.RoomsID[0])
+
+	problems := bundle.Validate(m)
+	require.Len(t, problems, 2)
+	assert.Contains(t, problems[0], "more than once")
+	assert.Contains(t, problems[1], "more than once")
+}
+
+func TestReadRejectsOversizedFiles(t *testing.T) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	_, err := archive.CreateRaw(&zip.FileHeader{Name: "media/001-bomb.bin", Method: zip.Deflate, UncompressedSize64: bundle.MaxFileSize + 1})
+	require.NoError(t, err)
+	require.NoError(t, archive.Close())
+
+	_, _, err = bundle.Read(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.ErrorIs(t, err, cerr.ErrInvalidBundle)
+}
+
+func TestTree(t *testing.T) {
+	m := manifest()
+	orphan := model.Room{ID: primitive.NewObjectID()}
+	m.Rooms = append(m.Rooms, orphan)
+
+	tree, conflicts := bundle.Tree(m)
+
+	require.Len(t, tree.ExhibitionSections, 2)
+	assert.Equal(t, "Second", tree.ExhibitionSections[0].Title)
+	assert.Equal(t, "First", tree.ExhibitionSections[1].Title)
+	assert.Len(t, tree.Room, 1)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, model.ConflictOrphanContent, conflicts[0].Type)
+	assert.Equal(t, orphan.ID.Hex(), conflicts[0].Ref)
+}
@@ -3,5 +3,63 @@ package cerr
 import "errors"
 
 var (
-	ErrExhibitionNotFound = errors.New("Exhibition Not Found")
+	ErrExhibitionNotFound      = errors.New("Exhibition Not Found")
+	ErrForbidden               = errors.New("Insufficient permissions")
+	ErrCollaboratorNotFound    = errors.New("Collaborator Not Found")
+	ErrCollaboratorExists      = errors.New("Collaborator Already Exists")
+	ErrInvalidRole             = errors.New("Invalid Collaborator Role")
+	ErrShareLinkNotFound       = errors.New("Share Link Not Found")
+	ErrShareLinkExpired        = errors.New("Share Link Expired")
+	ErrPasswordRequired        = errors.New("Password Required")
+	ErrPasswordInvalid         = errors.New("Invalid Password")
+	ErrTemplateNotFound        = errors.New("Template Not Found")
+	ErrInvalidBundle           = errors.New("Invalid Bundle")
+	ErrUnsupportedLayout       = errors.New("Unsupported Layout")
+	ErrNoImages                = errors.New("Exhibition Has No Images")
+	ErrArtworkNotFound         = errors.New("Artwork Not Found")
+	ErrArtworkExists           = errors.New("Artwork With This Accession Number Already Exists")
+	ErrArtworkInUse            = errors.New("Artwork Is Used In Exhibitions")
+	ErrArtworkNotAllowed       = errors.New("Artwork Is Not In The Catalogue Of The Exhibition Owner")
+	ErrInvalidTimelineEntry    = errors.New("Invalid Timeline Entry")
+	ErrTimelineEntryNotFound   = errors.New("Timeline Entry Not Found")
+	ErrLayoutMismatch          = errors.New("Exhibition Does Not Use This Layout")
+	ErrInvalidLocation         = errors.New("Invalid Location")
+	ErrPointOfInterestNotFound = errors.New("Point Of Interest Not Found")
+	ErrTourNotFound            = errors.New("Tour Not Found")
+	ErrInvalidTour             = errors.New("Invalid Tour")
+	ErrUnsupportedAudio        = errors.New("Unsupported Audio Format")
+	ErrMediaStorageUnavailable = errors.New("No Local Media Storage Is Configured")
+	ErrQuizNotFound            = errors.New("Quiz Not Found")
+	ErrInvalidQuiz             = errors.New("Invalid Quiz")
+	ErrInvalidQuizAnswers      = errors.New("Invalid Quiz Answers")
+	ErrTimeSlotNotFound        = errors.New("Time Slot Not Found")
+	ErrInvalidTimeSlot         = errors.New("Invalid Time Slot")
+	ErrTimeSlotFull            = errors.New("Time Slot Is Fully Booked")
+	ErrTimeSlotClosed          = errors.New("Time Slot Has Ended")
+	ErrTimeSlotHasReservations = errors.New("Time Slot Has Reservations")
+	ErrCapacityBelowBookings   = errors.New("Capacity Is Below The Booked Seats")
+	ErrReservationNotFound     = errors.New("Reservation Not Found")
+	ErrAlreadyReserved         = errors.New("Time Slot Is Already Reserved")
+	ErrReservationNotActive    = errors.New("Reservation Is Cancelled Or Checked In")
+	ErrInvalidBookingToken     = errors.New("Invalid Booking Token")
+	ErrInvalidEventType        = errors.New("Invalid Event Type")
+	ErrInvalidResumeToken      = errors.New("Invalid Last Event ID")
+	ErrEventHistoryLost        = errors.New("Events After The Last Event ID Are No Longer Available")
+	ErrEventsUnavailable       = errors.New("Event Streams Require A MongoDB Replica Set")
+	ErrWebhookNotFound         = errors.New("Webhook Not Found")
+	ErrInvalidWebhook          = errors.New("Invalid Webhook")
+	ErrDeliveryNotFound        = errors.New("Webhook Delivery Not Found")
+	ErrInvalidSignature        = errors.New("Invalid Webhook Signature")
+	ErrCommentServiceDown      = errors.New("Comment Service Unavailable")
+	ErrAlreadyReported         = errors.New("Exhibition Is Already Reported")
+	ErrModerationNotApplicable = errors.New("Moderation Action Does Not Apply To The Exhibition")
+	ErrModerationNotFound      = errors.New("Moderation Action Not Found")
+	ErrNotAppealable           = errors.New("Moderation Action Cannot Be Appealed")
+	ErrAlreadyAppealed         = errors.New("Moderation Action Is Already Appealed")
+	ErrAppealNotFound          = errors.New("Appeal Not Found")
+	ErrAppealDecided           = errors.New("Appeal Is Already Decided")
+	ErrContentBlocked          = errors.New("Content Is Not Allowed")
+	ErrInvalidScreeningRule    = errors.New("Invalid Screening Rule")
+	ErrScreeningRuleNotFound   = errors.New("Screening Rule Not Found")
+	ErrForeignContent          = errors.New("Section Or Room Belongs To Another Exhibition")
 )
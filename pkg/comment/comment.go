@@ -0,0 +1,122 @@
+package comment
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// Timeout bounds one request to the comment service.
+	Timeout = 5 * time.Second
+	// Attempts is how many times a request is made before the comment service is considered down.
+	Attempts = 3
+)
+
+// First and longest wait between two cleanups of the comments of an exhibition.
+const (
+	baseBackoff = time.Minute
+	maxBackoff  = 6 * time.Hour
+)
+
+// CommentCleaner removes the comments of exhibitions. Errors wrapping
+// cerr.ErrCommentServiceDown mean the cleanup can be tried again later.
+type CommentCleaner interface {
+	DeleteExhibitionComments(ctx context.Context, exhibitionID primitive.ObjectID) error
+}
+
+// HTTPCleaner removes comments through the API of the comment service, which deletes the
+// comments of an exhibition on DELETE /api/comments/exhibitions/{exhibitionID} and answers
+// 404 when it has none. Requests that time out or fail with a server error are retried.
+type HTTPCleaner struct {
+	BaseURL string
+	// Token is sent as the bearer token of the requests when it is set.
+	Token  string
+	Client *http.Client
+	// RetryDelay is the wait before the second request, doubled before every next one.
+	RetryDelay time.Duration
+}
+
+// NewHTTPCleaner creates a cleaner for the comment service at the given URL.
+func NewHTTPCleaner(baseURL, token string) *HTTPCleaner {
+	return &HTTPCleaner{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		Token:      token,
+		Client:     &http.Client{},
+		RetryDelay: 200 * time.Millisecond,
+	}
+}
+
+// DeleteExhibitionComments asks the comment service to delete the comments of an exhibition.
+func (c *HTTPCleaner) DeleteExhibitionComments(ctx context.Context, exhibitionID primitive.ObjectID) error {
+	var err error
+	wait := c.RetryDelay
+	for attempt := 1; attempt <= Attempts; attempt++ {
+		if attempt > 1 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return fmt.Errorf("%w: %v", cerr.ErrCommentServiceDown, ctx.Err())
+			case <-timer.C:
+			}
+			wait *= 2
+		}
+
+		var retry bool
+		retry, err = c.delete(ctx, exhibitionID)
+		if err == nil || !retry {
+			return err
+		}
+	}
+	return fmt.Errorf("%w: %v", cerr.ErrCommentServiceDown, err)
+}
+
+// delete makes one request and reports whether a failed one is worth retrying.
+func (c *HTTPCleaner) delete(ctx context.Context, exhibitionID primitive.ObjectID) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	endpoint := c.BaseURL + "/api/comments/exhibitions/" + exhibitionID.Hex()
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return false, err
+	}
+	if c.Token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	response, err := c.Client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer response.Body.Close()
+	content, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300, response.StatusCode == http.StatusNotFound:
+		return false, nil
+	case response.StatusCode >= 500, response.StatusCode == http.StatusRequestTimeout, response.StatusCode == http.StatusTooManyRequests:
+		return true, fmt.Errorf("comment service answered %d: %s", response.StatusCode, content)
+	}
+	return false, fmt.Errorf("comment service rejected the cleanup with %d: %s", response.StatusCode, content)
+}
+
+// Backoff returns how long to wait before cleaning up the comments of an exhibition again
+// after the given number of failed cleanups: a minute, doubled every time up to 6 hours.
+func Backoff(failures int) time.Duration {
+	wait := baseBackoff
+	for i := 1; i < failures && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+	return wait
+}
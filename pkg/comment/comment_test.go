@@ -0,0 +1,123 @@
+package comment_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/comment"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// commentService stands in for the comment service, answering with the given statuses in turn
+// and the last one after that.
+func commentService(t *testing.T, exhibitionID primitive.ObjectID, statuses ...int) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/comments/exhibitions/"+exhibitionID.Hex(), r.URL.Path)
+		assert.Equal(t, "Bearer service-token", r.Header.Get("Authorization"))
+
+		i := int(atomic.AddInt32(&requests, 1)) - 1
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		w.WriteHeader(statuses[i])
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func cleaner(server *httptest.Server) *comment.HTTPCleaner {
+	cleaner := comment.NewHTTPCleaner(server.URL+"/", "service-token")
+	cleaner.RetryDelay = time.Millisecond
+	return cleaner
+}
+
+func TestHTTPCleaner(t *testing.T) {
+	exhibitionID := primitive.NewObjectID()
+
+	tests := []struct {
+		name     string
+		statuses []int
+		requests int32
+		down     bool
+		fails    bool
+	}{
+		{name: "deleted", statuses: []int{http.StatusNoContent}, requests: 1},
+		{name: "no comments", statuses: []int{http.StatusNotFound}, requests: 1},
+		{name: "recovers", statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK}, requests: 3},
+		{name: "down", statuses: []int{http.StatusInternalServerError}, requests: comment.Attempts, down: true, fails: true},
+		{name: "rejected", statuses: []int{http.StatusForbidden}, requests: 1, fails: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := commentService(t, exhibitionID, tt.statuses...)
+			err := cleaner(server).DeleteExhibitionComments(context.Background(), exhibitionID)
+
+			assert.Equal(t, tt.requests, atomic.LoadInt32(requests))
+			assert.Equal(t, tt.fails, err != nil)
+			assert.Equal(t, tt.down, errors.Is(err, cerr.ErrCommentServiceDown))
+		})
+	}
+}
+
+func TestHTTPCleanerUnreachable(t *testing.T) {
+	server, _ := commentService(t, primitive.NewObjectID(), http.StatusOK)
+	server.Close()
+
+	err := cleaner(server).DeleteExhibitionComments(context.Background(), primitive.NewObjectID())
+	assert.ErrorIs(t, err, cerr.ErrCommentServiceDown)
+}
+
+type queue struct {
+	queued []primitive.ObjectID
+	next   time.Time
+}
+
+func (q *queue) EnqueueCleanup(ctx context.Context, exhibitionID primitive.ObjectID, next time.Time, reason string) error {
+	q.queued = append(q.queued, exhibitionID)
+	q.next = next
+	return nil
+}
+
+func TestFallbackCleaner(t *testing.T) {
+	exhibitionID := primitive.NewObjectID()
+
+	down, _ := commentService(t, exhibitionID, http.StatusServiceUnavailable)
+	pending := &queue{}
+	fallback := &comment.FallbackCleaner{Cleaner: cleaner(down), Queue: pending}
+	require.NoError(t, fallback.DeleteExhibitionComments(context.Background(), exhibitionID))
+	assert.Equal(t, []primitive.ObjectID{exhibitionID}, pending.queued)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), pending.next, 5*time.Second)
+
+	rejected, _ := commentService(t, exhibitionID, http.StatusBadRequest)
+	pending = &queue{}
+	fallback = &comment.FallbackCleaner{Cleaner: cleaner(rejected), Queue: pending}
+	assert.Error(t, fallback.DeleteExhibitionComments(context.Background(), exhibitionID))
+	assert.Empty(t, pending.queued)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, comment.Backoff(1))
+	assert.Equal(t, 4*time.Minute, comment.Backoff(3))
+	assert.Equal(t, 6*time.Hour, comment.Backoff(20))
+}
+
+func TestQueueCleaner(t *testing.T) {
+	exhibitionID := primitive.NewObjectID()
+
+	pending := &queue{}
+	cleaner := &comment.QueueCleaner{Queue: pending}
+	require.NoError(t, cleaner.DeleteExhibitionComments(context.Background(), exhibitionID))
+	assert.Equal(t, []primitive.ObjectID{exhibitionID}, pending.queued)
+	assert.WithinDuration(t, time.Now(), pending.next, 5*time.Second)
+}
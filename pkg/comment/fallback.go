@@ -0,0 +1,46 @@
+package comment
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Queue keeps the cleanups that could not be done to try them again later.
+type Queue interface {
+	EnqueueCleanup(ctx context.Context, exhibitionID primitive.ObjectID, next time.Time, reason string) error
+}
+
+// FallbackCleaner cleans up through another cleaner and queues the cleanup for later when
+// the comment service is down, so deleting an exhibition does not depend on it.
+type FallbackCleaner struct {
+	Cleaner CommentCleaner
+	Queue   Queue
+}
+
+// DeleteExhibitionComments deletes the comments of an exhibition or queues their cleanup. It
+// only fails when the comment service rejected the cleanup or it could not be queued.
+func (c *FallbackCleaner) DeleteExhibitionComments(ctx context.Context, exhibitionID primitive.ObjectID) error {
+	err := c.Cleaner.DeleteExhibitionComments(ctx, exhibitionID)
+	if !errors.Is(err, cerr.ErrCommentServiceDown) {
+		return err
+	}
+	// The request may have been cancelled while waiting for the comment service
+	queueCtx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+	return c.Queue.EnqueueCleanup(queueCtx, exhibitionID, time.Now().Add(Backoff(1)), err.Error())
+}
+
+// QueueCleaner queues every cleanup, for when no comment service is configured yet. The
+// queued cleanups are done once one is.
+type QueueCleaner struct {
+	Queue Queue
+}
+
+// DeleteExhibitionComments queues the cleanup of the comments of an exhibition.
+func (c *QueueCleaner) DeleteExhibitionComments(ctx context.Context, exhibitionID primitive.ObjectID) error {
+	return c.Queue.EnqueueCleanup(ctx, exhibitionID, time.Now(), "No comment service is configured")
+}
@@ -0,0 +1,369 @@
+package event
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Collections whose changes are turned into events.
+const (
+	ExhibitionCollection = "exhibitions"
+	SectionCollection    = "exhibitionSections"
+	RoomCollection       = "exhibitionRooms"
+)
+
+// HeartbeatInterval is how long a stream waits for a change before it reports that it is
+// still alive, along with the position to resume from.
+const HeartbeatInterval = 15 * time.Second
+
+// Types lists every event type, in the order they are documented.
+var Types = []string{
+	model.EventExhibitionCreated,
+	model.EventExhibitionUpdated,
+	model.EventExhibitionPublished,
+	model.EventExhibitionUnpublished,
+	model.EventExhibitionBanned,
+	model.EventExhibitionModerated,
+	model.EventExhibitionDeleted,
+	model.EventLikesChanged,
+	model.EventSectionChanged,
+	model.EventRoomChanged,
+}
+
+// ignoredFields are derived or counted fields whose changes alone are not worth an event.
+var ignoredFields = map[string]bool{
+	"searchIndex":   true,
+	"visitedNumber": true,
+}
+
+// likeFields are the fields changed by liking and unliking an exhibition.
+var likeFields = map[string]bool{
+	"likeCount": true,
+	"likeList":  true,
+	"isLike":    true,
+}
+
+// Change is a document of a MongoDB change stream.
+type Change struct {
+	ID                bson.Raw            `bson:"_id"`
+	OperationType     string              `bson:"operationType"`
+	ClusterTime       primitive.Timestamp `bson:"clusterTime"`
+	Namespace         Namespace           `bson:"ns"`
+	DocumentKey       DocumentKey         `bson:"documentKey"`
+	FullDocument      bson.Raw            `bson:"fullDocument,omitempty"`
+	UpdateDescription UpdateDescription   `bson:"updateDescription,omitempty"`
+}
+
+// Namespace is the collection a change happened in.
+type Namespace struct {
+	Collection string `bson:"coll"`
+}
+
+// DocumentKey identifies the changed document.
+type DocumentKey struct {
+	ID primitive.ObjectID `bson:"_id"`
+}
+
+// UpdateDescription lists the fields an update set and removed.
+type UpdateDescription struct {
+	UpdatedFields bson.M   `bson:"updatedFields"`
+	RemovedFields []string `bson:"removedFields"`
+}
+
+// exhibitionDocument holds the fields of an exhibition events are built from.
+type exhibitionDocument struct {
+	ExhibitionName string                   `bson:"exhibitionName"`
+	UserID         model.UserID             `bson:"userId"`
+	LikeCount      int                      `bson:"likeCount"`
+	Moderation     *model.ModerationOutcome `bson:"moderation"`
+}
+
+// childDocument holds the fields of a section or room events are built from.
+type childDocument struct {
+	ExhibitionID primitive.ObjectID `bson:"exhibitionID"`
+}
+
+// FromChange turns a change into the event it represents. It returns false for changes that
+// are not worth an event, such as visit counts, and for section and room changes whose
+// exhibition cannot be told because the document is gone. The ID of the event is the resume
+// token of the change, which is the same in every stream.
+func FromChange(change *Change) (*model.Event, bool) {
+	event := &model.Event{
+		ID:         EncodeToken(change.ID),
+		OccurredAt: time.Unix(int64(change.ClusterTime.T), 0).UTC(),
+	}
+	return fromChange(change, event)
+}
+
+// FromWrite turns a write to an exhibition, section or room into the event it represents,
+// given the document before and after the write. Before is nil when the write inserted the
+// document and after is nil when it deleted it. Events are built as FromChange builds them,
+// except that deleted exhibitions keep their name and owner. The event has no ID.
+func FromWrite(collection string, id primitive.ObjectID, before, after bson.Raw, occurredAt time.Time) (*model.Event, bool) {
+	change := &Change{
+		Namespace:    Namespace{Collection: collection},
+		DocumentKey:  DocumentKey{ID: id},
+		FullDocument: after,
+	}
+	switch {
+	case before == nil && after == nil:
+		return nil, false
+	case before == nil:
+		change.OperationType = "insert"
+	case after == nil:
+		change.OperationType = "delete"
+		if collection == ExhibitionCollection {
+			change.FullDocument = before
+		}
+	default:
+		change.OperationType = "update"
+		change.UpdateDescription = Diff(before, after)
+	}
+	return fromChange(change, &model.Event{OccurredAt: occurredAt.UTC()})
+}
+
+// Diff describes the update from one version of a document to the next as a change stream
+// does: the top-level fields whose values differ, with their new values, and the removed ones.
+func Diff(before, after bson.Raw) UpdateDescription {
+	description := UpdateDescription{UpdatedFields: bson.M{}}
+
+	elements, _ := after.Elements()
+	for _, element := range elements {
+		value := element.Value()
+		previous, err := before.LookupErr(element.Key())
+		if err == nil && previous.Equal(value) {
+			continue
+		}
+		var decoded interface{}
+		if err := value.Unmarshal(&decoded); err != nil {
+			decoded = value
+		}
+		description.UpdatedFields[element.Key()] = decoded
+	}
+
+	elements, _ = before.Elements()
+	for _, element := range elements {
+		if _, err := after.LookupErr(element.Key()); err != nil {
+			description.RemovedFields = append(description.RemovedFields, element.Key())
+		}
+	}
+	return description
+}
+
+func fromChange(change *Change, event *model.Event) (*model.Event, bool) {
+	switch change.Namespace.Collection {
+	case ExhibitionCollection:
+		return fromExhibitionChange(change, event)
+	case SectionCollection, RoomCollection:
+		return fromChildChange(change, event)
+	}
+	return nil, false
+}
+
+func fromExhibitionChange(change *Change, event *model.Event) (*model.Event, bool) {
+	event.ExhibitionID = change.DocumentKey.ID
+	var document exhibitionDocument
+	if change.FullDocument != nil && bson.Unmarshal(change.FullDocument, &document) == nil {
+		event.ExhibitionName = document.ExhibitionName
+		if owner := document.UserID.UserID; !owner.IsZero() {
+			event.OwnerID = &owner
+		}
+	}
+
+	switch change.OperationType {
+	case "insert":
+		event.Type = model.EventExhibitionCreated
+		return event, true
+	case "replace":
+		event.Type = model.EventExhibitionUpdated
+		return event, true
+	case "delete":
+		event.Type = model.EventExhibitionDeleted
+		return event, true
+	case "update":
+	default:
+		return nil, false
+	}
+
+	event.Fields = changedFields(&change.UpdateDescription)
+	if len(event.Fields) == 0 {
+		return nil, false
+	}
+	updated := change.UpdateDescription.UpdatedFields
+	moderated := hasField(event.Fields, "moderation")
+	if moderated {
+		event.Moderation = document.Moderation
+	}
+
+	switch {
+	case updated["status"] == "banned":
+		event.Type = model.EventExhibitionBanned
+	case updated["status"] == "review":
+		// Held for review, the exhibition is no longer published
+		event.Type = model.EventExhibitionUnpublished
+	case updated["isPublic"] == true:
+		event.Type = model.EventExhibitionPublished
+	case updated["isPublic"] == false:
+		event.Type = model.EventExhibitionUnpublished
+	case moderated:
+		event.Type = model.EventExhibitionModerated
+	case onlyFields(event.Fields, likeFields):
+		event.Type = model.EventLikesChanged
+		// The looked up document may already reflect later likes, the update does not
+		likeCount, ok := toInt(updated["likeCount"])
+		if !ok {
+			likeCount = document.LikeCount
+		}
+		event.LikeCount = &likeCount
+	case onlyFields(event.Fields, map[string]bool{"exhibitionSectionsID": true}):
+		event.Type = model.EventSectionChanged
+	case onlyFields(event.Fields, map[string]bool{"roomsID": true}):
+		event.Type = model.EventRoomChanged
+	default:
+		event.Type = model.EventExhibitionUpdated
+	}
+	return event, true
+}
+
+func fromChildChange(change *Change, event *model.Event) (*model.Event, bool) {
+	// Deleted sections and rooms are reported by the exhibition they are removed from
+	if change.FullDocument == nil {
+		return nil, false
+	}
+	var document childDocument
+	if err := bson.Unmarshal(change.FullDocument, &document); err != nil || document.ExhibitionID.IsZero() {
+		return nil, false
+	}
+
+	switch change.OperationType {
+	case "insert", "replace":
+	case "update":
+		event.Fields = changedFields(&change.UpdateDescription)
+		if len(event.Fields) == 0 {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	id := change.DocumentKey.ID
+	event.ExhibitionID = document.ExhibitionID
+	if change.Namespace.Collection == SectionCollection {
+		event.Type = model.EventSectionChanged
+		event.SectionID = &id
+	} else {
+		event.Type = model.EventRoomChanged
+		event.RoomID = &id
+	}
+	return event, true
+}
+
+// Matches reports whether an event is of one of the given types. No types match every event.
+func Matches(event *model.Event, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, eventType := range types {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTypes parses a comma-separated list of event types.
+func ParseTypes(value string) ([]string, error) {
+	var types []string
+	for _, eventType := range strings.Split(value, ",") {
+		eventType = strings.TrimSpace(eventType)
+		if eventType == "" {
+			continue
+		}
+		if !Matches(&model.Event{Type: eventType}, Types) {
+			return nil, fmt.Errorf("%w: %s", cerr.ErrInvalidEventType, eventType)
+		}
+		types = append(types, eventType)
+	}
+	return types, nil
+}
+
+// EncodeToken turns a change stream resume token into an event ID.
+func EncodeToken(token bson.Raw) string {
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// DecodeToken turns an event ID back into the resume token of its change stream.
+func DecodeToken(id string) (bson.Raw, error) {
+	data, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return nil, cerr.ErrInvalidResumeToken
+	}
+	token := bson.Raw(data)
+	if err := token.Validate(); err != nil {
+		return nil, cerr.ErrInvalidResumeToken
+	}
+	return token, nil
+}
+
+// changedFields returns the sorted top-level fields an update set or removed, leaving out
+// the ignored ones.
+func changedFields(description *UpdateDescription) []string {
+	seen := map[string]bool{}
+	add := func(path string) {
+		field := strings.SplitN(path, ".", 2)[0]
+		if !ignoredFields[field] {
+			seen[field] = true
+		}
+	}
+	for path := range description.UpdatedFields {
+		add(path)
+	}
+	for _, path := range description.RemovedFields {
+		add(path)
+	}
+
+	fields := make([]string, 0, len(seen))
+	for field := range seen {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func hasField(fields []string, field string) bool {
+	for _, changed := range fields {
+		if changed == field {
+			return true
+		}
+	}
+	return false
+}
+
+func onlyFields(fields []string, allowed map[string]bool) bool {
+	for _, field := range fields {
+		if !allowed[field] {
+			return false
+		}
+	}
+	return true
+}
+
+func toInt(value interface{}) (int, bool) {
+	switch number := value.(type) {
+	case int32:
+		return int(number), true
+	case int64:
+		return int(number), true
+	case float64:
+		return int(number), true
+	}
+	return 0, false
+}
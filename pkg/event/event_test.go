@@ -0,0 +1,260 @@
+package event_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	exhibitionID = primitive.NewObjectID()
+	ownerID      = primitive.NewObjectID()
+	sectionID    = primitive.NewObjectID()
+)
+
+func document(t *testing.T, value interface{}) bson.Raw {
+	data, err := bson.Marshal(value)
+	require.NoError(t, err)
+	return data
+}
+
+func exhibitionChange(t *testing.T, operation string, updated bson.M, removed ...string) *event.Change {
+	return &event.Change{
+		ID:            document(t, bson.M{"_data": "8265"}),
+		OperationType: operation,
+		ClusterTime:   primitive.Timestamp{T: 1700000000},
+		Namespace:     event.Namespace{Collection: event.ExhibitionCollection},
+		DocumentKey:   event.DocumentKey{ID: exhibitionID},
+		FullDocument: document(t, bson.M{
+			"_id":            exhibitionID,
+			"exhibitionName": "Silk Road",
+			"userId":         bson.M{"userId": ownerID},
+			"likeCount":      7,
+		}),
+		UpdateDescription: event.UpdateDescription{UpdatedFields: updated, RemovedFields: removed},
+	}
+}
+
+func TestExhibitionEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  *event.Change
+		want    string
+		fields  []string
+		ignored bool
+	}{
+		{name: "created", change: exhibitionChange(t, "insert", nil), want: model.EventExhibitionCreated},
+		{name: "updated", change: exhibitionChange(t, "update", bson.M{"exhibitionName": "Silk Road", "translations.th.exhibitionName": "x", "searchIndex": bson.A{}}), want: model.EventExhibitionUpdated, fields: []string{"exhibitionName", "translations"}},
+		{name: "removed field", change: exhibitionChange(t, "update", nil, "venue"), want: model.EventExhibitionUpdated, fields: []string{"venue"}},
+		{name: "published", change: exhibitionChange(t, "update", bson.M{"isPublic": true, "exhibitionName": "x"}), want: model.EventExhibitionPublished, fields: []string{"exhibitionName", "isPublic"}},
+		{name: "unpublished", change: exhibitionChange(t, "update", bson.M{"isPublic": false}), want: model.EventExhibitionUnpublished, fields: []string{"isPublic"}},
+		{name: "banned", change: exhibitionChange(t, "update", bson.M{"status": "banned", "isPublic": false}), want: model.EventExhibitionBanned, fields: []string{"isPublic", "status"}},
+		{name: "held for review", change: exhibitionChange(t, "update", bson.M{"status": "review"}), want: model.EventExhibitionUnpublished, fields: []string{"status"}},
+		{name: "moderated", change: exhibitionChange(t, "update", bson.M{"status": "created", "moderation": bson.M{"action": "unban"}}), want: model.EventExhibitionModerated, fields: []string{"moderation", "status"}},
+		{name: "sections", change: exhibitionChange(t, "update", bson.M{"exhibitionSectionsID": bson.A{sectionID.Hex()}}), want: model.EventSectionChanged, fields: []string{"exhibitionSectionsID"}},
+		{name: "rooms", change: exhibitionChange(t, "update", nil, "roomsID"), want: model.EventRoomChanged, fields: []string{"roomsID"}},
+		{name: "deleted", change: exhibitionChange(t, "delete", nil), want: model.EventExhibitionDeleted},
+		{name: "visits", change: exhibitionChange(t, "update", bson.M{"visitedNumber": int32(12)}), ignored: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := event.FromChange(tt.change)
+			if tt.ignored {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got.Type)
+			assert.Equal(t, tt.fields, got.Fields)
+			assert.Equal(t, event.EncodeToken(tt.change.ID), got.ID)
+			assert.Equal(t, exhibitionID, got.ExhibitionID)
+			assert.Equal(t, "Silk Road", got.ExhibitionName)
+			assert.Equal(t, ownerID, *got.OwnerID)
+			assert.Equal(t, int64(1700000000), got.OccurredAt.Unix())
+			assert.Nil(t, got.LikeCount)
+		})
+	}
+}
+
+func TestLikeEvent(t *testing.T) {
+	change := exhibitionChange(t, "update", bson.M{"likeCount": int32(8), "likeList.7": ownerID.Hex()})
+
+	got, ok := event.FromChange(change)
+	require.True(t, ok)
+	assert.Equal(t, model.EventLikesChanged, got.Type)
+	// The count set by the change wins over the looked up document
+	assert.Equal(t, 8, *got.LikeCount)
+}
+
+func TestDeletedExhibitionEvent(t *testing.T) {
+	change := exhibitionChange(t, "delete", nil)
+	change.FullDocument = nil
+
+	got, ok := event.FromChange(change)
+	require.True(t, ok)
+	assert.Equal(t, exhibitionID, got.ExhibitionID)
+	assert.Nil(t, got.OwnerID)
+}
+
+func TestSectionEvents(t *testing.T) {
+	change := &event.Change{
+		OperationType:     "update",
+		Namespace:         event.Namespace{Collection: event.SectionCollection},
+		DocumentKey:       event.DocumentKey{ID: sectionID},
+		FullDocument:      document(t, bson.M{"_id": sectionID, "exhibitionID": exhibitionID}),
+		UpdateDescription: event.UpdateDescription{UpdatedFields: bson.M{"leftCol.title": "Caravans"}},
+	}
+
+	got, ok := event.FromChange(change)
+	require.True(t, ok)
+	assert.Equal(t, model.EventSectionChanged, got.Type)
+	assert.Equal(t, exhibitionID, got.ExhibitionID)
+	assert.Equal(t, sectionID, *got.SectionID)
+	assert.Equal(t, []string{"leftCol"}, got.Fields)
+	assert.Nil(t, got.RoomID)
+
+	change.Namespace.Collection = event.RoomCollection
+	change.OperationType = "insert"
+	got, ok = event.FromChange(change)
+	require.True(t, ok)
+	assert.Equal(t, model.EventRoomChanged, got.Type)
+	assert.Equal(t, sectionID, *got.RoomID)
+
+	// Without the document the exhibition of the change is unknown
+	change.OperationType = "delete"
+	change.FullDocument = nil
+	_, ok = event.FromChange(change)
+	assert.False(t, ok)
+}
+
+func TestDiff(t *testing.T) {
+	before := document(t, bson.M{"_id": exhibitionID, "exhibitionName": "Silk Road", "isPublic": false, "venue": bson.M{"name": "Hall"}, "likeCount": 7})
+	after := document(t, bson.M{"_id": exhibitionID, "exhibitionName": "Silk Road", "isPublic": true, "likeCount": int32(8)})
+
+	description := event.Diff(before, after)
+	assert.Equal(t, bson.M{"isPublic": true, "likeCount": int32(8)}, description.UpdatedFields)
+	assert.Equal(t, []string{"venue"}, description.RemovedFields)
+}
+
+func TestWriteEvents(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	exhibition := func(fields bson.M) bson.Raw {
+		values := bson.M{"_id": exhibitionID, "exhibitionName": "Silk Road", "userId": bson.M{"userId": ownerID}, "isPublic": false, "likeCount": 7}
+		for key, value := range fields {
+			values[key] = value
+		}
+		return document(t, values)
+	}
+
+	created, ok := event.FromWrite(event.ExhibitionCollection, exhibitionID, nil, exhibition(nil), now)
+	require.True(t, ok)
+	assert.Equal(t, model.EventExhibitionCreated, created.Type)
+	assert.Equal(t, ownerID, *created.OwnerID)
+	assert.Empty(t, created.ID)
+	assert.Equal(t, now.UTC(), created.OccurredAt)
+
+	published, ok := event.FromWrite(event.ExhibitionCollection, exhibitionID, exhibition(nil), exhibition(bson.M{"isPublic": true}), now)
+	require.True(t, ok)
+	assert.Equal(t, model.EventExhibitionPublished, published.Type)
+	assert.Equal(t, []string{"isPublic"}, published.Fields)
+
+	// Setting a field to the value it already has is not a change
+	_, ok = event.FromWrite(event.ExhibitionCollection, exhibitionID, exhibition(nil), exhibition(bson.M{"isPublic": false}), now)
+	assert.False(t, ok)
+
+	liked, ok := event.FromWrite(event.ExhibitionCollection, exhibitionID, exhibition(nil), exhibition(bson.M{"likeCount": 8, "likeList": bson.A{"u1"}}), now)
+	require.True(t, ok)
+	assert.Equal(t, model.EventLikesChanged, liked.Type)
+	assert.Equal(t, 8, *liked.LikeCount)
+
+	deleted, ok := event.FromWrite(event.ExhibitionCollection, exhibitionID, exhibition(nil), nil, now)
+	require.True(t, ok)
+	assert.Equal(t, model.EventExhibitionDeleted, deleted.Type)
+	assert.Equal(t, "Silk Road", deleted.ExhibitionName)
+
+	section := document(t, bson.M{"_id": sectionID, "exhibitionID": exhibitionID, "title": "Intro"})
+	edited, ok := event.FromWrite(event.SectionCollection, sectionID, section, document(t, bson.M{"_id": sectionID, "exhibitionID": exhibitionID, "title": "Origins"}), now)
+	require.True(t, ok)
+	assert.Equal(t, model.EventSectionChanged, edited.Type)
+	assert.Equal(t, sectionID, *edited.SectionID)
+	assert.Equal(t, []string{"title"}, edited.Fields)
+
+	// The exhibition reports the sections removed from it
+	_, ok = event.FromWrite(event.SectionCollection, sectionID, section, nil, now)
+	assert.False(t, ok)
+}
+
+func TestDecodeChange(t *testing.T) {
+	raw := document(t, bson.M{
+		"_id":           bson.M{"_data": "8265"},
+		"operationType": "update",
+		"clusterTime":   primitive.Timestamp{T: 1700000000, I: 1},
+		"ns":            bson.M{"db": "atommuse", "coll": event.ExhibitionCollection},
+		"documentKey":   bson.M{"_id": exhibitionID},
+		"fullDocument":  nil,
+		"updateDescription": bson.M{
+			"updatedFields": bson.M{"status": "banned"},
+			"removedFields": bson.A{},
+		},
+	})
+
+	var change event.Change
+	require.NoError(t, bson.Unmarshal(raw, &change))
+
+	got, ok := event.FromChange(&change)
+	require.True(t, ok)
+	assert.Equal(t, model.EventExhibitionBanned, got.Type)
+	assert.Nil(t, got.OwnerID)
+}
+
+func TestParseTypes(t *testing.T) {
+	types, err := event.ParseTypes(" exhibition.published, section.changed,")
+	require.NoError(t, err)
+	assert.Equal(t, []string{model.EventExhibitionPublished, model.EventSectionChanged}, types)
+
+	_, err = event.ParseTypes("exhibition.published,exhibition.exploded")
+	assert.ErrorIs(t, err, cerr.ErrInvalidEventType)
+
+	assert.True(t, event.Matches(&model.Event{Type: model.EventRoomChanged}, nil))
+	assert.False(t, event.Matches(&model.Event{Type: model.EventRoomChanged}, types))
+}
+
+func TestTokens(t *testing.T) {
+	token := document(t, bson.M{"_data": "826571A3B2000000012B022C0100296E5A1004"})
+
+	decoded, err := event.DecodeToken(event.EncodeToken(token))
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+
+	for _, id := range []string{"not base64!", "AAAA"} {
+		_, err := event.DecodeToken(id)
+		assert.ErrorIs(t, err, cerr.ErrInvalidResumeToken, id)
+	}
+}
+
+func TestWriteEvent(t *testing.T) {
+	var out strings.Builder
+	require.NoError(t, event.WriteEvent(&out, &model.Event{Type: model.EventRoomChanged, ExhibitionID: exhibitionID}, "abc"))
+	assert.Equal(t, "id: abc\nevent: room.changed\ndata: {\"id\":\"\",\"type\":\"room.changed\",\"exhibitionId\":\""+exhibitionID.Hex()+"\",\"occurredAt\":\"0001-01-01T00:00:00Z\"}\n\n", out.String())
+
+	out.Reset()
+	require.NoError(t, event.WriteEvent(&out, nil, "abc"))
+	assert.Equal(t, "id: abc\n\n", out.String())
+
+	out.Reset()
+	require.NoError(t, event.WriteEvent(&out, nil, ""))
+	assert.Equal(t, ": heartbeat\n\n", out.String())
+
+	out.Reset()
+	require.NoError(t, event.WriteError(&out, cerr.ErrEventHistoryLost))
+	assert.Equal(t, "event: error\ndata: {\"error\":\"Events After The Last Event ID Are No Longer Available\"}\n\n", out.String())
+}
@@ -0,0 +1,52 @@
+package event
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RetryInterval is how long clients wait before reconnecting to a closed stream, in
+// milliseconds.
+const RetryInterval = 3000
+
+// WriteOpen starts a Server-Sent Events stream by telling the client when to reconnect.
+func WriteOpen(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "retry: %d\n\n", RetryInterval)
+	return err
+}
+
+// WriteEvent writes an event as a Server-Sent Event named after its type. A nil event writes
+// a message with only the ID, which moves the position a reconnecting client resumes from
+// without dispatching anything and keeps idle connections alive.
+func WriteEvent(w io.Writer, event *model.Event, token string) error {
+	var message strings.Builder
+	if token != "" {
+		fmt.Fprintf(&message, "id: %s\n", token)
+	}
+	if event != nil {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&message, "event: %s\ndata: %s\n", event.Type, data)
+	} else if token == "" {
+		message.WriteString(": heartbeat\n")
+	}
+	message.WriteString("\n")
+
+	_, err := io.WriteString(w, message.String())
+	return err
+}
+
+// WriteError writes the error that ended a stream as an error event.
+func WriteError(w io.Writer, streamError error) error {
+	data, err := json.Marshal(map[string]string{"error": streamError.Error()})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+	return err
+}
@@ -0,0 +1,51 @@
+package eventbus
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"fmt"
+	"time"
+)
+
+// Kinds of brokers events can be published to.
+const (
+	NATS   = "nats"
+	Kafka  = "kafka"
+	Memory = "memory"
+)
+
+// DefaultTopic is the topic, or subject prefix, events are published under.
+const DefaultTopic = "atommuse.exhibitions"
+
+// Timeout bounds publishing an event, including waiting for the broker to acknowledge it.
+const Timeout = 10 * time.Second
+
+// EventPublisher publishes events to a broker. Publish returns once the broker stored the
+// event, so an event whose publishing failed can be published again. Events of an exhibition
+// published one after the other reach consumers in the same order.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *model.Event) error
+	Close() error
+}
+
+// Open connects to a broker of the given kind at the given address. An empty kind gives an
+// in-memory bus, for running the service without a broker.
+func Open(kind, address, topic string) (EventPublisher, error) {
+	if topic == "" {
+		topic = DefaultTopic
+	}
+	switch kind {
+	case NATS:
+		return NewNATSPublisher(address, topic)
+	case Kafka:
+		return NewKafkaRESTPublisher(address, topic), nil
+	case Memory, "":
+		return NewMemoryBus(), nil
+	}
+	return nil, fmt.Errorf("unknown event bus: %s", kind)
+}
+
+// key is what events are partitioned by so those of an exhibition stay in order.
+func key(event *model.Event) string {
+	return event.ExhibitionID.Hex()
+}
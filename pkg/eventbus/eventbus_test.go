@@ -0,0 +1,92 @@
+package eventbus_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/eventbus"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func sampleEvent() *model.Event {
+	return &model.Event{
+		ID:           primitive.NewObjectID().Hex(),
+		Sequence:     3,
+		Type:         model.EventExhibitionPublished,
+		ExhibitionID: primitive.NewObjectID(),
+		OccurredAt:   time.Unix(1700000000, 0).UTC(),
+	}
+}
+
+func TestMemoryBus(t *testing.T) {
+	bus := eventbus.NewMemoryBus()
+	var received []model.Event
+	unsubscribe := bus.Subscribe(func(event model.Event) { received = append(received, event) })
+
+	event := sampleEvent()
+	require.NoError(t, bus.Publish(context.Background(), event))
+	unsubscribe()
+	require.NoError(t, bus.Publish(context.Background(), sampleEvent()))
+
+	assert.Equal(t, []model.Event{*event}, received)
+	assert.Len(t, bus.Events(), 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, bus.Publish(ctx, event))
+}
+
+func TestKafkaRESTPublisher(t *testing.T) {
+	event := sampleEvent()
+	rejected := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/atommuse.exhibitions", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+
+		var body struct {
+			Records []struct {
+				Key   string      `json:"key"`
+				Value model.Event `json:"value"`
+			} `json:"records"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body.Records, 1)
+		assert.Equal(t, event.ExhibitionID.Hex(), body.Records[0].Key)
+		assert.Equal(t, *event, body.Records[0].Value)
+
+		if rejected {
+			w.Write([]byte(`{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"Kafka error"}]}`))
+			return
+		}
+		w.Write([]byte(`{"offsets":[{"partition":2,"offset":41,"error_code":null,"error":null}]}`))
+	}))
+	defer server.Close()
+
+	publisher, err := eventbus.Open(eventbus.Kafka, server.URL+"/", "")
+	require.NoError(t, err)
+	defer publisher.Close()
+	assert.NoError(t, publisher.Publish(context.Background(), event))
+
+	rejected = true
+	assert.ErrorContains(t, publisher.Publish(context.Background(), event), "Kafka error")
+
+	server.Close()
+	assert.Error(t, publisher.Publish(context.Background(), event))
+}
+
+func TestOpen(t *testing.T) {
+	publisher, err := eventbus.Open("", "", "")
+	require.NoError(t, err)
+	assert.IsType(t, &eventbus.MemoryBus{}, publisher)
+
+	_, err = eventbus.Open("carrier-pigeon", "", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package eventbus
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Media types of the Kafka REST Proxy API v2, which Confluent REST Proxy and Redpanda serve.
+const (
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+	kafkaAccept      = "application/vnd.kafka.v2+json"
+)
+
+// KafkaRESTPublisher publishes events to a Kafka topic through a REST proxy. Events are keyed
+// by exhibition, so the events of an exhibition land in the same partition and stay in order.
+type KafkaRESTPublisher struct {
+	URL    string
+	Topic  string
+	Client *http.Client
+}
+
+// NewKafkaRESTPublisher creates a publisher for the REST proxy at the given URL.
+func NewKafkaRESTPublisher(proxyURL, topic string) *KafkaRESTPublisher {
+	return &KafkaRESTPublisher{
+		URL:    strings.TrimSuffix(proxyURL, "/"),
+		Topic:  topic,
+		Client: &http.Client{Timeout: Timeout},
+	}
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string       `json:"key"`
+	Value *model.Event `json:"value"`
+}
+
+type kafkaOffsets struct {
+	Offsets []struct {
+		Partition int    `json:"partition"`
+		Offset    int64  `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Publish produces an event and returns once the proxy reports the offset it was stored at.
+func (p *KafkaRESTPublisher) Publish(ctx context.Context, event *model.Event) error {
+	body, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: key(event), Value: event}}})
+	if err != nil {
+		return err
+	}
+
+	endpoint := p.URL + "/topics/" + url.PathEscape(p.Topic)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", kafkaContentType)
+	request.Header.Set("Accept", kafkaAccept)
+
+	response, err := p.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(response.Body, 1<<16))
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("kafka rest proxy answered %d: %s", response.StatusCode, content)
+	}
+
+	var offsets kafkaOffsets
+	if err := json.Unmarshal(content, &offsets); err != nil {
+		return fmt.Errorf("invalid kafka rest proxy response: %v", err)
+	}
+	if len(offsets.Offsets) != 1 {
+		return fmt.Errorf("kafka rest proxy returned %d offsets for 1 record", len(offsets.Offsets))
+	}
+	if offset := offsets.Offsets[0]; offset.ErrorCode != nil || offset.Error != "" {
+		return fmt.Errorf("kafka rest proxy rejected the event: %s", offset.Error)
+	}
+	return nil
+}
+
+func (p *KafkaRESTPublisher) Close() error {
+	p.Client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,64 @@
+package eventbus
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"sync"
+)
+
+// MemoryBus is an event bus within the process. It keeps every event it was given and hands
+// them to its subscribers as they are published. It is meant for tests and for running the
+// service without a broker.
+type MemoryBus struct {
+	mu          sync.Mutex
+	events      []model.Event
+	subscribers map[int]func(model.Event)
+	next        int
+}
+
+// NewMemoryBus creates an empty MemoryBus.
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{subscribers: map[int]func(model.Event){}}
+}
+
+// Publish keeps the event and calls the subscribers with it before returning.
+func (b *MemoryBus) Publish(ctx context.Context, event *model.Event) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.events = append(b.events, *event)
+	for _, handle := range b.subscribers {
+		handle(*event)
+	}
+	return nil
+}
+
+// Subscribe calls handle with every event published from now on, until the returned
+// function is called. Handlers must not publish to the bus.
+func (b *MemoryBus) Subscribe(handle func(model.Event)) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	id := b.next
+	b.next++
+	b.subscribers[id] = handle
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, id)
+	}
+}
+
+// Events returns the events published so far, in order.
+func (b *MemoryBus) Events() []model.Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]model.Event(nil), b.events...)
+}
+
+func (b *MemoryBus) Close() error {
+	return nil
+}
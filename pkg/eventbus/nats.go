@@ -0,0 +1,57 @@
+package eventbus
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSPublisher publishes events to NATS JetStream under <topic>.<event type>, such as
+// atommuse.exhibitions.exhibition.published. A stream must capture the subjects, and keeps
+// the events of an exhibition in the order they are published. The ID of the event is the
+// message ID, so JetStream drops events published again within its duplicate window.
+type NATSPublisher struct {
+	conn    *nats.Conn
+	stream  nats.JetStreamContext
+	subject string
+}
+
+// NewNATSPublisher connects to the NATS servers at the given URLs, separated by commas. It
+// keeps trying in the background when they cannot be reached yet.
+func NewNATSPublisher(url, subject string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url, nats.Name("exhibition-service"), nats.MaxReconnects(-1), nats.RetryOnFailedConnect(true))
+	if err != nil {
+		return nil, err
+	}
+	stream, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &NATSPublisher{conn: conn, stream: stream, subject: subject}, nil
+}
+
+// Publish publishes an event and waits for JetStream to acknowledge it.
+func (p *NATSPublisher) Publish(ctx context.Context, event *model.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(p.subject + "." + event.Type)
+	msg.Data = data
+	msg.Header.Set(nats.MsgIdHdr, event.ID)
+	msg.Header.Set("AtomMuse-Exhibition", key(event))
+
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+	_, err = p.stream.PublishMsg(msg, nats.Context(ctx))
+	return err
+}
+
+// Close flushes pending messages and closes the connection.
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
@@ -0,0 +1,130 @@
+package feed
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// dateLayouts are the formats exhibition dates are stored in.
+var dateLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"}
+
+// Calendar writes an iCalendar feed with an event for each entry that has a valid start date.
+// Entries without an end date last for the start day.
+func Calendar(w io.Writer, channel Channel, entries []Entry, now time.Time) error {
+	out := &icsWriter{w: bufio.NewWriter(w)}
+
+	out.line("BEGIN:VCALENDAR")
+	out.line("VERSION:2.0")
+	out.line("PRODID:-//AtomMuse//Exhibitions//EN")
+	out.line("CALSCALE:GREGORIAN")
+	out.line("METHOD:PUBLISH")
+	out.property("X-WR-CALNAME", channel.Title)
+	out.property("X-WR-CALDESC", channel.Description)
+
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, entry := range entries {
+		start, startAllDay, ok := parseDate(entry.StartDate)
+		if !ok {
+			continue
+		}
+		end, endAllDay, ok := parseDate(entry.EndDate)
+		if !ok || end.Before(start) {
+			end, endAllDay = start, startAllDay
+		}
+
+		out.line("BEGIN:VEVENT")
+		out.line("UID:" + entry.ID + "@atommuse")
+		out.line("DTSTAMP:" + stamp)
+		out.date("DTSTART", start, startAllDay)
+		// The end of an all-day event is exclusive
+		if endAllDay {
+			end = end.AddDate(0, 0, 1)
+		}
+		out.date("DTEND", end, endAllDay)
+		out.property("SUMMARY", entry.Title)
+		out.property("DESCRIPTION", entry.Summary)
+		out.property("URL", entry.URL)
+		out.property("LOCATION", entry.Location)
+		if len(entry.Geo) == 2 {
+			out.line(fmt.Sprintf("GEO:%.6f;%.6f", entry.Geo[0], entry.Geo[1]))
+		}
+		if len(entry.Categories) > 0 {
+			escaped := make([]string, len(entry.Categories))
+			for i, category := range entry.Categories {
+				escaped[i] = escapeText(category)
+			}
+			out.line("CATEGORIES:" + strings.Join(escaped, ","))
+		}
+		out.line("END:VEVENT")
+	}
+
+	out.line("END:VCALENDAR")
+	return out.flush()
+}
+
+func parseDate(value string) (time.Time, bool, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), layout == "2006-01-02", true
+		}
+	}
+	return time.Time{}, false, false
+}
+
+// icsWriter writes content lines, folding them at 75 octets as RFC 5545 requires.
+type icsWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (out *icsWriter) property(name, value string) {
+	if value != "" {
+		out.line(name + ":" + escapeText(value))
+	}
+}
+
+func (out *icsWriter) date(name string, t time.Time, allDay bool) {
+	if allDay {
+		out.line(name + ";VALUE=DATE:" + t.Format("20060102"))
+		return
+	}
+	out.line(name + ":" + t.Format("20060102T150405Z"))
+}
+
+func (out *icsWriter) line(content string) {
+	if out.err != nil {
+		return
+	}
+
+	limit := 75
+	for len(content) > limit {
+		// Never split a UTF-8 sequence
+		cut := limit
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		_, out.err = out.w.WriteString(content[:cut] + "\r\n ")
+		content = content[cut:]
+		// Continuation lines start with a space that counts towards the limit
+		limit = 74
+	}
+	if out.err == nil {
+		_, out.err = out.w.WriteString(content + "\r\n")
+	}
+}
+
+func (out *icsWriter) flush() error {
+	if out.err != nil {
+		return out.err
+	}
+	return out.w.Flush()
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
@@ -0,0 +1,261 @@
+package feed
+
+import (
+	"atommuse/backend/exhibition-service/pkg/geo"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+)
+
+// SitemapPageSize is the largest number of URLs a sitemap may list.
+const SitemapPageSize = 50000
+
+// Channel describes a feed as a whole.
+type Channel struct {
+	Title       string
+	Description string
+	// Link is the website the feed belongs to, Self the URL the feed is served from.
+	Link string
+	Self string
+}
+
+// Entry is an exhibition as it appears in a feed.
+type Entry struct {
+	ID         string
+	Title      string
+	Summary    string
+	URL        string
+	Author     string
+	Categories []string
+	Published  time.Time
+	StartDate  string
+	EndDate    string
+	// Location is the venue of a physical exhibition, Geo its latitude and longitude.
+	Location string
+	Geo      []float64
+}
+
+// FromExhibition creates the entry of an exhibition whose page lives at pageURL.
+// Exhibitions have no publication date, so the creation time of their ID is used.
+func FromExhibition(exhibition *model.ResponseExhibition, pageURL string) Entry {
+	author := strings.TrimSpace(exhibition.UserID.FirstName + " " + exhibition.UserID.LastName)
+	if author == "" {
+		author = exhibition.UserID.Username
+	}
+
+	entry := Entry{
+		ID:         exhibition.ID.Hex(),
+		Title:      exhibition.ExhibitionName,
+		Summary:    exhibition.ExhibitionDescription,
+		URL:        pageURL,
+		Author:     author,
+		Categories: exhibition.ExhibitionCategories,
+		Published:  exhibition.ID.Timestamp().UTC(),
+		StartDate:  exhibition.StartDate,
+		EndDate:    exhibition.EndDate,
+	}
+
+	if venue := exhibition.Venue; venue != nil {
+		var parts []string
+		for _, part := range []string{venue.Name, venue.Address} {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		entry.Location = strings.Join(parts, ", ")
+		if geo.Validate(venue.Location) == nil {
+			entry.Geo = []float64{geo.Latitude(venue.Location), geo.Longitude(venue.Location)}
+		}
+	}
+
+	return entry
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Self          atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description,omitempty"`
+	Author      string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS writes an RSS 2.0 feed of the entries.
+func RSS(w io.Writer, channel Channel, entries []Entry) error {
+	doc := rss{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		DC:      "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       channel.Title,
+			Link:        channel.Link,
+			Description: channel.Description,
+			Self:        atomLink{Href: channel.Self, Rel: "self", Type: "application/rss+xml"},
+		},
+	}
+	if len(entries) > 0 {
+		doc.Channel.LastBuildDate = latest(entries).Format(time.RFC1123Z)
+	}
+
+	for _, entry := range entries {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       entry.Title,
+			Link:        entry.URL,
+			Description: entry.Summary,
+			Author:      entry.Author,
+			Categories:  entry.Categories,
+			GUID:        rssGUID{IsPermaLink: true, Value: entry.URL},
+			PubDate:     entry.Published.Format(time.RFC1123Z),
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary,omitempty"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// Atom writes an Atom 1.0 feed of the entries.
+func Atom(w io.Writer, channel Channel, entries []Entry) error {
+	updated := time.Unix(0, 0).UTC()
+	if len(entries) > 0 {
+		updated = latest(entries)
+	}
+
+	doc := atomFeed{
+		ID:      channel.Self,
+		Title:   channel.Title,
+		Updated: updated.Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: channel.Self, Rel: "self", Type: "application/atom+xml"},
+			{Href: channel.Link, Rel: "alternate", Type: "text/html"},
+		},
+	}
+
+	for _, entry := range entries {
+		item := atomEntry{
+			ID:        entry.URL,
+			Title:     entry.Title,
+			Link:      atomLink{Href: entry.URL, Rel: "alternate"},
+			Published: entry.Published.Format(time.RFC3339),
+			Updated:   entry.Published.Format(time.RFC3339),
+			Summary:   entry.Summary,
+		}
+		if entry.Author != "" {
+			item.Author = &atomAuthor{Name: entry.Author}
+		}
+		for _, category := range entry.Categories {
+			item.Categories = append(item.Categories, atomCategory{Term: category})
+		}
+		doc.Entries = append(doc.Entries, item)
+	}
+
+	return writeXML(w, doc)
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []sitemapLoc `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+	Sitemaps []sitemapLoc `xml:"sitemap"`
+}
+
+type sitemapLoc struct {
+	Loc string `xml:"loc"`
+}
+
+// Sitemap writes a sitemap listing the URLs.
+func Sitemap(w io.Writer, urls []string) error {
+	doc := urlSet{}
+	for _, url := range urls {
+		doc.URLs = append(doc.URLs, sitemapLoc{Loc: url})
+	}
+	return writeXML(w, doc)
+}
+
+// SitemapIndex writes a sitemap index listing the URLs of sitemaps.
+func SitemapIndex(w io.Writer, sitemaps []string) error {
+	doc := sitemapIndex{}
+	for _, url := range sitemaps {
+		doc.Sitemaps = append(doc.Sitemaps, sitemapLoc{Loc: url})
+	}
+	return writeXML(w, doc)
+}
+
+func writeXML(w io.Writer, doc interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(doc)
+}
+
+func latest(entries []Entry) time.Time {
+	newest := entries[0].Published
+	for _, entry := range entries[1:] {
+		if entry.Published.After(newest) {
+			newest = entry.Published
+		}
+	}
+	return newest
+}
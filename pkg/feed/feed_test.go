@@ -0,0 +1,136 @@
+package feed_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/feed"
+	"atommuse/backend/exhibition-service/pkg/geo"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var channel = feed.Channel{
+	Title:       "AtomMuse exhibitions",
+	Description: "Newly published exhibitions",
+	Link:        "https://atommuse.example",
+	Self:        "https://api.atommuse.example/api/feeds/exhibitions.rss",
+}
+
+func entries() []feed.Entry {
+	exhibition := &model.ResponseExhibition{
+		ID:                    primitive.NewObjectIDFromTimestamp(time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)),
+		ExhibitionName:        "Siam Ceramics & Glaze",
+		ExhibitionDescription: "Stoneware; celadon, and more",
+		ExhibitionCategories:  []string{"Art"},
+		UserID:                model.UserID{FirstName: "Nok"},
+		StartDate:             "2026-11-01T03:00:00.000Z",
+		EndDate:               "2026-12-31T10:00:00.000Z",
+	}
+	return []feed.Entry{feed.FromExhibition(exhibition, "https://atommuse.example/exhibitions/"+exhibition.ID.Hex())}
+}
+
+func TestFromExhibition(t *testing.T) {
+	entry := entries()[0]
+	assert.Equal(t, "Nok", entry.Author)
+	assert.Equal(t, time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC), entry.Published)
+}
+
+func TestRSS(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, feed.RSS(&buf, channel, entries()))
+
+	var doc struct {
+		Version string `xml:"version,attr"`
+		Items   []struct {
+			Title   string `xml:"title"`
+			PubDate string `xml:"pubDate"`
+		} `xml:"channel>item"`
+	}
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "2.0", doc.Version)
+	require.Len(t, doc.Items, 1)
+	assert.Equal(t, "Siam Ceramics & Glaze", doc.Items[0].Title)
+	assert.Equal(t, "Thu, 01 Oct 2026 08:00:00 +0000", doc.Items[0].PubDate)
+}
+
+func TestAtom(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, feed.Atom(&buf, channel, entries()))
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			ID     string `xml:"id"`
+			Author string `xml:"author>name"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "2026-10-01T08:00:00Z", doc.Updated)
+	require.Len(t, doc.Entries, 1)
+	assert.Equal(t, "Nok", doc.Entries[0].Author)
+}
+
+func TestSitemap(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, feed.Sitemap(&buf, []string{"https://atommuse.example/exhibitions/1"}))
+	assert.Contains(t, buf.String(), `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+	assert.Contains(t, buf.String(), "<loc>https://atommuse.example/exhibitions/1</loc>")
+}
+
+func TestCalendar(t *testing.T) {
+	list := entries()
+	list[0].Summary = strings.Repeat("long text ", 20)
+	allDay := feed.Entry{ID: "b", Title: "One day", StartDate: "2026-11-05"}
+	invalid := feed.Entry{ID: "c", Title: "No date"}
+
+	var buf bytes.Buffer
+	require.NoError(t, feed.Calendar(&buf, channel, append(list, allDay, invalid), time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)))
+	ics := buf.String()
+
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	assert.Equal(t, 2, strings.Count(ics, "BEGIN:VEVENT"))
+	assert.Contains(t, ics, "SUMMARY:Siam Ceramics & Glaze\r\n")
+	assert.Contains(t, ics, "DTSTART:20261101T030000Z\r\n")
+	assert.Contains(t, ics, "DTEND:20261231T100000Z\r\n")
+	assert.Contains(t, ics, "DTSTAMP:20261019T000000Z\r\n")
+	assert.Contains(t, ics, "DTSTART;VALUE=DATE:20261105\r\n")
+	assert.Contains(t, ics, "DTEND;VALUE=DATE:20261106\r\n")
+	assert.Contains(t, ics, "CATEGORIES:Art\r\n")
+
+	// Lines are folded at 75 octets
+	for _, line := range strings.Split(ics, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+}
+
+func TestCalendarEscapesText(t *testing.T) {
+	var buf bytes.Buffer
+	list := []feed.Entry{{ID: "a", Title: "A; B, C\\D", StartDate: "2026-11-05"}}
+	require.NoError(t, feed.Calendar(&buf, channel, list, time.Now()))
+	assert.Contains(t, buf.String(), `SUMMARY:A\; B\, C\\D`)
+}
+
+func TestCalendarVenue(t *testing.T) {
+	exhibition := &model.ResponseExhibition{
+		ID:        primitive.NewObjectID(),
+		StartDate: "2026-11-05",
+		Venue: &model.Venue{
+			Name:     "River City",
+			Address:  "23 Trok Rongnamkaeng, Bangkok",
+			Location: geo.NewPoint(13.7286, 100.5136),
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, feed.Calendar(&buf, channel, []feed.Entry{feed.FromExhibition(exhibition, "https://atommuse.example/exhibitions/x")}, time.Now()))
+	assert.Contains(t, buf.String(), "LOCATION:River City\\, 23 Trok Rongnamkaeng\\, Bangkok\r\n")
+	assert.Contains(t, buf.String(), "GEO:13.728600;100.513600\r\n")
+}
@@ -0,0 +1,87 @@
+package geo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	// DefaultRadius is the search radius in meters when a nearby query gives none.
+	DefaultRadius = 10000
+	// MaxRadius is the largest search radius in meters.
+	MaxRadius = 200000
+)
+
+// NewPoint creates the GeoJSON point of a latitude and longitude.
+func NewPoint(latitude, longitude float64) model.GeoPoint {
+	return model.GeoPoint{Type: model.GeoPointType, Coordinates: []float64{longitude, latitude}}
+}
+
+// Latitude returns the latitude of a valid point.
+func Latitude(point model.GeoPoint) float64 {
+	return point.Coordinates[1]
+}
+
+// Longitude returns the longitude of a valid point.
+func Longitude(point model.GeoPoint) float64 {
+	return point.Coordinates[0]
+}
+
+// Validate checks that a point is a GeoJSON point with a longitude and latitude in range.
+// The error wraps cerr.ErrInvalidLocation.
+func Validate(point model.GeoPoint) error {
+	if point.Type != model.GeoPointType {
+		return fmt.Errorf("%w: type must be %q", cerr.ErrInvalidLocation, model.GeoPointType)
+	}
+	if len(point.Coordinates) != 2 {
+		return fmt.Errorf("%w: coordinates must be [longitude, latitude]", cerr.ErrInvalidLocation)
+	}
+	return checkRange(Latitude(point), Longitude(point))
+}
+
+// ValidateVenue checks the location of a venue. Exhibitions without a venue are valid.
+func ValidateVenue(venue *model.Venue) error {
+	if venue == nil {
+		return nil
+	}
+	return Validate(venue.Location)
+}
+
+// ParseNearby parses the lat, lng and radius query parameters of a nearby search. The radius
+// is in meters and defaults to DefaultRadius.
+func ParseNearby(lat, lng, radius string) (model.NearbyQuery, error) {
+	latitude, err := strconv.ParseFloat(lat, 64)
+	if err != nil {
+		return model.NearbyQuery{}, fmt.Errorf("%w: lat must be a number", cerr.ErrInvalidLocation)
+	}
+	longitude, err := strconv.ParseFloat(lng, 64)
+	if err != nil {
+		return model.NearbyQuery{}, fmt.Errorf("%w: lng must be a number", cerr.ErrInvalidLocation)
+	}
+	if err := checkRange(latitude, longitude); err != nil {
+		return model.NearbyQuery{}, err
+	}
+
+	query := model.NearbyQuery{Latitude: latitude, Longitude: longitude, Radius: DefaultRadius}
+	if radius != "" {
+		query.Radius, err = strconv.ParseFloat(radius, 64)
+		if err != nil || query.Radius <= 0 || query.Radius > MaxRadius {
+			return model.NearbyQuery{}, fmt.Errorf("%w: radius must be between 0 and %d meters", cerr.ErrInvalidLocation, MaxRadius)
+		}
+	}
+
+	return query, nil
+}
+
+func checkRange(latitude, longitude float64) error {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", cerr.ErrInvalidLocation)
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", cerr.ErrInvalidLocation)
+	}
+	return nil
+}
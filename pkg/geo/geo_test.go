@@ -0,0 +1,51 @@
+package geo_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/geo"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	point := geo.NewPoint(13.75, 100.493)
+	assert.Equal(t, []float64{100.493, 13.75}, point.Coordinates)
+	assert.NoError(t, geo.Validate(point))
+
+	invalid := []model.GeoPoint{
+		{Coordinates: []float64{100.493, 13.75}},
+		{Type: model.GeoPointType, Coordinates: []float64{100.493}},
+		geo.NewPoint(95, 100),
+		geo.NewPoint(13.75, 181),
+	}
+	for _, point := range invalid {
+		assert.ErrorIs(t, geo.Validate(point), cerr.ErrInvalidLocation, point)
+	}
+
+	assert.NoError(t, geo.ValidateVenue(nil))
+	assert.Error(t, geo.ValidateVenue(&model.Venue{Name: "Nowhere"}))
+}
+
+func TestParseNearby(t *testing.T) {
+	query, err := geo.ParseNearby("13.75", "100.493", "")
+	require.NoError(t, err)
+	assert.Equal(t, model.NearbyQuery{Latitude: 13.75, Longitude: 100.493, Radius: geo.DefaultRadius}, query)
+
+	query, err = geo.ParseNearby("13.75", "100.493", "2500")
+	require.NoError(t, err)
+	assert.Equal(t, 2500.0, query.Radius)
+
+	for _, args := range [][3]string{
+		{"", "100.493", ""},
+		{"13.75", "east", ""},
+		{"-91", "100.493", ""},
+		{"13.75", "100.493", "0"},
+		{"13.75", "100.493", "1e9"},
+	} {
+		_, err := geo.ParseNearby(args[0], args[1], args[2])
+		assert.ErrorIs(t, err, cerr.ErrInvalidLocation, args)
+	}
+}
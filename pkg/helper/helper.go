@@ -1,5 +1,138 @@
 package helper
 
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/text/language"
+)
+
 type APIError struct {
 	ErrorMessage string
 }
+
+// GetActor builds the authenticated user from the values set by the auth middleware.
+func GetActor(c *gin.Context) (model.Actor, bool) {
+	var actor model.Actor
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		return actor, false
+	}
+	objectID, ok := userID.(primitive.ObjectID)
+	if !ok {
+		return actor, false
+	}
+
+	actor.UserID.UserID = objectID
+	actor.UserID.FirstName = c.GetString("user_first_name")
+	actor.UserID.LastName = c.GetString("user_last_name")
+	actor.UserID.ProfileImage = c.GetString("user_image")
+	actor.UserID.Username = c.GetString("user_username")
+	actor.Role = c.GetString("user_role")
+
+	return actor, true
+}
+
+// Languages returns the languages the client reads, from the lang query parameter followed by
+// the Accept-Language header. Responses vary on the header.
+func Languages(c *gin.Context) []language.Tag {
+	c.Header("Vary", "Accept-Language")
+	return i18n.Preferences(c.Query("lang"), c.GetHeader("Accept-Language"))
+}
+
+// GetViewer builds the viewer of an exhibition from the authenticated user, if any, and the
+// share link presented in the X-Share-Token header or the share query parameter.
+func GetViewer(c *gin.Context) model.ExhibitionViewer {
+	viewer := model.ExhibitionViewer{
+		ShareToken:    c.GetHeader("X-Share-Token"),
+		SharePassword: c.GetHeader("X-Share-Password"),
+	}
+	if viewer.ShareToken == "" {
+		viewer.ShareToken = c.Query("share")
+	}
+	if actor, ok := GetActor(c); ok {
+		viewer.Actor = &actor
+	}
+	return viewer
+}
+
+// BaseURL returns the public URL of the service, configured with PUBLIC_BASE_URL or derived
+// from the request.
+func BaseURL(c *gin.Context) string {
+	if base := os.Getenv("PUBLIC_BASE_URL"); base != "" {
+		return strings.TrimSuffix(base, "/")
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host
+}
+
+// MediaURL returns the URL relative media references are served under, configured with
+// MEDIA_BASE_URL and defaulting to the service URL.
+func MediaURL(c *gin.Context) string {
+	if base := os.Getenv("MEDIA_BASE_URL"); base != "" {
+		return strings.TrimSuffix(base, "/")
+	}
+	return BaseURL(c)
+}
+
+// SiteURL returns the URL of the public website, configured with SITE_BASE_URL and
+// defaulting to the service URL.
+func SiteURL(c *gin.Context) string {
+	if base := os.Getenv("SITE_BASE_URL"); base != "" {
+		return strings.TrimSuffix(base, "/")
+	}
+	return BaseURL(c)
+}
+
+// RespondAccessError writes the HTTP response matching an authorization error.
+func RespondAccessError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, cerr.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, cerr.ErrExhibitionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	}
+}
+
+// AuthorizeExhibition checks that the current user has at least the given role on the
+// exhibition and returns the access to it. It writes the error response and returns false when
+// the request must stop.
+func AuthorizeExhibition(c *gin.Context, collaborators collabsvc.ICollaboratorServices, exhibitionID string, role string) (*model.ExhibitionAccess, bool) {
+	actor, _ := GetActor(c)
+	access, err := collaborators.Authorize(c.Request.Context(), exhibitionID, actor, role)
+	if err != nil {
+		RespondAccessError(c, err)
+		return nil, false
+	}
+	return access, true
+}
+
+// RespondBlockedContent writes the response rejecting content blocked by the screening, with
+// the rules it matched. It returns false when the error is not about blocked content.
+func RespondBlockedContent(c *gin.Context, err error) bool {
+	var blocked *screening.BlockedError
+	if !errors.As(err, &blocked) {
+		return false
+	}
+	c.JSON(http.StatusUnprocessableEntity, gin.H{"error": blocked.Error(), "matches": blocked.Matches})
+	return true
+}
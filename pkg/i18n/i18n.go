@@ -0,0 +1,304 @@
+package i18n
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Preferences parses the languages a client asks for. The lang query parameter, a comma
+// separated list, comes before the languages of the Accept-Language header.
+func Preferences(lang, acceptLanguage string) []language.Tag {
+	var tags []language.Tag
+	for _, value := range strings.Split(lang, ",") {
+		if tag, err := language.Parse(strings.TrimSpace(value)); err == nil {
+			tags = append(tags, tag)
+		}
+	}
+	if accepted, _, err := language.ParseAcceptLanguage(acceptLanguage); err == nil {
+		tags = append(tags, accepted...)
+	}
+	return tags
+}
+
+// DefaultLocale returns the locale the untranslated texts of an exhibition are written in.
+func DefaultLocale(exhibition *model.ResponseExhibition) string {
+	if exhibition.DefaultLocale != "" {
+		return exhibition.DefaultLocale
+	}
+	return model.DefaultLocale
+}
+
+// Locales returns the locales an exhibition has texts in, the default locale first and the
+// translated locales sorted after it.
+func Locales(exhibition *model.ResponseExhibition) []string {
+	defaultLocale := DefaultLocale(exhibition)
+	seen := map[string]bool{defaultLocale: true}
+	var translated []string
+	add := func(locale string) {
+		if !seen[locale] {
+			seen[locale] = true
+			translated = append(translated, locale)
+		}
+	}
+
+	for locale := range exhibition.Translations {
+		add(locale)
+	}
+	for _, section := range exhibition.ExhibitionSections {
+		for locale := range section.Translations {
+			add(locale)
+		}
+	}
+	for _, entry := range exhibition.Timeline {
+		for locale := range entry.Translations {
+			add(locale)
+		}
+	}
+	for _, point := range exhibition.PointsOfInterest {
+		for locale := range point.Translations {
+			add(locale)
+		}
+	}
+	walkDetails(exhibition, func(details *model.Details) {
+		for locale := range details.Translations {
+			add(locale)
+		}
+	})
+
+	sort.Strings(translated)
+	return append([]string{defaultLocale}, translated...)
+}
+
+// Negotiate picks the locale of an exhibition that best matches the preferences. The default
+// locale is used when nothing matches.
+func Negotiate(preferences []language.Tag, locales []string) string {
+	if len(preferences) == 0 || len(locales) == 1 {
+		return locales[0]
+	}
+
+	tags := make([]language.Tag, len(locales))
+	for i, locale := range locales {
+		tags[i] = language.Make(locale)
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(preferences...)
+	if confidence == language.No {
+		return locales[0]
+	}
+	return locales[index]
+}
+
+// Localize replaces the texts of an exhibition with their translation into the negotiated
+// locale. Texts that are not translated keep their default locale value.
+func Localize(exhibition *model.ResponseExhibition, preferences []language.Tag) {
+	locale := Negotiate(preferences, Locales(exhibition))
+	exhibition.Locale = locale
+	if locale == DefaultLocale(exhibition) {
+		return
+	}
+
+	if translation, ok := exhibition.Translations[locale]; ok {
+		exhibition.ExhibitionName = fallback(translation.ExhibitionName, exhibition.ExhibitionName)
+		exhibition.ExhibitionDescription = fallback(translation.ExhibitionDescription, exhibition.ExhibitionDescription)
+	}
+
+	for i := range exhibition.ExhibitionSections {
+		section := &exhibition.ExhibitionSections[i]
+		if translation, ok := section.Translations[locale]; ok {
+			section.Title = fallback(translation.Title, section.Title)
+			section.Text = fallback(translation.Text, section.Text)
+		}
+	}
+
+	for i := range exhibition.Timeline {
+		entry := &exhibition.Timeline[i]
+		if translation, ok := entry.Translations[locale]; ok {
+			entry.Title = fallback(translation.Title, entry.Title)
+			entry.Text = fallback(translation.Text, entry.Text)
+		}
+	}
+
+	for i := range exhibition.PointsOfInterest {
+		point := &exhibition.PointsOfInterest[i]
+		if translation, ok := point.Translations[locale]; ok {
+			point.Title = fallback(translation.Title, point.Title)
+			point.Text = fallback(translation.Text, point.Text)
+		}
+	}
+
+	walkDetails(exhibition, func(details *model.Details) {
+		if contents, ok := details.Translations[locale]; ok && len(contents) > 0 {
+			details.Contents = contents
+		}
+	})
+}
+
+// LocalizeAll localizes every exhibition of a list.
+func LocalizeAll(exhibitions []model.ResponseExhibition, preferences []language.Tag) {
+	for i := range exhibitions {
+		Localize(&exhibitions[i], preferences)
+	}
+}
+
+// Completeness reports for every locale of an exhibition which texts are translated. Texts
+// that are empty in the default locale need no translation. Missing texts are named by path,
+// such as "sections[0].title".
+func Completeness(exhibition *model.ResponseExhibition) []model.LocaleCompleteness {
+	locales := Locales(exhibition)
+	report := make([]model.LocaleCompleteness, len(locales))
+
+	for i, locale := range locales {
+		isDefault := i == 0
+		status := model.LocaleCompleteness{Locale: locale, Default: isDefault, Missing: []string{}}
+		count := func(path string, translated bool) {
+			status.Total++
+			if isDefault || translated {
+				status.Translated++
+			} else {
+				status.Missing = append(status.Missing, path)
+			}
+		}
+		check := func(path, value, translated string) {
+			if value != "" {
+				count(path, translated != "")
+			}
+		}
+
+		translation := exhibition.Translations[locale]
+		check("exhibitionName", exhibition.ExhibitionName, translation.ExhibitionName)
+		check("exhibitionDescription", exhibition.ExhibitionDescription, translation.ExhibitionDescription)
+
+		for j, section := range exhibition.ExhibitionSections {
+			translation := section.Translations[locale]
+			check(fmt.Sprintf("sections[%d].title", j), section.Title, translation.Title)
+			check(fmt.Sprintf("sections[%d].text", j), section.Text, translation.Text)
+		}
+
+		for j, entry := range exhibition.Timeline {
+			translation := entry.Translations[locale]
+			check(fmt.Sprintf("timeline[%d].title", j), entry.Title, translation.Title)
+			check(fmt.Sprintf("timeline[%d].text", j), entry.Text, translation.Text)
+		}
+
+		for j, point := range exhibition.PointsOfInterest {
+			translation := point.Translations[locale]
+			check(fmt.Sprintf("pointsOfInterest[%d].title", j), point.Title, translation.Title)
+			check(fmt.Sprintf("pointsOfInterest[%d].text", j), point.Text, translation.Text)
+		}
+
+		for j := range exhibition.Room {
+			for _, item := range roomDetails(&exhibition.Room[j]) {
+				if len(item.details.Contents) > 0 {
+					path := fmt.Sprintf("rooms[%d].%s[%d].details.contents", j, item.wall, item.index)
+					count(path, len(item.details.Translations[locale]) > 0)
+				}
+			}
+		}
+
+		status.Complete = status.Translated == status.Total
+		report[i] = status
+	}
+
+	return report
+}
+
+// SearchIndex returns the text of an exhibition per locale for full text search.
+func SearchIndex(exhibition *model.ResponseExhibition) []model.SearchEntry {
+	defaultLocale := DefaultLocale(exhibition)
+	var entries []model.SearchEntry
+
+	for _, locale := range Locales(exhibition) {
+		isDefault := locale == defaultLocale
+		var texts []string
+		add := func(value, translated string) {
+			if isDefault {
+				translated = value
+			}
+			if translated != "" {
+				texts = append(texts, translated)
+			}
+		}
+
+		translation := exhibition.Translations[locale]
+		add(exhibition.ExhibitionName, translation.ExhibitionName)
+		add(exhibition.ExhibitionDescription, translation.ExhibitionDescription)
+		for _, section := range exhibition.ExhibitionSections {
+			translation := section.Translations[locale]
+			add(section.Title, translation.Title)
+			add(section.Text, translation.Text)
+		}
+		for _, entry := range exhibition.Timeline {
+			translation := entry.Translations[locale]
+			add(entry.Title, translation.Title)
+			add(entry.Text, translation.Text)
+		}
+		for _, point := range exhibition.PointsOfInterest {
+			translation := point.Translations[locale]
+			add(point.Title, translation.Title)
+			add(point.Text, translation.Text)
+		}
+
+		if len(texts) > 0 {
+			entries = append(entries, model.SearchEntry{Language: SearchLanguage(locale), Text: strings.Join(texts, "\n")})
+		}
+	}
+
+	return entries
+}
+
+// searchLanguages maps base languages to the languages of MongoDB text search.
+var searchLanguages = map[string]string{
+	"da": "danish", "de": "german", "en": "english", "es": "spanish", "fi": "finnish",
+	"fr": "french", "hu": "hungarian", "it": "italian", "nb": "norwegian", "nl": "dutch",
+	"pt": "portuguese", "ro": "romanian", "ru": "russian", "sv": "swedish", "tr": "turkish",
+}
+
+// SearchLanguage returns the MongoDB text search language of a locale. Languages without
+// stemming support, such as Thai, are indexed with "none" and only match whole words.
+func SearchLanguage(locale string) string {
+	base, _ := language.Make(locale).Base()
+	if name, ok := searchLanguages[base.String()]; ok {
+		return name
+	}
+	return "none"
+}
+
+func fallback(translated, value string) string {
+	if translated != "" {
+		return translated
+	}
+	return value
+}
+
+type wallDetails struct {
+	wall    string
+	index   int
+	details *model.Details
+}
+
+// roomDetails lists the details of the items of a room wall by wall.
+func roomDetails(room *model.Room) []wallDetails {
+	var details []wallDetails
+	for i := range room.Left {
+		details = append(details, wallDetails{wall: "left", index: i, details: &room.Left[i].Details})
+	}
+	for i := range room.Center {
+		details = append(details, wallDetails{wall: "center", index: i, details: &room.Center[i].Details})
+	}
+	for i := range room.Right {
+		details = append(details, wallDetails{wall: "right", index: i, details: &room.Right[i].Details})
+	}
+	return details
+}
+
+func walkDetails(exhibition *model.ResponseExhibition, fn func(details *model.Details)) {
+	for i := range exhibition.Room {
+		for _, item := range roomDetails(&exhibition.Room[i]) {
+			fn(item.details)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package i18n_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exhibition() *model.ResponseExhibition {
+	return &model.ResponseExhibition{
+		ExhibitionName:        "Siam Ceramics",
+		ExhibitionDescription: "Stoneware from Sukhothai",
+		Translations: map[string]model.ExhibitionTranslation{
+			"th": {ExhibitionName: "เครื่องปั้นดินเผาสยาม"},
+		},
+		ExhibitionSections: []model.ExhibitionSection{
+			{
+				Title: "Celadon",
+				Text:  "Green glazes",
+				Translations: map[string]model.SectionTranslation{
+					"th": {Title: "ศิลาดล", Text: "เคลือบสีเขียว"},
+				},
+			},
+			{Title: "Kilns"},
+		},
+		Room: []model.Room{{
+			Center: []model.CenterItem{{Details: model.Details{
+				Contents: []model.Contents{{Title: "Jar"}},
+				Translations: map[string][]model.Contents{
+					"th":    {{Title: "ไห"}},
+					"zh-TW": {{Title: "罐"}},
+				},
+			}}},
+		}},
+	}
+}
+
+func TestPreferences(t *testing.T) {
+	tags := i18n.Preferences("th", "en-US,en;q=0.8,not a tag")
+	require.NotEmpty(t, tags)
+	assert.Equal(t, "th", tags[0].String())
+
+	assert.Empty(t, i18n.Preferences("", ""))
+}
+
+func TestNegotiate(t *testing.T) {
+	locales := i18n.Locales(exhibition())
+	assert.Equal(t, []string{"en", "th", "zh-TW"}, locales)
+
+	assert.Equal(t, "th", i18n.Negotiate(i18n.Preferences("", "th-TH,en;q=0.5"), locales))
+	assert.Equal(t, "zh-TW", i18n.Negotiate(i18n.Preferences("zh-Hant", ""), locales))
+	assert.Equal(t, "en", i18n.Negotiate(i18n.Preferences("", "fr-CH"), locales))
+	assert.Equal(t, "en", i18n.Negotiate(nil, locales))
+}
+
+func TestLocalize(t *testing.T) {
+	e := exhibition()
+	i18n.Localize(e, i18n.Preferences("th", ""))
+
+	assert.Equal(t, "th", e.Locale)
+	assert.Equal(t, "เครื่องปั้นดินเผาสยาม", e.ExhibitionName)
+	// Untranslated texts fall back to the default locale
+	assert.Equal(t, "Stoneware from Sukhothai", e.ExhibitionDescription)
+	assert.Equal(t, "ศิลาดล", e.ExhibitionSections[0].Title)
+	assert.Equal(t, "Kilns", e.ExhibitionSections[1].Title)
+	assert.Equal(t, "ไห", e.Room[0].Center[0].Details.Contents[0].Title)
+
+	e = exhibition()
+	i18n.Localize(e, nil)
+	assert.Equal(t, "en", e.Locale)
+	assert.Equal(t, "Siam Ceramics", e.ExhibitionName)
+}
+
+func TestCompleteness(t *testing.T) {
+	report := i18n.Completeness(exhibition())
+	require.Len(t, report, 3)
+
+	assert.True(t, report[0].Default)
+	assert.True(t, report[0].Complete)
+	assert.Equal(t, 6, report[0].Total)
+
+	assert.Equal(t, "th", report[1].Locale)
+	assert.Equal(t, 4, report[1].Translated)
+	assert.Equal(t, []string{"exhibitionDescription", "sections[1].title"}, report[1].Missing)
+	assert.False(t, report[1].Complete)
+
+	assert.Equal(t, 1, report[2].Translated)
+}
+
+func TestSearchIndex(t *testing.T) {
+	entries := i18n.SearchIndex(exhibition())
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "english", entries[0].Language)
+	assert.Contains(t, entries[0].Text, "Green glazes")
+	assert.Equal(t, "none", entries[1].Language)
+	assert.Contains(t, entries[1].Text, "ศิลาดล")
+	assert.NotContains(t, entries[1].Text, "Kilns")
+}
@@ -0,0 +1,299 @@
+package iiif
+
+import (
+	"atommuse/backend/exhibition-service/pkg/media"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/rights"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Context is the JSON-LD context of IIIF Presentation 3.0 documents.
+const Context = "http://iiif.io/api/presentation/3/context.json"
+
+// Canvas size used when the dimensions of an image are unknown. Viewers scale the image to fit.
+const (
+	DefaultWidth  = 1000
+	DefaultHeight = 1000
+)
+
+// LanguageMap holds a label or summary. Untagged values use the "none" language.
+type LanguageMap map[string][]string
+
+// Manifest is a IIIF Presentation 3.0 manifest.
+type Manifest struct {
+	Context    string         `json:"@context"`
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	Label      LanguageMap    `json:"label"`
+	Summary    LanguageMap    `json:"summary,omitempty"`
+	Metadata   []MetadataItem `json:"metadata,omitempty"`
+	Thumbnail  []Resource     `json:"thumbnail,omitempty"`
+	Items      []Canvas       `json:"items"`
+	Structures []Range        `json:"structures,omitempty"`
+}
+
+// MetadataItem is a label and value pair shown by viewers.
+type MetadataItem struct {
+	Label LanguageMap `json:"label"`
+	Value LanguageMap `json:"value"`
+}
+
+// Canvas is a view of a single image. Rights is the URI of the image licence and
+// RequiredStatement its credit line.
+type Canvas struct {
+	ID                string           `json:"id"`
+	Type              string           `json:"type"`
+	Label             LanguageMap      `json:"label,omitempty"`
+	Summary           LanguageMap      `json:"summary,omitempty"`
+	Rights            string           `json:"rights,omitempty"`
+	RequiredStatement *MetadataItem    `json:"requiredStatement,omitempty"`
+	Height            int              `json:"height"`
+	Width             int              `json:"width"`
+	Items             []AnnotationPage `json:"items"`
+}
+
+// AnnotationPage lists the annotations painted on a canvas.
+type AnnotationPage struct {
+	ID    string       `json:"id"`
+	Type  string       `json:"type"`
+	Items []Annotation `json:"items"`
+}
+
+// Annotation paints a resource on its target canvas.
+type Annotation struct {
+	ID         string   `json:"id"`
+	Type       string   `json:"type"`
+	Motivation string   `json:"motivation"`
+	Body       Resource `json:"body"`
+	Target     string   `json:"target"`
+}
+
+// Resource is an external content resource such as an image.
+type Resource struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Format string `json:"format,omitempty"`
+}
+
+// Range groups canvases into a section or room of the exhibition.
+type Range struct {
+	ID    string        `json:"id"`
+	Type  string        `json:"type"`
+	Label LanguageMap   `json:"label"`
+	Items []RangeMember `json:"items"`
+}
+
+// RangeMember references a canvas from a range.
+type RangeMember struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// image is a painted image with the texts describing it.
+type image struct {
+	src     string
+	label   string
+	summary string
+}
+
+// builder assigns IDs below the manifest ID as canvases are added.
+type builder struct {
+	manifest *Manifest
+	mediaURL string
+	rights   map[string]*model.MediaRights
+}
+
+// Build creates a manifest for an exhibition. id is the URL the manifest is served from and
+// mediaURL the base URL relative media references are resolved against. Sections of blog
+// exhibitions and rooms of live exhibitions become ranges; every image in them becomes a canvas.
+// Other media such as videos is left out since its dimensions and duration are unknown.
+// Canvases carry the licence and credit line of their image when its rights are known.
+func Build(exhibition *model.ResponseExhibition, id, mediaURL string) *Manifest {
+	b := &builder{
+		manifest: &Manifest{
+			Context: Context,
+			ID:      id,
+			Type:    "Manifest",
+			Label:   text(exhibition.ExhibitionName),
+			Summary: text(exhibition.ExhibitionDescription),
+			Items:   []Canvas{},
+		},
+		mediaURL: strings.TrimSuffix(mediaURL, "/"),
+		rights:   rights.Index(exhibition),
+	}
+
+	b.metadata("Start date", exhibition.StartDate)
+	b.metadata("End date", exhibition.EndDate)
+	b.metadata("Categories", strings.Join(exhibition.ExhibitionCategories, ", "))
+	b.metadata("Tags", strings.Join(exhibition.ExhibitionTags, ", "))
+
+	if exhibition.ThumbnailImg != "" {
+		b.manifest.Thumbnail = []Resource{b.resource(exhibition.ThumbnailImg)}
+	}
+
+	for i, section := range exhibition.ExhibitionSections {
+		label := section.Title
+		if label == "" {
+			label = fmt.Sprintf("Section %d", i+1)
+		}
+		b.addRange(fmt.Sprintf("section-%d", i+1), label, sectionImages(section))
+	}
+
+	for i, room := range exhibition.Room {
+		b.addRange(fmt.Sprintf("room-%d", i+1), fmt.Sprintf("Room %d", i+1), roomImages(room))
+	}
+
+	return b.manifest
+}
+
+func sectionImages(section model.ExhibitionSection) []image {
+	var images []image
+	if section.LeftCol.Image != "" {
+		images = append(images, columnImage(section.LeftCol.Image, section.LeftCol.Title, section.LeftCol.ImageDescription, section.LeftCol.Text))
+	}
+	if section.RightCol.Image != "" {
+		images = append(images, columnImage(section.RightCol.Image, section.RightCol.Title, section.RightCol.ImageDescription, section.RightCol.Text))
+	}
+	for _, src := range section.Images {
+		if src != "" {
+			images = append(images, image{src: src, label: section.Title, summary: section.Text})
+		}
+	}
+	return images
+}
+
+func columnImage(src, title, description, body string) image {
+	label := title
+	if label == "" {
+		label = description
+	}
+	summary := description
+	if summary == "" || summary == label {
+		summary = body
+	}
+	return image{src: src, label: label, summary: summary}
+}
+
+func roomImages(room model.Room) []image {
+	var images []image
+	add := func(previewType, src string, details model.Details) {
+		if src == "" || previewType == "video" {
+			return
+		}
+		img := image{src: src}
+		var texts []string
+		for _, content := range details.Contents {
+			if img.label == "" {
+				img.label = content.Title
+			}
+			for _, paragraph := range content.Text {
+				texts = append(texts, strings.Join(paragraph, " "))
+			}
+		}
+		img.summary = strings.Join(texts, "\n")
+		images = append(images, img)
+	}
+
+	for _, item := range room.Left {
+		add(item.PreviewType, item.Src, item.Details)
+	}
+	for _, item := range room.Center {
+		add(item.PreviewType, item.Src, item.Details)
+	}
+	for _, item := range room.Right {
+		add(item.PreviewType, item.Src, item.Details)
+	}
+	return images
+}
+
+// addRange adds a canvas per image and a range grouping them. Empty ranges are left out.
+func (b *builder) addRange(name, label string, images []image) {
+	if len(images) == 0 {
+		return
+	}
+
+	r := Range{ID: b.manifest.ID + "/range/" + name, Type: "Range", Label: text(label), Items: []RangeMember{}}
+	for _, img := range images {
+		canvas := b.addCanvas(img)
+		r.Items = append(r.Items, RangeMember{ID: canvas.ID, Type: "Canvas"})
+	}
+	b.manifest.Structures = append(b.manifest.Structures, r)
+}
+
+func (b *builder) addCanvas(img image) Canvas {
+	n := len(b.manifest.Items) + 1
+	canvasID := fmt.Sprintf("%s/canvas/%d", b.manifest.ID, n)
+
+	canvas := Canvas{
+		ID:      canvasID,
+		Type:    "Canvas",
+		Label:   text(img.label),
+		Summary: text(img.summary),
+		Height:  DefaultHeight,
+		Width:   DefaultWidth,
+		Items: []AnnotationPage{{
+			ID:   fmt.Sprintf("%s/page/%d", b.manifest.ID, n),
+			Type: "AnnotationPage",
+			Items: []Annotation{{
+				ID:         fmt.Sprintf("%s/annotation/%d", b.manifest.ID, n),
+				Type:       "Annotation",
+				Motivation: "painting",
+				Body:       b.resource(img.src),
+				Target:     canvasID,
+			}},
+		}},
+	}
+
+	if r := b.rights[img.src]; r != nil {
+		canvas.Rights = rights.Licenses[r.License].URL
+		if credit := rights.Credit(r); credit != "" {
+			canvas.RequiredStatement = &MetadataItem{Label: text("Attribution"), Value: text(credit)}
+		}
+	}
+
+	b.manifest.Items = append(b.manifest.Items, canvas)
+	return canvas
+}
+
+func (b *builder) metadata(label, value string) {
+	if value == "" {
+		return
+	}
+	b.manifest.Metadata = append(b.manifest.Metadata, MetadataItem{Label: text(label), Value: text(value)})
+}
+
+// resource describes an image, resolving relative references against the media URL.
+func (b *builder) resource(src string) Resource {
+	if !media.IsRemote(src) {
+		src = b.mediaURL + "/" + strings.TrimPrefix(src, "/")
+	}
+	return Resource{ID: src, Type: "Image", Format: imageFormat(src)}
+}
+
+func imageFormat(src string) string {
+	if i := strings.IndexAny(src, "?#"); i >= 0 {
+		src = src[:i]
+	}
+	switch strings.ToLower(path.Ext(src)) {
+	case ".jpg", ".jpeg":
+		return "image/jpeg"
+	case ".png":
+		return "image/png"
+	case ".gif":
+		return "image/gif"
+	case ".webp":
+		return "image/webp"
+	case ".tif", ".tiff":
+		return "image/tiff"
+	}
+	return ""
+}
+
+func text(value string) LanguageMap {
+	if value == "" {
+		return nil
+	}
+	return LanguageMap{"none": {value}}
+}
@@ -0,0 +1,161 @@
+package iiif_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/iiif"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const manifestID = "https://museum.example/api/exhibitions/abc/iiif/manifest"
+
+// validate checks the JSON structure required by the IIIF Presentation 3.0 specification.
+func validate(t *testing.T, manifest *iiif.Manifest) map[string]interface{} {
+	raw, err := json.Marshal(manifest)
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(raw, &doc))
+
+	assert.Equal(t, iiif.Context, doc["@context"])
+	assert.Equal(t, "Manifest", doc["type"])
+	assertHTTPID(t, doc["id"])
+	assertLanguageMap(t, doc["label"])
+
+	items, ok := doc["items"].([]interface{})
+	require.True(t, ok, "manifest items must be an array")
+	require.NotEmpty(t, items, "manifest must have at least one canvas")
+
+	canvases := map[string]bool{}
+	for _, item := range items {
+		canvas := item.(map[string]interface{})
+		assert.Equal(t, "Canvas", canvas["type"])
+		assertHTTPID(t, canvas["id"])
+		assert.Greater(t, canvas["width"], float64(0))
+		assert.Greater(t, canvas["height"], float64(0))
+		if label, ok := canvas["label"]; ok {
+			assertLanguageMap(t, label)
+		}
+		canvases[canvas["id"].(string)] = true
+
+		pages := canvas["items"].([]interface{})
+		require.Len(t, pages, 1)
+		page := pages[0].(map[string]interface{})
+		assert.Equal(t, "AnnotationPage", page["type"])
+		assertHTTPID(t, page["id"])
+
+		for _, a := range page["items"].([]interface{}) {
+			annotation := a.(map[string]interface{})
+			assert.Equal(t, "Annotation", annotation["type"])
+			assert.Equal(t, "painting", annotation["motivation"])
+			assert.Equal(t, canvas["id"], annotation["target"])
+			assertHTTPID(t, annotation["id"])
+
+			body := annotation["body"].(map[string]interface{})
+			assert.Equal(t, "Image", body["type"])
+			assertHTTPID(t, body["id"])
+		}
+	}
+
+	if structures, ok := doc["structures"]; ok {
+		for _, s := range structures.([]interface{}) {
+			r := s.(map[string]interface{})
+			assert.Equal(t, "Range", r["type"])
+			assertHTTPID(t, r["id"])
+			assertLanguageMap(t, r["label"])
+			for _, m := range r["items"].([]interface{}) {
+				member := m.(map[string]interface{})
+				assert.Equal(t, "Canvas", member["type"])
+				assert.True(t, canvases[member["id"].(string)], "range references unknown canvas %v", member["id"])
+			}
+		}
+	}
+
+	return doc
+}
+
+func assertHTTPID(t *testing.T, value interface{}) {
+	id, ok := value.(string)
+	require.True(t, ok, "id must be a string")
+	u, err := url.Parse(id)
+	require.NoError(t, err)
+	assert.Contains(t, []string{"http", "https"}, u.Scheme, "id %q must be an HTTP(S) URI", id)
+}
+
+func assertLanguageMap(t *testing.T, value interface{}) {
+	languages, ok := value.(map[string]interface{})
+	require.True(t, ok, "language map must be an object")
+	for _, values := range languages {
+		list, ok := values.([]interface{})
+		require.True(t, ok, "language map values must be arrays")
+		for _, v := range list {
+			assert.IsType(t, "", v)
+		}
+	}
+}
+
+func TestBuildBlogLayout(t *testing.T) {
+	manifest := iiif.Build(&model.ResponseExhibition{
+		ExhibitionName:        "Siam Ceramics",
+		ExhibitionDescription: "Stoneware from the kilns of Sukhothai",
+		ThumbnailImg:          "/uploads/cover.jpg",
+		LayoutUsed:            "blogLayout",
+		ExhibitionSections: []model.ExhibitionSection{
+			{
+				Title:    "Celadon",
+				LeftCol:  model.LeftColumn{Image: "https://cdn.example/bowl.png", Title: "Bowl"},
+				RightCol: model.RightColumn{Image: "/uploads/jar.jpg", ImageDescription: "Jar"},
+				Images:   []string{"https://cdn.example/plate.jpg?v=2"},
+			},
+			{Title: "Text only", Text: "No images here"},
+		},
+		MediaRights: []model.MediaRights{
+			{Ref: "/uploads/jar.jpg", RightsHolder: "City Museum", License: model.LicenseCCBY},
+		},
+	}, manifestID, "https://media.example/")
+
+	doc := validate(t, manifest)
+
+	require.Len(t, manifest.Items, 3)
+	assert.Equal(t, iiif.LanguageMap{"none": {"Bowl"}}, manifest.Items[0].Label)
+	assert.Equal(t, iiif.LanguageMap{"none": {"Jar"}}, manifest.Items[1].Label)
+	assert.Equal(t, "https://media.example/uploads/jar.jpg", manifest.Items[1].Items[0].Items[0].Body.ID)
+	assert.Equal(t, "https://creativecommons.org/licenses/by/4.0/", manifest.Items[1].Rights)
+	assert.Equal(t, iiif.LanguageMap{"none": {"© City Museum, CC BY 4.0"}}, manifest.Items[1].RequiredStatement.Value)
+	assert.Empty(t, manifest.Items[0].Rights)
+	assert.Equal(t, "image/jpeg", manifest.Items[2].Items[0].Items[0].Body.Format)
+	assert.Equal(t, "https://media.example/uploads/cover.jpg", manifest.Thumbnail[0].ID)
+
+	// Sections without images get no range
+	require.Len(t, manifest.Structures, 1)
+	assert.Equal(t, iiif.LanguageMap{"none": {"Celadon"}}, manifest.Structures[0].Label)
+	assert.Equal(t, map[string]interface{}{"none": []interface{}{"Stoneware from the kilns of Sukhothai"}}, doc["summary"])
+}
+
+func TestBuildLiveLayout(t *testing.T) {
+	manifest := iiif.Build(&model.ResponseExhibition{
+		ExhibitionName: "Night Market",
+		LayoutUsed:     "liveLayout",
+		Room: []model.Room{{
+			Left: []model.LeftRightItem{{
+				Src: "https://cdn.example/lamp.jpg",
+				Details: model.Details{Contents: []model.Contents{
+					{Title: "Oil lamp", Text: [][]string{{"Brass,", "1890"}}},
+				}},
+			}},
+			Center: []model.CenterItem{{PreviewType: "video", Src: "https://cdn.example/clip.mp4"}},
+		}},
+	}, manifestID, "https://media.example")
+
+	validate(t, manifest)
+
+	require.Len(t, manifest.Items, 1)
+	assert.Equal(t, iiif.LanguageMap{"none": {"Oil lamp"}}, manifest.Items[0].Label)
+	assert.Equal(t, iiif.LanguageMap{"none": {"Brass, 1890"}}, manifest.Items[0].Summary)
+	require.Len(t, manifest.Structures, 1)
+	assert.Equal(t, iiif.LanguageMap{"none": {"Room 1"}}, manifest.Structures[0].Label)
+}
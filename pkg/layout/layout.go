@@ -0,0 +1,224 @@
+package layout
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/timeline"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Names of the built-in layouts.
+const (
+	Blog     = "blogLayout"
+	Live     = "liveLayout"
+	Timeline = "timelineLayout"
+	Map      = "mapLayout"
+)
+
+// Collections holding the content of layouts that is stored apart from sections and rooms.
+const (
+	TimelineCollection         = "exhibitionTimelineEntries"
+	PointsOfInterestCollection = "exhibitionPointsOfInterest"
+)
+
+// Layout describes how the content of an exhibition using it is stored.
+type Layout struct {
+	Name string
+	// Load fills in the content of an exhibition read from the exhibitions collection.
+	Load func(ctx context.Context, db *mongo.Database, exhibition *model.ResponseExhibition) error
+	// Delete removes content stored apart from the exhibition document. It may be nil.
+	Delete func(ctx context.Context, db *mongo.Database, exhibitionID primitive.ObjectID) error
+}
+
+var (
+	mu      sync.RWMutex
+	layouts = map[string]Layout{}
+)
+
+// Register makes a layout available under its name. It panics if the name is taken, as
+// registering happens at start up.
+func Register(layout Layout) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if layout.Name == "" || layout.Load == nil {
+		panic("layout: Register needs a name and a Load function")
+	}
+	if _, ok := layouts[layout.Name]; ok {
+		panic("layout: Register called twice for " + layout.Name)
+	}
+	layouts[layout.Name] = layout
+}
+
+// Lookup returns the layout registered under name.
+func Lookup(name string) (Layout, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	layout, ok := layouts[name]
+	return layout, ok
+}
+
+// Names returns the names of the registered layouts in alphabetical order.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(layouts))
+	for name := range layouts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Load fills in the content of an exhibition with its layout. Exhibitions of unknown layouts
+// are left as they are.
+func Load(ctx context.Context, db *mongo.Database, exhibition *model.ResponseExhibition) error {
+	layout, ok := Lookup(exhibition.LayoutUsed)
+	if !ok {
+		return nil
+	}
+	return layout.Load(ctx, db, exhibition)
+}
+
+// Delete removes the content an exhibition of the named layout stores apart from its document.
+func Delete(ctx context.Context, db *mongo.Database, name string, exhibitionID primitive.ObjectID) error {
+	layout, ok := Lookup(name)
+	if !ok || layout.Delete == nil {
+		return nil
+	}
+	return layout.Delete(ctx, db, exhibitionID)
+}
+
+// Of returns the name of the layout an exhibition uses, read from the exhibitions collection.
+func Of(ctx context.Context, exhibitions *mongo.Collection, exhibitionID primitive.ObjectID) (string, error) {
+	var exhibition struct {
+		LayoutUsed string `bson:"layoutUsed"`
+	}
+
+	opts := options.FindOne().SetProjection(bson.M{"layoutUsed": 1})
+	if err := exhibitions.FindOne(ctx, bson.M{"_id": exhibitionID}, opts).Decode(&exhibition); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", cerr.ErrExhibitionNotFound
+		}
+		return "", err
+	}
+
+	return exhibition.LayoutUsed, nil
+}
+
+func init() {
+	Register(Layout{Name: Blog, Load: loadSections})
+	Register(Layout{Name: Live, Load: loadRooms})
+	Register(Layout{Name: Timeline, Load: loadTimeline, Delete: deleteByExhibition(TimelineCollection)})
+	Register(Layout{Name: Map, Load: loadPointsOfInterest, Delete: deleteByExhibition(PointsOfInterestCollection)})
+}
+
+func loadSections(ctx context.Context, db *mongo.Database, exhibition *model.ResponseExhibition) error {
+	sectionCollection := db.Collection("exhibitionSections")
+
+	var sections []model.ExhibitionSection
+	for _, sectionID := range exhibition.ExhibitionSectionsID {
+		sectionObjID, err := primitive.ObjectIDFromHex(sectionID)
+		if err != nil {
+			return err
+		}
+
+		var section model.ExhibitionSection
+		err = sectionCollection.FindOne(ctx, bson.M{"_id": sectionObjID, "exhibitionID": exhibition.ID}).Decode(&section)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return errors.New("section not found")
+			}
+			return err
+		}
+
+		sections = append(sections, section)
+	}
+
+	exhibition.ExhibitionSections = sections
+	return nil
+}
+
+func loadRooms(ctx context.Context, db *mongo.Database, exhibition *model.ResponseExhibition) error {
+	roomCollection := db.Collection("exhibitionRooms")
+
+	var rooms []model.Room
+	for _, roomID := range exhibition.RoomsID {
+		roomObjID, err := primitive.ObjectIDFromHex(roomID)
+		if err != nil {
+			return err
+		}
+
+		var room model.Room
+		err = roomCollection.FindOne(ctx, bson.M{"_id": roomObjID, "exhibitionID": exhibition.ID}).Decode(&room)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return errors.New("room not found")
+			}
+			return err
+		}
+
+		rooms = append(rooms, room)
+	}
+
+	if rooms != nil {
+		exhibition.Room = rooms
+	}
+	return nil
+}
+
+func loadTimeline(ctx context.Context, db *mongo.Database, exhibition *model.ResponseExhibition) error {
+	cursor, err := db.Collection(TimelineCollection).Find(ctx, bson.M{"exhibitionID": exhibition.ID})
+	if err != nil {
+		return fmt.Errorf("error finding timeline entries: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var entries []model.TimelineEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return fmt.Errorf("error decoding timeline entries: %v", err)
+	}
+
+	timeline.Sort(entries)
+	exhibition.Timeline = entries
+	return nil
+}
+
+func loadPointsOfInterest(ctx context.Context, db *mongo.Database, exhibition *model.ResponseExhibition) error {
+	opts := options.Find().SetSort(bson.D{{Key: "order", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := db.Collection(PointsOfInterestCollection).Find(ctx, bson.M{"exhibitionID": exhibition.ID}, opts)
+	if err != nil {
+		return fmt.Errorf("error finding points of interest: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var points []model.PointOfInterest
+	if err := cursor.All(ctx, &points); err != nil {
+		return fmt.Errorf("error decoding points of interest: %v", err)
+	}
+
+	exhibition.PointsOfInterest = points
+	return nil
+}
+
+// deleteByExhibition returns a Delete function removing the documents of an exhibition from
+// a collection.
+func deleteByExhibition(collection string) func(ctx context.Context, db *mongo.Database, exhibitionID primitive.ObjectID) error {
+	return func(ctx context.Context, db *mongo.Database, exhibitionID primitive.ObjectID) error {
+		if _, err := db.Collection(collection).DeleteMany(ctx, bson.M{"exhibitionID": exhibitionID}); err != nil {
+			return fmt.Errorf("error deleting from %s: %v", collection, err)
+		}
+		return nil
+	}
+}
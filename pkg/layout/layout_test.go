@@ -0,0 +1,44 @@
+package layout_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestBuiltinLayouts(t *testing.T) {
+	assert.Subset(t, layout.Names(), []string{layout.Blog, layout.Live, layout.Timeline, layout.Map})
+
+	timeline, ok := layout.Lookup(layout.Timeline)
+	require.True(t, ok)
+	assert.NotNil(t, timeline.Delete)
+
+	_, ok = layout.Lookup("galleryLayout")
+	assert.False(t, ok)
+}
+
+func TestRegister(t *testing.T) {
+	loaded := false
+	layout.Register(layout.Layout{
+		Name: "testLayout",
+		Load: func(ctx context.Context, db *mongo.Database, exhibition *model.ResponseExhibition) error {
+			loaded = true
+			return nil
+		},
+	})
+
+	require.NoError(t, layout.Load(context.Background(), nil, &model.ResponseExhibition{LayoutUsed: "testLayout"}))
+	assert.True(t, loaded)
+
+	// Exhibitions of unknown layouts are left alone
+	assert.NoError(t, layout.Load(context.Background(), nil, &model.ResponseExhibition{LayoutUsed: "galleryLayout"}))
+
+	assert.Panics(t, func() {
+		layout.Register(layout.Layout{Name: layout.Blog, Load: func(context.Context, *mongo.Database, *model.ResponseExhibition) error { return nil }})
+	})
+}
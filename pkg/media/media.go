@@ -0,0 +1,135 @@
+package media
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Root returns the directory holding locally stored media, configured with MEDIA_ROOT.
+func Root() string {
+	return os.Getenv("MEDIA_ROOT")
+}
+
+// IsRemote reports whether a media reference points to another host.
+func IsRemote(ref string) bool {
+	if strings.HasPrefix(ref, "//") {
+		return true
+	}
+	u, err := url.Parse(ref)
+	return err == nil && u.Scheme != ""
+}
+
+// LocalPath resolves a media reference to a file under MEDIA_ROOT.
+// It returns false for remote references or when no media root is configured.
+func LocalPath(ref string) (string, bool) {
+	root := Root()
+	if root == "" || ref == "" || IsRemote(ref) {
+		return "", false
+	}
+
+	// Cleaning an absolute path drops any ".." so the result stays inside the root
+	cleaned := path.Clean("/" + ref)
+	return filepath.Join(root, filepath.FromSlash(cleaned)), true
+}
+
+// Store writes the content of a reader to the local file of a media reference. The file is
+// written to a temporary file first, so a failed upload never leaves a partial file behind.
+func Store(ref string, r io.Reader) error {
+	target, ok := LocalPath(ref)
+	if !ok {
+		return cerr.ErrMediaStorageUnavailable
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), target)
+}
+
+// Walk calls fn with a pointer to every media reference of an exhibition, its sections, rooms,
+// timeline entries and points of interest, so callers can read or rewrite them in place.
+func Walk(exhibition *model.ResponseExhibition, fn func(ref *string)) {
+	visit := func(ref *string) {
+		if *ref != "" {
+			fn(ref)
+		}
+	}
+
+	visit(&exhibition.ThumbnailImg)
+
+	for i := range exhibition.ExhibitionSections {
+		section := &exhibition.ExhibitionSections[i]
+		visit(&section.Background)
+		visit(&section.LeftCol.Image)
+		visit(&section.RightCol.Image)
+		for j := range section.Images {
+			visit(&section.Images[j])
+		}
+	}
+
+	for i := range exhibition.Room {
+		room := &exhibition.Room[i]
+		visit(&room.MapThumbnail)
+		for j := range room.Left {
+			visit(&room.Left[j].Src)
+			visit(&room.Left[j].Details.Img)
+		}
+		for j := range room.Center {
+			visit(&room.Center[j].Src)
+			visit(&room.Center[j].Details.Img)
+		}
+		for j := range room.Right {
+			visit(&room.Right[j].Src)
+			visit(&room.Right[j].Details.Img)
+		}
+	}
+
+	for i := range exhibition.Timeline {
+		entry := &exhibition.Timeline[i]
+		for j := range entry.Media {
+			visit(&entry.Media[j])
+		}
+	}
+
+	for i := range exhibition.PointsOfInterest {
+		point := &exhibition.PointsOfInterest[i]
+		for j := range point.Media {
+			visit(&point.Media[j])
+		}
+	}
+}
+
+// References returns the distinct media references of an exhibition in the order they appear.
+func References(exhibition *model.ResponseExhibition) []string {
+	var refs []string
+	seen := map[string]bool{}
+
+	Walk(exhibition, func(ref *string) {
+		if !seen[*ref] {
+			seen[*ref] = true
+			refs = append(refs, *ref)
+		}
+	})
+
+	return refs
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Artwork is an object of an exhibitor's catalogue. Sections and room items reference it by ID
+// and get its metadata embedded when an exhibition is assembled.
+type Artwork struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	OwnerID         primitive.ObjectID `bson:"ownerId" json:"ownerId"`
+	AccessionNumber string             `bson:"accessionNumber,omitempty" json:"accessionNumber,omitempty"`
+	Title           string             `bson:"title" json:"title"`
+	Creator         string             `bson:"creator,omitempty" json:"creator,omitempty"`
+	Date            string             `bson:"date,omitempty" json:"date,omitempty"`
+	Medium          string             `bson:"medium,omitempty" json:"medium,omitempty"`
+	Dimensions      string             `bson:"dimensions,omitempty" json:"dimensions,omitempty"`
+	CreditLine      string             `bson:"creditLine,omitempty" json:"creditLine,omitempty"`
+	Rights          string             `bson:"rights,omitempty" json:"rights,omitempty"`
+	License         string             `bson:"license,omitempty" json:"license,omitempty"`
+	Description     string             `bson:"description,omitempty" json:"description,omitempty"`
+	Images          []string           `bson:"images,omitempty" json:"images,omitempty"`
+	CreatedAt       time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt       time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// RequestArtwork represents the structure of the request to create or replace an artwork.
+type RequestArtwork struct {
+	AccessionNumber string   `json:"accessionNumber,omitempty"`
+	Title           string   `json:"title" validate:"required"`
+	Creator         string   `json:"creator,omitempty"`
+	Date            string   `json:"date,omitempty"`
+	Medium          string   `json:"medium,omitempty"`
+	Dimensions      string   `json:"dimensions,omitempty"`
+	CreditLine      string   `json:"creditLine,omitempty"`
+	Rights          string   `json:"rights,omitempty"`
+	License         string   `json:"license,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Images          []string `json:"images,omitempty" validate:"omitempty,dive,required"`
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditEntry records a request that changed or tried to change something: who made it, what
+// it acted on and the state of the target before and after, as hashes of its document.
+type AuditEntry struct {
+	ID            primitive.ObjectID  `bson:"_id,omitempty" json:"_id,omitempty"`
+	ActorID       *primitive.ObjectID `bson:"actorID,omitempty" json:"actorId,omitempty"`
+	ActorUsername string              `bson:"actorUsername,omitempty" json:"actorUsername,omitempty"`
+	Role          string              `bson:"role,omitempty" json:"role,omitempty"`
+	// Action is the name of the handler of the request, such as BanExhibition.
+	Action     string `bson:"action" json:"action"`
+	Method     string `bson:"method" json:"method"`
+	Route      string `bson:"route" json:"route"`
+	TargetType string `bson:"targetType,omitempty" json:"targetType,omitempty"`
+	TargetID   string `bson:"targetID,omitempty" json:"targetId,omitempty"`
+	// BeforeHash and AfterHash are SHA-256 hashes of the target document, empty when it did not
+	// exist.
+	BeforeHash string    `bson:"beforeHash,omitempty" json:"beforeHash,omitempty"`
+	AfterHash  string    `bson:"afterHash,omitempty" json:"afterHash,omitempty"`
+	Status     int       `bson:"status" json:"status"`
+	IP         string    `bson:"ip" json:"ip"`
+	RequestID  string    `bson:"requestID" json:"requestId"`
+	At         time.Time `bson:"at" json:"at"`
+}
+
+// AuditQuery selects audit entries. Empty fields match every entry, and Before is the ID of
+// the last entry of the previous page.
+type AuditQuery struct {
+	ActorID    *primitive.ObjectID
+	Action     string
+	TargetType string
+	TargetID   string
+	From       *time.Time
+	To         *time.Time
+	Before     *primitive.ObjectID
+	Limit      int64
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Conflict types reported when importing an exhibition bundle.
+const (
+	ConflictDuplicateName  = "duplicate_name"
+	ConflictOrphanContent  = "orphan_content"
+	ConflictMediaMissing   = "media_missing"
+	ConflictMediaCorrupt   = "media_corrupt"
+	ConflictMediaNotStored = "media_not_stored"
+)
+
+// BundleManifest describes an exported exhibition. It is stored as manifest.json
+// at the root of the bundle archive, next to the media files it lists.
+type BundleManifest struct {
+	Format        string              `json:"format"`
+	SchemaVersion int                 `json:"schemaVersion"`
+	ExportedAt    time.Time           `json:"exportedAt"`
+	Exhibition    ResponseExhibition  `json:"exhibition"`
+	Sections      []ExhibitionSection `json:"sections"`
+	Rooms         []Room              `json:"rooms"`
+	Media         []BundleMedia       `json:"media"`
+}
+
+// BundleMedia links a media reference used by the exhibition to a file inside the bundle.
+type BundleMedia struct {
+	Ref    string `json:"ref"`
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ImportConflict describes something that could not be imported as is.
+type ImportConflict struct {
+	Type    string `json:"type"`
+	Ref     string `json:"ref,omitempty"`
+	Message string `json:"message"`
+}
+
+// ResponseImportExhibition reports the outcome of an import. The ID is empty for dry runs.
+type ResponseImportExhibition struct {
+	ID        *primitive.ObjectID `json:"_id,omitempty"`
+	DryRun    bool                `json:"dryRun"`
+	Conflicts []ImportConflict    `json:"conflicts"`
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Collaborator roles on an exhibition, from most to least privileged.
+const (
+	RoleOwner  = "owner"
+	RoleEditor = "editor"
+	RoleViewer = "viewer"
+)
+
+// Collaborator invitation states.
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+)
+
+// Collaborator represents a user who co-curates an exhibition.
+type Collaborator struct {
+	UserID       primitive.ObjectID `bson:"userId" json:"userId"`
+	FirstName    string             `bson:"firstName,omitempty" json:"firstName,omitempty"`
+	LastName     string             `bson:"lastName,omitempty" json:"lastName,omitempty"`
+	Username     string             `bson:"username,omitempty" json:"username,omitempty"`
+	ProfileImage string             `bson:"profile,omitempty" json:"profile,omitempty"`
+	Role         string             `bson:"role" json:"role"`
+	Status       string             `bson:"status" json:"status"`
+	InvitedBy    primitive.ObjectID `bson:"invitedBy,omitempty" json:"invitedBy,omitempty"`
+	InvitedAt    time.Time          `bson:"invitedAt" json:"invitedAt"`
+	AcceptedAt   *time.Time         `bson:"acceptedAt,omitempty" json:"acceptedAt,omitempty"`
+}
+
+// ExhibitionAccess holds the ownership data needed to authorize a request on an exhibition.
+type ExhibitionAccess struct {
+	ID            primitive.ObjectID `bson:"_id" json:"_id"`
+	UserID        UserID             `bson:"userId" json:"userId"`
+	Collaborators []Collaborator     `bson:"collaborators,omitempty" json:"collaborators,omitempty"`
+}
+
+// Actor represents the authenticated user performing a request.
+type Actor struct {
+	UserID UserID
+	Role   string
+}
+
+// RequestInviteCollaborator represents the structure of the request to invite a collaborator.
+type RequestInviteCollaborator struct {
+	UserID       primitive.ObjectID `json:"userId" validate:"required"`
+	FirstName    string             `json:"firstName,omitempty"`
+	LastName     string             `json:"lastName,omitempty"`
+	Username     string             `json:"username,omitempty"`
+	ProfileImage string             `json:"profile,omitempty"`
+	Role         string             `json:"role" validate:"required,oneof=editor viewer"`
+}
+
+// RequestUpdateCollaboratorRole represents the structure of the request to change a collaborator role.
+type RequestUpdateCollaboratorRole struct {
+	Role string `json:"role" validate:"required,oneof=editor viewer"`
+}
+
+// RequestTransferOwnership represents the structure of the request to transfer exhibition ownership.
+type RequestTransferOwnership struct {
+	UserID primitive.ObjectID `json:"userId" validate:"required"`
+}
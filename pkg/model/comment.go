@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CommentCleanup is a pending removal of the comments of a deleted exhibition, queued while
+// the comment service was down.
+type CommentCleanup struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionId"`
+	// Failures counts the cleanups that failed, and LastError tells why the last one failed.
+	Failures      int       `bson:"failures" json:"failures"`
+	LastError     string    `bson:"lastError,omitempty" json:"lastError,omitempty"`
+	NextAttemptAt time.Time `bson:"nextAttemptAt" json:"nextAttemptAt"`
+	CreatedAt     time.Time `bson:"createdAt" json:"createdAt"`
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Types of the domain events about exhibitions, their sections and their rooms.
+const (
+	EventExhibitionCreated     = "exhibition.created"
+	EventExhibitionUpdated     = "exhibition.updated"
+	EventExhibitionPublished   = "exhibition.published"
+	EventExhibitionUnpublished = "exhibition.unpublished"
+	EventExhibitionBanned      = "exhibition.banned"
+	EventExhibitionModerated   = "exhibition.moderated"
+	EventExhibitionDeleted     = "exhibition.deleted"
+	EventLikesChanged          = "exhibition.likesChanged"
+	EventSectionChanged        = "section.changed"
+	EventRoomChanged           = "room.changed"
+)
+
+// Event is a change to an exhibition, one of its sections or one of its rooms. Section and
+// room events carry the ID of the changed section or room when one was created or edited,
+// and none when sections or rooms were added to, removed from or reordered in the exhibition.
+type Event struct {
+	// ID identifies the event. Events of streams are identified by their position in the
+	// change history, which streams resume after.
+	ID string `bson:"id" json:"id"`
+	// Sequence numbers the events of an exhibition published to the event bus from 1 on, so
+	// consumers can order them and drop the ones delivered again. Streams leave it out.
+	Sequence       int64               `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	Type           string              `bson:"type" json:"type" enums:"exhibition.created,exhibition.updated,exhibition.published,exhibition.unpublished,exhibition.banned,exhibition.moderated,exhibition.deleted,exhibition.likesChanged,section.changed,room.changed"`
+	ExhibitionID   primitive.ObjectID  `bson:"exhibitionID" json:"exhibitionId"`
+	ExhibitionName string              `bson:"exhibitionName,omitempty" json:"exhibitionName,omitempty"`
+	OwnerID        *primitive.ObjectID `bson:"ownerID,omitempty" json:"ownerId,omitempty"`
+	SectionID      *primitive.ObjectID `bson:"sectionID,omitempty" json:"sectionId,omitempty"`
+	RoomID         *primitive.ObjectID `bson:"roomID,omitempty" json:"roomId,omitempty"`
+	// Fields lists the top-level fields an update changed.
+	Fields    []string `bson:"fields,omitempty" json:"fields,omitempty"`
+	LikeCount *int     `bson:"likeCount,omitempty" json:"likeCount,omitempty"`
+	// Moderation is the action a moderator took when the event results from one, whatever
+	// its type: bans are banned events and unpublishing is an unpublished event.
+	Moderation *ModerationOutcome `bson:"moderation,omitempty" json:"moderation,omitempty"`
+	OccurredAt time.Time          `bson:"occurredAt" json:"occurredAt"`
+}
+
+// EventQuery selects the events of a stream. Types is empty to receive every type, and
+// ResumeToken is the ID of the last event a reconnecting client received.
+type EventQuery struct {
+	ExhibitionID *primitive.ObjectID
+	OwnerID      *primitive.ObjectID
+	Types        []string
+	ResumeToken  string
+}
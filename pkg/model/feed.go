@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PublishedQuery selects published exhibitions for sitemaps and feeds.
+// Zero values leave a criterion out.
+type PublishedQuery struct {
+	Category    string
+	UserID      primitive.ObjectID
+	EndingAfter time.Time
+	Newest      bool
+	Skip        int64
+	Limit       int64
+}
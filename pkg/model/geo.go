@@ -0,0 +1,66 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// GeoPointType is the GeoJSON type of a point.
+const GeoPointType = "Point"
+
+// GeoPoint is a GeoJSON point. Coordinates are longitude then latitude, as GeoJSON and the
+// MongoDB 2dsphere index expect.
+type GeoPoint struct {
+	Type        string    `bson:"type" json:"type" example:"Point"`
+	Coordinates []float64 `bson:"coordinates" json:"coordinates" example:"100.4930,13.7500"`
+}
+
+// Venue is the place a physical exhibition is shown at.
+type Venue struct {
+	Name     string   `bson:"name,omitempty" json:"name,omitempty"`
+	Address  string   `bson:"address,omitempty" json:"address,omitempty"`
+	Location GeoPoint `bson:"location" json:"location"`
+}
+
+// PointOfInterest is a stop of a mapLayout exhibition, such as a building of a walking tour.
+// Points are shown in ascending Order.
+type PointOfInterest struct {
+	ID           primitive.ObjectID            `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID            `bson:"exhibitionID" json:"exhibitionId"`
+	Order        int                           `bson:"order" json:"order"`
+	Title        string                        `bson:"title" json:"title"`
+	Text         string                        `bson:"text,omitempty" json:"text,omitempty"`
+	Address      string                        `bson:"address,omitempty" json:"address,omitempty"`
+	Location     GeoPoint                      `bson:"location" json:"location"`
+	Media        []string                      `bson:"media,omitempty" json:"media,omitempty"`
+	Translations map[string]SectionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+}
+
+// RequestCreatePointOfInterest represents the structure of the request to create a point of interest.
+type RequestCreatePointOfInterest struct {
+	ExhibitionID primitive.ObjectID `json:"exhibitionId" validate:"required"`
+	RequestUpdatePointOfInterest
+}
+
+// RequestUpdatePointOfInterest represents the structure of the request to replace a point of interest.
+type RequestUpdatePointOfInterest struct {
+	Order        int                           `json:"order"`
+	Title        string                        `json:"title" validate:"required"`
+	Text         string                        `json:"text,omitempty"`
+	Address      string                        `json:"address,omitempty"`
+	Location     GeoPoint                      `json:"location"`
+	Media        []string                      `json:"media,omitempty" validate:"omitempty,dive,required"`
+	Translations map[string]SectionTranslation `json:"translations,omitempty"`
+}
+
+// NearbyQuery narrows the public exhibitions near a place.
+type NearbyQuery struct {
+	Latitude  float64
+	Longitude float64
+	// Radius is the largest distance in meters.
+	Radius float64
+	Limit  int
+}
+
+// ResponseNearbyExhibition is a public exhibition with the distance of its venue in meters.
+type ResponseNearbyExhibition struct {
+	ResponseExhibition `bson:",inline"`
+	Distance           float64 `bson:"distance" json:"distance"`
+}
@@ -0,0 +1,39 @@
+package model
+
+// DefaultLocale is the locale of content that does not declare its default locale.
+const DefaultLocale = "en"
+
+// ExhibitionTranslation holds the translated texts of an exhibition in one locale.
+type ExhibitionTranslation struct {
+	ExhibitionName        string `bson:"exhibitionName,omitempty" json:"exhibitionName,omitempty"`
+	ExhibitionDescription string `bson:"exhibitionDescription,omitempty" json:"exhibitionDescription,omitempty"`
+}
+
+// SectionTranslation holds the translated texts of an exhibition section in one locale.
+type SectionTranslation struct {
+	Title string `bson:"title,omitempty" json:"title,omitempty"`
+	Text  string `bson:"text,omitempty" json:"text,omitempty"`
+}
+
+// SearchEntry is the text of an exhibition in one language, indexed for full text search.
+// Language is the MongoDB text search language, "none" when it is not supported.
+type SearchEntry struct {
+	Language string `bson:"language"`
+	Text     string `bson:"text"`
+}
+
+// LocaleCompleteness reports how much of an exhibition is translated into a locale.
+type LocaleCompleteness struct {
+	Locale     string   `json:"locale"`
+	Default    bool     `json:"default"`
+	Translated int      `json:"translated"`
+	Total      int      `json:"total"`
+	Complete   bool     `json:"complete"`
+	Missing    []string `json:"missing"`
+}
+
+// ResponseTranslations represents the translation status of an exhibition.
+type ResponseTranslations struct {
+	DefaultLocale string               `json:"defaultLocale"`
+	Locales       []LocaleCompleteness `json:"locales"`
+}
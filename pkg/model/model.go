@@ -16,34 +16,39 @@ type UserID struct {
 
 // ExhibitionSection represents the structure of an exhibition section.
 type ExhibitionSection struct {
-	ID           primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
-	SectionType  string             `bson:"sectionType,omitempty" json:"sectionType,omitempty" validate:"required"`
-	ContentType  string             `bson:"contentType,omitempty" json:"contentType,omitempty" `
-	Background   string             `bson:"background,omitempty" json:"background,omitempty"`
-	Title        string             `bson:"title,omitempty" json:"title,omitempty"`
-	Text         string             `bson:"text,omitempty" json:"text,omitempty"`
-	LeftCol      LeftColumn         `bson:"leftCol,omitempty" json:"leftCol,omitempty" `
-	RightCol     RightColumn        `bson:"rightCol,omitempty" json:"rightCol,omitempty" `
-	Images       []string           `bson:"images,omitempty" json:"images,omitempty" `
-	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionId" validate:"required"`
+	ID           primitive.ObjectID            `bson:"_id,omitempty" json:"_id,omitempty"`
+	SectionType  string                        `bson:"sectionType,omitempty" json:"sectionType,omitempty" validate:"required"`
+	ContentType  string                        `bson:"contentType,omitempty" json:"contentType,omitempty" `
+	Background   string                        `bson:"background,omitempty" json:"background,omitempty"`
+	Title        string                        `bson:"title,omitempty" json:"title,omitempty"`
+	Text         string                        `bson:"text,omitempty" json:"text,omitempty"`
+	LeftCol      LeftColumn                    `bson:"leftCol,omitempty" json:"leftCol,omitempty" `
+	RightCol     RightColumn                   `bson:"rightCol,omitempty" json:"rightCol,omitempty" `
+	Images       []string                      `bson:"images,omitempty" json:"images,omitempty" `
+	Translations map[string]SectionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	ExhibitionID primitive.ObjectID            `bson:"exhibitionID" json:"exhibitionId" validate:"required"`
 }
 
 // LeftColumn represents the structure of the left column in an exhibition section.
 type LeftColumn struct {
-	ContentType      string `bson:"contentType,omitempty" json:"contentType,omitempty" `
-	Image            string `bson:"image,omitempty" json:"image,omitempty" `
-	ImageDescription string `bson:"imageDescription,omitempty" json:"imageDescription,omitempty"`
-	Title            string `bson:"title,omitempty" json:"title,omitempty"`
-	Text             string `bson:"text,omitempty" json:"text,omitempty"`
+	ContentType      string              `bson:"contentType,omitempty" json:"contentType,omitempty" `
+	Image            string              `bson:"image,omitempty" json:"image,omitempty" `
+	ImageDescription string              `bson:"imageDescription,omitempty" json:"imageDescription,omitempty"`
+	Title            string              `bson:"title,omitempty" json:"title,omitempty"`
+	Text             string              `bson:"text,omitempty" json:"text,omitempty"`
+	ArtworkID        *primitive.ObjectID `bson:"artworkId,omitempty" json:"artworkId,omitempty"`
+	Artwork          *Artwork            `bson:"-" json:"artwork,omitempty"`
 }
 
 // RightColumn represents the structure of the right column in an exhibition section.
 type RightColumn struct {
-	ContentType      string `bson:"contentType,omitempty" json:"contentType,omitempty" `
-	Image            string `bson:"image,omitempty" json:"image,omitempty" validate:"omitempty"`
-	ImageDescription string `bson:"imageDescription,omitempty" json:"imageDescription,omitempty"`
-	Title            string `bson:"title,omitempty" json:"title,omitempty"`
-	Text             string `bson:"text,omitempty" json:"text,omitempty"`
+	ContentType      string              `bson:"contentType,omitempty" json:"contentType,omitempty" `
+	Image            string              `bson:"image,omitempty" json:"image,omitempty" validate:"omitempty"`
+	ImageDescription string              `bson:"imageDescription,omitempty" json:"imageDescription,omitempty"`
+	Title            string              `bson:"title,omitempty" json:"title,omitempty"`
+	Text             string              `bson:"text,omitempty" json:"text,omitempty"`
+	ArtworkID        *primitive.ObjectID `bson:"artworkId,omitempty" json:"artworkId,omitempty"`
+	Artwork          *Artwork            `bson:"-" json:"artwork,omitempty"`
 }
 
 type Contents struct {
@@ -54,18 +59,24 @@ type Contents struct {
 type Details struct {
 	Img      string     `bson:"img,omitempty" json:"img,omitempty"`
 	Contents []Contents `bson:"contents,omitempty" json:"contents,omitempty"`
+	// Translations holds the contents in other locales than the default locale of the exhibition.
+	Translations map[string][]Contents `bson:"translations,omitempty" json:"translations,omitempty"`
 }
 
 type CenterItem struct {
-	PreviewType string  `bson:"previewType,omitempty" json:"previewType,omitempty"`
-	Src         string  `bson:"src,omitempty" json:"src,omitempty"`
-	Details     Details `bson:"details,omitempty" json:"details,omitempty"`
+	PreviewType string              `bson:"previewType,omitempty" json:"previewType,omitempty"`
+	Src         string              `bson:"src,omitempty" json:"src,omitempty"`
+	Details     Details             `bson:"details,omitempty" json:"details,omitempty"`
+	ArtworkID   *primitive.ObjectID `bson:"artworkId,omitempty" json:"artworkId,omitempty"`
+	Artwork     *Artwork            `bson:"-" json:"artwork,omitempty"`
 }
 
 type LeftRightItem struct {
-	PreviewType string  `bson:"previewType,omitempty" json:"previewType,omitempty"`
-	Src         string  `bson:"src,omitempty" json:"src,omitempty"`
-	Details     Details `bson:"details,omitempty" json:"details,omitempty"`
+	PreviewType string              `bson:"previewType,omitempty" json:"previewType,omitempty"`
+	Src         string              `bson:"src,omitempty" json:"src,omitempty"`
+	Details     Details             `bson:"details,omitempty" json:"details,omitempty"`
+	ArtworkID   *primitive.ObjectID `bson:"artworkId,omitempty" json:"artworkId,omitempty"`
+	Artwork     *Artwork            `bson:"-" json:"artwork,omitempty"`
 }
 
 type Room struct {
@@ -85,26 +96,35 @@ type ExhibitionSectionInfo struct {
 
 // ResponseExhibition represents the structure of the exhibition data.
 type ResponseExhibition struct {
-	ID                    primitive.ObjectID  `bson:"_id,omitempty" json:"_id,omitempty" validate:"required"`
-	ExhibitionName        string              `bson:"exhibitionName" json:"exhibitionName" validate:"required"`
-	ExhibitionDescription string              `bson:"exhibitionDescription,omitempty" json:"exhibitionDescription,omitempty"`
-	ThumbnailImg          string              `bson:"thumbnailImg,omitempty" json:"thumbnailImg,omitempty"`
-	StartDate             string              `bson:"startDate" json:"startDate"`
-	EndDate               string              `bson:"endDate" json:"endDate" validate:"gtfield=StartDate"`
-	IsPublic              bool                `bson:"isPublic" json:"isPublic"`
-	ExhibitionCategories  []string            `bson:"exhibitionCategories,omitempty" json:"exhibitionCategories,omitempty"`
-	ExhibitionTags        []string            `bson:"exhibitionTags,omitempty" json:"exhibitionTags,omitempty"`
-	UserID                UserID              `bson:"userId" json:"userId" validate:"required"`
-	LayoutUsed            string              `bson:"layoutUsed,omitempty" json:"layoutUsed,omitempty" validate:"required"`
-	ExhibitionSectionsID  []string            `bson:"exhibitionSectionsID,omitempty" json:"exhibitionSectionsID,omitempty"`
-	ExhibitionSections    []ExhibitionSection `bson:"exhibitionSections,omitempty" json:"exhibitionSections,omitempty" `
-	VisitedNumber         int                 `bson:"visitedNumber" json:"visitedNumber"`
-	LikeCount             int                 `bson:"likeCount" json:"likeCount"`
-	LikeList              []string            `bson:"likeList,omitempty" json:"likeList,omitempty"`
-	IsLike                bool                `bson:"isLike" json:"isLike"`
-	Room                  []Room              `bson:"rooms,omitempty" json:"rooms,omitempty"`
-	RoomsID               []string            `bson:"roomsID,omitempty" json:"roomsID,omitempty"`
-	Status                string              `bson:"status" json:"status" validate:"required" error:"status is required"`
+	ID                    primitive.ObjectID               `bson:"_id,omitempty" json:"_id,omitempty" validate:"required"`
+	ExhibitionName        string                           `bson:"exhibitionName" json:"exhibitionName" validate:"required"`
+	ExhibitionDescription string                           `bson:"exhibitionDescription,omitempty" json:"exhibitionDescription,omitempty"`
+	ThumbnailImg          string                           `bson:"thumbnailImg,omitempty" json:"thumbnailImg,omitempty"`
+	StartDate             string                           `bson:"startDate" json:"startDate"`
+	EndDate               string                           `bson:"endDate" json:"endDate" validate:"gtfield=StartDate"`
+	IsPublic              bool                             `bson:"isPublic" json:"isPublic"`
+	ExhibitionCategories  []string                         `bson:"exhibitionCategories,omitempty" json:"exhibitionCategories,omitempty"`
+	ExhibitionTags        []string                         `bson:"exhibitionTags,omitempty" json:"exhibitionTags,omitempty"`
+	UserID                UserID                           `bson:"userId" json:"userId" validate:"required"`
+	LayoutUsed            string                           `bson:"layoutUsed,omitempty" json:"layoutUsed,omitempty" validate:"required"`
+	ExhibitionSectionsID  []string                         `bson:"exhibitionSectionsID,omitempty" json:"exhibitionSectionsID,omitempty"`
+	ExhibitionSections    []ExhibitionSection              `bson:"exhibitionSections,omitempty" json:"exhibitionSections,omitempty" `
+	VisitedNumber         int                              `bson:"visitedNumber" json:"visitedNumber"`
+	LikeCount             int                              `bson:"likeCount" json:"likeCount"`
+	LikeList              []string                         `bson:"likeList,omitempty" json:"likeList,omitempty"`
+	IsLike                bool                             `bson:"isLike" json:"isLike"`
+	Room                  []Room                           `bson:"rooms,omitempty" json:"rooms,omitempty"`
+	Timeline              []TimelineEntry                  `bson:"-" json:"timeline,omitempty"`
+	PointsOfInterest      []PointOfInterest                `bson:"-" json:"pointsOfInterest,omitempty"`
+	RoomsID               []string                         `bson:"roomsID,omitempty" json:"roomsID,omitempty"`
+	Status                string                           `bson:"status" json:"status" validate:"required" error:"status is required"`
+	Collaborators         []Collaborator                   `bson:"collaborators,omitempty" json:"collaborators,omitempty"`
+	MediaRights           []MediaRights                    `bson:"mediaRights,omitempty" json:"mediaRights,omitempty"`
+	DefaultLocale         string                           `bson:"defaultLocale,omitempty" json:"defaultLocale,omitempty"`
+	Translations          map[string]ExhibitionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	Venue                 *Venue                           `bson:"venue,omitempty" json:"venue,omitempty"`
+	// Locale is the locale the texts of the exhibition are returned in.
+	Locale string `bson:"-" json:"locale,omitempty"`
 }
 
 // ResponseExhibition represents the structure of the exhibition data.
@@ -147,81 +167,92 @@ type ResponseGetExhibitionSectionId struct {
 
 // RequestCreateExhibition represents the structure of the request to create an exhibition.
 type RequestCreateExhibition struct {
-	ExhibitionName        string   `bson:"exhibitionName" json:"exhibitionName" validate:"required" error:"ExhibitionName is required"`
-	ExhibitionDescription string   `bson:"exhibitionDescription" json:"exhibitionDescription" validate:"required" error:"ExhibitionDescription is required"`
-	ThumbnailImg          string   `bson:"thumbnailImg" json:"thumbnailImg" validate:"required" error:"thumbnailImg is required"`
-	StartDate             string   `bson:"startDate" json:"startDate" validate:"required" error:"StartDate is required"`
-	EndDate               string   `bson:"endDate" json:"endDate" validate:"required" error:"EndDate is required and must be greater than StartDate"`
-	IsPublic              *bool    `bson:"isPublic" json:"isPublic" validate:"required" error:"IsPublic is required"`
-	ExhibitionCategories  []string `bson:"exhibitionCategories" json:"exhibitionCategories" validate:"required" error:"exhibitionCategories is required"`
-	ExhibitionTags        []string `bson:"exhibitionTags,omitempty" json:"exhibitionTags,omitempty"`
-	UserID                UserID   `bson:"userId" json:"userId" validate:"required" error:"UserID is required"`
-	LayoutUsed            string   `bson:"layoutUsed,omitempty" json:"layoutUsed,omitempty" validate:"required" error:"LayoutUsed is required"`
-	ExhibitionSectionsID  []string `bson:"exhibitionSectionsID,omitempty" json:"exhibitionSectionsID,omitempty"`
-	VisitedNumber         int      `bson:"visitedNumber" json:"visitedNumber,omitempty"`
-	LikeCount             int      `bson:"likeCount" json:"likeCount,omitempty"`
-	LikeList              []string `bson:"likeList,omitempty" json:"likeList,omitempty"`
-	IsLike                bool     `bson:"isLike,omitempty" json:"isLike,omitempty"`
-	Room                  []Room   `bson:"rooms,omitempty" json:"rooms,omitempty"`
-	RoomsID               []string `bson:"roomsSectionsID,omitempty" json:"roomsID,omitempty"`
-	Status                string   `bson:"status" json:"status" validate:"required" error:"status is required"`
+	ExhibitionName        string                           `bson:"exhibitionName" json:"exhibitionName" validate:"required" error:"ExhibitionName is required"`
+	ExhibitionDescription string                           `bson:"exhibitionDescription" json:"exhibitionDescription" validate:"required" error:"ExhibitionDescription is required"`
+	ThumbnailImg          string                           `bson:"thumbnailImg" json:"thumbnailImg" validate:"required" error:"thumbnailImg is required"`
+	StartDate             string                           `bson:"startDate" json:"startDate" validate:"required" error:"StartDate is required"`
+	EndDate               string                           `bson:"endDate" json:"endDate" validate:"required" error:"EndDate is required and must be greater than StartDate"`
+	IsPublic              *bool                            `bson:"isPublic" json:"isPublic" validate:"required" error:"IsPublic is required"`
+	ExhibitionCategories  []string                         `bson:"exhibitionCategories" json:"exhibitionCategories" validate:"required" error:"exhibitionCategories is required"`
+	ExhibitionTags        []string                         `bson:"exhibitionTags,omitempty" json:"exhibitionTags,omitempty"`
+	UserID                UserID                           `bson:"userId" json:"userId" validate:"required" error:"UserID is required"`
+	LayoutUsed            string                           `bson:"layoutUsed,omitempty" json:"layoutUsed,omitempty" validate:"required" error:"LayoutUsed is required"`
+	ExhibitionSectionsID  []string                         `bson:"exhibitionSectionsID,omitempty" json:"exhibitionSectionsID,omitempty"`
+	VisitedNumber         int                              `bson:"visitedNumber" json:"visitedNumber,omitempty"`
+	LikeCount             int                              `bson:"likeCount" json:"likeCount,omitempty"`
+	LikeList              []string                         `bson:"likeList,omitempty" json:"likeList,omitempty"`
+	IsLike                bool                             `bson:"isLike,omitempty" json:"isLike,omitempty"`
+	Room                  []Room                           `bson:"rooms,omitempty" json:"rooms,omitempty"`
+	RoomsID               []string                         `bson:"roomsSectionsID,omitempty" json:"roomsID,omitempty"`
+	Status                string                           `bson:"status" json:"status" validate:"required" error:"status is required"`
+	DefaultLocale         string                           `bson:"defaultLocale,omitempty" json:"defaultLocale,omitempty"`
+	Translations          map[string]ExhibitionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	Venue                 *Venue                           `bson:"venue,omitempty" json:"venue,omitempty"`
+	SearchIndex           []SearchEntry                    `bson:"searchIndex,omitempty" json:"-"`
 }
 
 type RequestUpdateExhibition struct {
-	ExhibitionName        string   `bson:"exhibitionName,omitempty" json:"exhibitionName,omitempty"`
-	ExhibitionDescription string   `bson:"exhibitionDescription,omitempty" json:"exhibitionDescription,omitempty"`
-	ThumbnailImg          string   `bson:"thumbnailImg,omitempty" json:"thumbnailImg,omitempty"`
-	StartDate             string   `bson:"startDate,omitempty" json:"startDate,omitempty"`
-	EndDate               string   `bson:"endDate,omitempty" json:"endDate,omitempty"`
-	IsPublic              bool     `bson:"isPublic,omitempty" json:"isPublic,omitempty"`
-	ExhibitionCategories  []string `bson:"exhibitionCategories,omitempty" json:"exhibitionCategories,omitempty"`
-	ExhibitionTags        []string `bson:"exhibitionTags,omitempty" json:"exhibitionTags,omitempty"`
-	UserID                UserID   `bson:"userId,omitempty" json:"userId,omitempty"`
-	LayoutUsed            string   `bson:"layoutUsed,omitempty" json:"layoutUsed,omitempty"`
-	ExhibitionSectionsID  []string `bson:"exhibitionSectionsID,omitempty" json:"exhibitionSectionsID,omitempty"`
-	VisitedNumber         int      `bson:"visitedNumber,omitempty" json:"visitedNumber,omitempty"`
-	LikeList              []string `bson:"likeList,omitempty" json:"likeList,omitempty"`
-	IsLike                bool     `bson:"isLike,omitempty" json:"isLike,omitempty"`
-	Room                  []Room   `bson:"rooms,omitempty" json:"rooms,omitempty"`
-	RoomsID               []string `bson:"roomsID,omitempty" json:"roomsID,omitempty"`
-	Status                string   `bson:"status" json:"status" validate:"required" error:"status is required"`
+	ExhibitionName        string                           `bson:"exhibitionName,omitempty" json:"exhibitionName,omitempty"`
+	ExhibitionDescription string                           `bson:"exhibitionDescription,omitempty" json:"exhibitionDescription,omitempty"`
+	ThumbnailImg          string                           `bson:"thumbnailImg,omitempty" json:"thumbnailImg,omitempty"`
+	StartDate             string                           `bson:"startDate,omitempty" json:"startDate,omitempty"`
+	EndDate               string                           `bson:"endDate,omitempty" json:"endDate,omitempty"`
+	IsPublic              bool                             `bson:"isPublic,omitempty" json:"isPublic,omitempty"`
+	ExhibitionCategories  []string                         `bson:"exhibitionCategories,omitempty" json:"exhibitionCategories,omitempty"`
+	ExhibitionTags        []string                         `bson:"exhibitionTags,omitempty" json:"exhibitionTags,omitempty"`
+	UserID                UserID                           `bson:"userId,omitempty" json:"userId,omitempty"`
+	LayoutUsed            string                           `bson:"layoutUsed,omitempty" json:"layoutUsed,omitempty"`
+	ExhibitionSectionsID  []string                         `bson:"exhibitionSectionsID,omitempty" json:"exhibitionSectionsID,omitempty"`
+	VisitedNumber         int                              `bson:"visitedNumber,omitempty" json:"visitedNumber,omitempty"`
+	LikeList              []string                         `bson:"likeList,omitempty" json:"likeList,omitempty"`
+	IsLike                bool                             `bson:"isLike,omitempty" json:"isLike,omitempty"`
+	Room                  []Room                           `bson:"rooms,omitempty" json:"rooms,omitempty"`
+	RoomsID               []string                         `bson:"roomsID,omitempty" json:"roomsID,omitempty"`
+	Status                string                           `bson:"status" json:"status" validate:"required" error:"status is required"`
+	DefaultLocale         string                           `bson:"defaultLocale,omitempty" json:"defaultLocale,omitempty"`
+	Translations          map[string]ExhibitionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	Venue                 *Venue                           `bson:"venue,omitempty" json:"venue,omitempty"`
+	SearchIndex           []SearchEntry                    `bson:"searchIndex,omitempty" json:"-"`
 }
 
 type RequestCreateExhibitionSection struct {
-	SectionType  string             `bson:"sectionType,omitempty" json:"sectionType,omitempty" validate:"required"`
-	ContentType  string             `bson:"contentType,omitempty" json:"contentType,omitempty" `
-	Background   string             `bson:"background,omitempty" json:"background,omitempty"`
-	Title        string             `bson:"title,omitempty" json:"title,omitempty"`
-	Text         string             `bson:"text,omitempty" json:"text,omitempty"`
-	LeftCol      LeftColumn         `bson:"leftCol,omitempty" json:"leftCol,omitempty" `
-	RightCol     RightColumn        `bson:"rightCol,omitempty" json:"rightCol,omitempty" `
-	Images       []string           `bson:"images,omitempty" json:"images,omitempty" validate:"omitempty"`
-	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionID" validate:"required"`
+	SectionType  string                        `bson:"sectionType,omitempty" json:"sectionType,omitempty" validate:"required"`
+	ContentType  string                        `bson:"contentType,omitempty" json:"contentType,omitempty" `
+	Background   string                        `bson:"background,omitempty" json:"background,omitempty"`
+	Title        string                        `bson:"title,omitempty" json:"title,omitempty"`
+	Text         string                        `bson:"text,omitempty" json:"text,omitempty"`
+	LeftCol      LeftColumn                    `bson:"leftCol,omitempty" json:"leftCol,omitempty" `
+	RightCol     RightColumn                   `bson:"rightCol,omitempty" json:"rightCol,omitempty" `
+	Images       []string                      `bson:"images,omitempty" json:"images,omitempty" validate:"omitempty"`
+	Translations map[string]SectionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	ExhibitionID primitive.ObjectID            `bson:"exhibitionID" json:"exhibitionID" validate:"required"`
 }
 
 type ResponseExhibitionSection struct {
-	ID           primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
-	SectionType  string             `bson:"sectionType,omitempty" json:"sectionType,omitempty" validate:"required"`
-	ContentType  string             `bson:"contentType,omitempty" json:"contentType,omitempty"`
-	Background   string             `bson:"background,omitempty" json:"background,omitempty"`
-	Title        string             `bson:"title,omitempty" json:"title,omitempty" `
-	Text         string             `bson:"text,omitempty" json:"text,omitempty"`
-	LeftCol      LeftColumn         `bson:"leftCol,omitempty" json:"leftCol,omitempty" `
-	RightCol     RightColumn        `bson:"rightCol,omitempty" json:"rightCol,omitempty" `
-	Images       []string           `bson:"images,omitempty" json:"images,omitempty" `
-	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionID" validate:"required"`
+	ID           primitive.ObjectID            `bson:"_id,omitempty" json:"_id,omitempty"`
+	SectionType  string                        `bson:"sectionType,omitempty" json:"sectionType,omitempty" validate:"required"`
+	ContentType  string                        `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	Background   string                        `bson:"background,omitempty" json:"background,omitempty"`
+	Title        string                        `bson:"title,omitempty" json:"title,omitempty" `
+	Text         string                        `bson:"text,omitempty" json:"text,omitempty"`
+	LeftCol      LeftColumn                    `bson:"leftCol,omitempty" json:"leftCol,omitempty" `
+	RightCol     RightColumn                   `bson:"rightCol,omitempty" json:"rightCol,omitempty" `
+	Images       []string                      `bson:"images,omitempty" json:"images,omitempty" `
+	Translations map[string]SectionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	ExhibitionID primitive.ObjectID            `bson:"exhibitionID" json:"exhibitionID" validate:"required"`
 }
 
 type RequestUpdateExhibitionSection struct {
-	SectionType  string             `bson:"sectionType,omitempty" json:"sectionType,omitempty" validate:"required"`
-	ContentType  string             `bson:"contentType,omitempty" json:"contentType,omitempty" `
-	Background   string             `bson:"background,omitempty" json:"background,omitempty"`
-	Title        string             `bson:"title,omitempty" json:"title,omitempty"`
-	Text         string             `bson:"text,omitempty" json:"text,omitempty"`
-	LeftCol      LeftColumn         `bson:"leftCol,omitempty" json:"leftCol,omitempty" `
-	RightCol     RightColumn        `bson:"rightCol,omitempty" json:"rightCol,omitempty" `
-	Images       []string           `bson:"images,omitempty" json:"images,omitempty" `
-	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionID" validate:"required"`
+	SectionType  string                        `bson:"sectionType,omitempty" json:"sectionType,omitempty" validate:"required"`
+	ContentType  string                        `bson:"contentType,omitempty" json:"contentType,omitempty" `
+	Background   string                        `bson:"background,omitempty" json:"background,omitempty"`
+	Title        string                        `bson:"title,omitempty" json:"title,omitempty"`
+	Text         string                        `bson:"text,omitempty" json:"text,omitempty"`
+	LeftCol      LeftColumn                    `bson:"leftCol,omitempty" json:"leftCol,omitempty" `
+	RightCol     RightColumn                   `bson:"rightCol,omitempty" json:"rightCol,omitempty" `
+	Images       []string                      `bson:"images,omitempty" json:"images,omitempty" `
+	Translations map[string]SectionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	ExhibitionID primitive.ObjectID            `bson:"exhibitionID" json:"exhibitionID" validate:"required"`
 }
 
 type RequestCreateExhibitionRoom struct {
@@ -0,0 +1,160 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Categories of the reasons visitors report exhibitions for.
+const (
+	ReportSpam       = "spam"
+	ReportOffensive  = "offensive"
+	ReportHarassment = "harassment"
+	ReportCopyright  = "copyright"
+	ReportMisleading = "misleading"
+	ReportOther      = "other"
+	// ReportScreening is the category of the reports the content screening files on flagged
+	// content. They have no reporter.
+	ReportScreening = "screening"
+)
+
+// Report states.
+const (
+	ReportOpen     = "open"
+	ReportResolved = "resolved"
+)
+
+// Actions moderators take on reported exhibitions.
+const (
+	ModerationDismiss   = "dismiss"
+	ModerationWarn      = "warn"
+	ModerationUnpublish = "unpublish"
+	ModerationBan       = "ban"
+	ModerationUnban     = "unban"
+	ModerationApprove   = "approve"
+)
+
+// Appeal states.
+const (
+	AppealPending  = "pending"
+	AppealGranted  = "granted"
+	AppealRejected = "rejected"
+)
+
+// Report is a visitor's report of an exhibition, or the content screening's. It stays open until a moderator acts on the
+// exhibition.
+type Report struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID  `bson:"exhibitionID" json:"exhibitionId"`
+	ReporterID   primitive.ObjectID  `bson:"reporterID" json:"reporterId"`
+	Category     string              `bson:"category" json:"category" enums:"spam,offensive,harassment,copyright,misleading,other,screening"`
+	Details      string              `bson:"details,omitempty" json:"details,omitempty"`
+	Status       string              `bson:"status" json:"status" enums:"open,resolved"`
+	ActionID     *primitive.ObjectID `bson:"actionID,omitempty" json:"actionId,omitempty"`
+	CreatedAt    time.Time           `bson:"createdAt" json:"createdAt"`
+	ResolvedAt   *time.Time          `bson:"resolvedAt,omitempty" json:"resolvedAt,omitempty"`
+	// Matches are the rules the content of the exhibition matched, in screening reports.
+	Matches []ScreeningMatch `bson:"matches,omitempty" json:"matches,omitempty"`
+}
+
+// RequestReport represents the structure of the request to report an exhibition.
+type RequestReport struct {
+	Category string `json:"category" validate:"required,oneof=spam offensive harassment copyright misleading other"`
+	Details  string `json:"details,omitempty" validate:"max=2000"`
+}
+
+// ModerationAction is an action a moderator took on an exhibition, resolving its open reports.
+type ModerationAction struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionId"`
+	Action       string             `bson:"action" json:"action" enums:"dismiss,warn,unpublish,ban,unban,approve"`
+	Reason       string             `bson:"reason" json:"reason"`
+	ModeratorID  primitive.ObjectID `bson:"moderatorID" json:"moderatorId"`
+	// Reports counts the open reports the action resolved.
+	Reports int64 `bson:"reports" json:"reports"`
+	// AppealID is the appeal the action was taken on, if any.
+	AppealID  *primitive.ObjectID `bson:"appealID,omitempty" json:"appealId,omitempty"`
+	CreatedAt time.Time           `bson:"createdAt" json:"createdAt"`
+}
+
+// RequestModerationAction represents the structure of the request to act on an exhibition.
+type RequestModerationAction struct {
+	Action string `json:"action" validate:"required,oneof=dismiss warn unpublish ban unban approve"`
+	Reason string `json:"reason" validate:"required,max=2000"`
+}
+
+// RequestBanExhibition represents the structure of the request to ban an exhibition.
+type RequestBanExhibition struct {
+	Reason string `json:"reason" validate:"required,max=2000"`
+}
+
+// ModerationOutcome is the last action taken on an exhibition, kept on the exhibition so
+// that its events tell the owner about it.
+type ModerationOutcome struct {
+	ActionID    primitive.ObjectID `bson:"actionID" json:"actionId"`
+	Action      string             `bson:"action" json:"action"`
+	Reason      string             `bson:"reason" json:"reason"`
+	ModeratedAt time.Time          `bson:"moderatedAt" json:"moderatedAt"`
+}
+
+// ModerationCase gathers the open reports of an exhibition in the moderation queue.
+type ModerationCase struct {
+	ExhibitionID    primitive.ObjectID `bson:"_id" json:"exhibitionId"`
+	ExhibitionName  string             `bson:"exhibitionName" json:"exhibitionName"`
+	OwnerID         primitive.ObjectID `bson:"ownerID" json:"ownerId"`
+	Status          string             `bson:"status" json:"status"`
+	IsPublic        bool               `bson:"isPublic" json:"isPublic"`
+	OpenReports     int                `bson:"openReports" json:"openReports"`
+	Categories      []ReportCount      `bson:"categories" json:"categories"`
+	FirstReportedAt time.Time          `bson:"firstReportedAt" json:"firstReportedAt"`
+	LastReportedAt  time.Time          `bson:"lastReportedAt" json:"lastReportedAt"`
+	// Moderation is the last action taken on the exhibition, if any.
+	Moderation *ModerationOutcome `bson:"moderation,omitempty" json:"moderation,omitempty"`
+}
+
+// ReportCount counts the open reports of an exhibition in a category.
+type ReportCount struct {
+	Category string `bson:"category" json:"category"`
+	Count    int    `bson:"count" json:"count"`
+}
+
+// ModerationQuery selects the cases of the moderation queue. Category is empty to include
+// every category.
+type ModerationQuery struct {
+	Category string
+	Limit    int64
+}
+
+// Appeal is an exhibitor's request to reconsider a moderation action.
+type Appeal struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID  `bson:"exhibitionID" json:"exhibitionId"`
+	ActionID     primitive.ObjectID  `bson:"actionID" json:"actionId"`
+	AppellantID  primitive.ObjectID  `bson:"appellantID" json:"appellantId"`
+	Message      string              `bson:"message" json:"message"`
+	Status       string              `bson:"status" json:"status" enums:"pending,granted,rejected"`
+	Decision     string              `bson:"decision,omitempty" json:"decision,omitempty"`
+	DecidedBy    *primitive.ObjectID `bson:"decidedBy,omitempty" json:"decidedBy,omitempty"`
+	CreatedAt    time.Time           `bson:"createdAt" json:"createdAt"`
+	DecidedAt    *time.Time          `bson:"decidedAt,omitempty" json:"decidedAt,omitempty"`
+}
+
+// RequestAppeal represents the structure of the request to appeal a moderation action.
+type RequestAppeal struct {
+	ActionID string `json:"actionId" validate:"required"`
+	Message  string `json:"message" validate:"required,max=2000"`
+}
+
+// RequestAppealDecision represents the structure of the request to decide an appeal.
+type RequestAppealDecision struct {
+	Status string `json:"status" validate:"required,oneof=granted rejected"`
+	Reason string `json:"reason" validate:"required,max=2000"`
+}
+
+// ModerationHistory lists the actions taken on an exhibition, the latest first, and the
+// appeals of its owners in the order they were made.
+type ModerationHistory struct {
+	Actions []ModerationAction `json:"actions"`
+	Appeals []Appeal           `json:"appeals"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OutboxMessage is an event recorded in the same transaction as the write it results from,
+// kept until it is published to the event bus.
+type OutboxMessage struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	Event     Event              `bson:"event" json:"event"`
+	Published bool               `bson:"published" json:"published"`
+	// Attempts counts the failed attempts to publish the event, and LastError tells why the
+	// last one failed.
+	Attempts    int        `bson:"attempts" json:"attempts"`
+	LastError   string     `bson:"lastError,omitempty" json:"lastError,omitempty"`
+	CreatedAt   time.Time  `bson:"createdAt" json:"createdAt"`
+	PublishedAt *time.Time `bson:"publishedAt,omitempty" json:"publishedAt,omitempty"`
+}
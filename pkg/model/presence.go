@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Types of the messages exchanged over a presence connection.
+const (
+	PresenceMove    = "move"
+	PresenceChat    = "chat"
+	PresenceWelcome = "welcome"
+	PresenceRoom    = "room"
+	PresenceJoined  = "joined"
+	PresenceMoved   = "moved"
+	PresenceLeft    = "left"
+	PresenceError   = "error"
+)
+
+// Position is where a visitor stands in the 3D view of a room and which way they face.
+type Position struct {
+	X   float64 `json:"x"`
+	Y   float64 `json:"y"`
+	Z   float64 `json:"z"`
+	Yaw float64 `json:"yaw,omitempty"`
+}
+
+// Visitor is a connected visitor as other visitors see them. A user connected from several
+// devices has one session per connection.
+type Visitor struct {
+	SessionID string `json:"sessionId"`
+	UserID
+	RoomID   *primitive.ObjectID `json:"roomId,omitempty"`
+	Position *Position           `json:"position,omitempty"`
+}
+
+// PresenceMessage is a message sent by a visitor: a move to a room and position, or a chat
+// message to the visitors of their room.
+type PresenceMessage struct {
+	Type     string              `json:"type" enums:"move,chat"`
+	RoomID   *primitive.ObjectID `json:"roomId,omitempty"`
+	Position *Position           `json:"position,omitempty"`
+	Text     string              `json:"text,omitempty"`
+}
+
+// PresenceEvent is a message sent to a visitor. Welcome carries the visitor's own session,
+// room the visitors of a room they entered, joined, moved and left a change of another visitor
+// of their room, and chat a message of another visitor.
+type PresenceEvent struct {
+	Type     string     `json:"type" enums:"welcome,room,joined,moved,left,chat,error"`
+	Visitor  *Visitor   `json:"visitor,omitempty"`
+	Visitors []Visitor  `json:"visitors,omitempty"`
+	Text     string     `json:"text,omitempty"`
+	SentAt   *time.Time `json:"sentAt,omitempty"`
+	Error    string     `json:"error,omitempty"`
+}
+
+// Occupancy counts the visitors connected to an exhibition, in total and per room.
+type Occupancy struct {
+	ExhibitionID primitive.ObjectID `json:"exhibitionId"`
+	Visitors     int                `json:"visitors"`
+	Rooms        []RoomOccupancy    `json:"rooms"`
+}
+
+// RoomOccupancy counts the visitors in a room. Users connected from several devices count once.
+type RoomOccupancy struct {
+	RoomID   primitive.ObjectID `json:"roomId"`
+	Visitors int                `json:"visitors"`
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UserProfile is the latest profile of a user the user service reported. Exhibitions keep a
+// copy of the profiles of their owner and collaborators, which is brought in line with it.
+type UserProfile struct {
+	UserID       primitive.ObjectID `bson:"_id" json:"userId" validate:"required"`
+	FirstName    string             `bson:"firstName" json:"firstName"`
+	LastName     string             `bson:"lastName" json:"lastName"`
+	Username     string             `bson:"username" json:"username"`
+	ProfileImage string             `bson:"profile" json:"profile"`
+	// ChangedAt is when the profile changed in the user service. Changes reported out of
+	// order are ignored when they are older than the stored profile.
+	ChangedAt time.Time `bson:"changedAt" json:"changedAt" validate:"required"`
+	UpdatedAt time.Time `bson:"updatedAt" json:"updatedAt"`
+}
+
+// ProfileSync counts the copies of a profile brought in line with it.
+type ProfileSync struct {
+	UserID primitive.ObjectID `json:"userId"`
+	// Stale is true when the change was older than the stored profile, which was applied
+	// instead.
+	Stale          bool  `json:"stale"`
+	Exhibitions    int64 `json:"exhibitions"`
+	Collaborations int64 `json:"collaborations"`
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Types of quiz questions.
+const (
+	QuestionMultipleChoice = "multipleChoice"
+	QuestionTrueFalse      = "trueFalse"
+	QuestionImagePick      = "imagePick"
+)
+
+// Quiz is a set of questions attached to an exhibition, or to one of its sections or rooms.
+type Quiz struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID  `bson:"exhibitionID" json:"exhibitionId"`
+	SectionID    *primitive.ObjectID `bson:"sectionId,omitempty" json:"sectionId,omitempty"`
+	RoomID       *primitive.ObjectID `bson:"roomId,omitempty" json:"roomId,omitempty"`
+	Title        string              `bson:"title" json:"title"`
+	Description  string              `bson:"description,omitempty" json:"description,omitempty"`
+	Questions    []QuizQuestion      `bson:"questions" json:"questions"`
+	CreatedAt    time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// QuizQuestion is a question of a quiz. Correct holds the positions of the correct options;
+// a multipleChoice question may have several, which must all be picked.
+type QuizQuestion struct {
+	Type        string       `bson:"type" json:"type" enums:"multipleChoice,trueFalse,imagePick"`
+	Prompt      string       `bson:"prompt" json:"prompt"`
+	Image       string       `bson:"image,omitempty" json:"image,omitempty"`
+	Options     []QuizOption `bson:"options" json:"options"`
+	Correct     []int        `bson:"correct" json:"correct"`
+	Points      int          `bson:"points" json:"points"`
+	Explanation string       `bson:"explanation,omitempty" json:"explanation,omitempty"`
+}
+
+// QuizOption is an option of a question. The options of an imagePick question are images.
+type QuizOption struct {
+	Text  string `bson:"text,omitempty" json:"text,omitempty"`
+	Image string `bson:"image,omitempty" json:"image,omitempty"`
+}
+
+// RequestQuiz represents the structure of the request to create or replace a quiz.
+// The options of trueFalse questions may be left out.
+type RequestQuiz struct {
+	ExhibitionID primitive.ObjectID  `json:"exhibitionId" validate:"required"`
+	SectionID    *primitive.ObjectID `json:"sectionId,omitempty"`
+	RoomID       *primitive.ObjectID `json:"roomId,omitempty"`
+	Title        string              `json:"title" validate:"required"`
+	Description  string              `json:"description,omitempty"`
+	Questions    []QuizQuestion      `json:"questions" validate:"required,min=1"`
+}
+
+// QuizQuery selects the quizzes of an exhibition. A section or room narrows the quizzes to
+// those attached to it.
+type QuizQuery struct {
+	ExhibitionID primitive.ObjectID
+	SectionID    *primitive.ObjectID
+	RoomID       *primitive.ObjectID
+}
+
+// ResponseQuiz is a quiz as visitors see it, without the correct answers.
+type ResponseQuiz struct {
+	ID           primitive.ObjectID  `json:"_id"`
+	ExhibitionID primitive.ObjectID  `json:"exhibitionId"`
+	SectionID    *primitive.ObjectID `json:"sectionId,omitempty"`
+	RoomID       *primitive.ObjectID `json:"roomId,omitempty"`
+	Title        string              `json:"title"`
+	Description  string              `json:"description,omitempty"`
+	MaxScore     int                 `json:"maxScore"`
+	Questions    []ResponseQuestion  `json:"questions"`
+}
+
+// ResponseQuestion is a question without its correct answers. Multiple tells whether several
+// options may be picked.
+type ResponseQuestion struct {
+	Index    int          `json:"index"`
+	Type     string       `json:"type"`
+	Prompt   string       `json:"prompt"`
+	Image    string       `json:"image,omitempty"`
+	Options  []QuizOption `json:"options"`
+	Points   int          `json:"points"`
+	Multiple bool         `json:"multiple"`
+}
+
+// QuizAnswer holds the options a visitor picked for a question.
+type QuizAnswer struct {
+	Question int   `bson:"question" json:"question"`
+	Options  []int `bson:"options" json:"options"`
+}
+
+// RequestSubmitQuiz represents the structure of the request to answer a quiz.
+// Questions without an answer score no points.
+type RequestSubmitQuiz struct {
+	Answers []QuizAnswer `json:"answers" validate:"required"`
+}
+
+// QuizAttempt is a scored submission of a quiz by a visitor.
+type QuizAttempt struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	QuizID       primitive.ObjectID `bson:"quizID" json:"quizId"`
+	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionId"`
+	UserID       primitive.ObjectID `bson:"userID" json:"userId"`
+	Answers      []QuizAnswer       `bson:"answers" json:"answers"`
+	Results      []QuestionResult   `bson:"results" json:"results"`
+	Score        int                `bson:"score" json:"score"`
+	MaxScore     int                `bson:"maxScore" json:"maxScore"`
+	SubmittedAt  time.Time          `bson:"submittedAt" json:"submittedAt"`
+}
+
+// QuestionResult is the outcome of one question of an attempt, revealed once it is submitted.
+type QuestionResult struct {
+	Question    int    `bson:"question" json:"question"`
+	Correct     bool   `bson:"correct" json:"correct"`
+	Points      int    `bson:"points" json:"points"`
+	Answer      []int  `bson:"answer" json:"answer"`
+	Explanation string `bson:"explanation,omitempty" json:"explanation,omitempty"`
+}
+
+// QuizTally holds the raw counts aggregated over the attempts of a quiz.
+type QuizTally struct {
+	Attempts     int             `bson:"attempts"`
+	Participants int             `bson:"participants"`
+	AverageScore float64         `bson:"averageScore"`
+	Answered     []QuestionCount `bson:"answered"`
+	Correct      []QuestionCount `bson:"correct"`
+	Options      []OptionTally   `bson:"options"`
+}
+
+// QuestionCount counts the attempts matching a condition for a question.
+type QuestionCount struct {
+	Question int `bson:"_id"`
+	Count    int `bson:"count"`
+}
+
+// OptionTally counts how often an option of a question was picked.
+type OptionTally struct {
+	Question int `bson:"question"`
+	Option   int `bson:"option"`
+	Count    int `bson:"count"`
+}
+
+// QuizStatistics summarizes the attempts of a quiz for the exhibitor.
+type QuizStatistics struct {
+	QuizID       primitive.ObjectID   `json:"quizId"`
+	Attempts     int                  `json:"attempts"`
+	Participants int                  `json:"participants"`
+	AverageScore float64              `json:"averageScore"`
+	MaxScore     int                  `json:"maxScore"`
+	Questions    []QuestionStatistics `json:"questions"`
+}
+
+// QuestionStatistics summarizes the answers to a question. OptionCounts holds how often each
+// option was picked, in the order of the options.
+type QuestionStatistics struct {
+	Question     int     `json:"question"`
+	Prompt       string  `json:"prompt"`
+	Answered     int     `json:"answered"`
+	Correct      int     `json:"correct"`
+	CorrectRate  float64 `json:"correctRate"`
+	OptionCounts []int   `json:"optionCounts"`
+}
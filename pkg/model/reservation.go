@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Statuses of a reservation.
+const (
+	ReservationConfirmed = "confirmed"
+	ReservationCancelled = "cancelled"
+	ReservationCheckedIn = "checkedIn"
+)
+
+// TimeSlot is an entry window of an exhibition with a limited number of seats.
+type TimeSlot struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionId"`
+	StartsAt     time.Time          `bson:"startsAt" json:"startsAt"`
+	EndsAt       time.Time          `bson:"endsAt" json:"endsAt"`
+	Capacity     int                `bson:"capacity" json:"capacity"`
+	// Booked counts the seats of confirmed and checked in reservations.
+	Booked    int `bson:"booked" json:"booked"`
+	Remaining int `bson:"-" json:"remaining"`
+}
+
+// RequestTimeSlot represents the structure of the request to create or change a time slot.
+type RequestTimeSlot struct {
+	StartsAt time.Time `json:"startsAt" validate:"required"`
+	EndsAt   time.Time `json:"endsAt" validate:"required"`
+	Capacity int       `json:"capacity" validate:"required,min=1"`
+}
+
+// Reservation is a booking of seats in a time slot by a user.
+type Reservation struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionId"`
+	SlotID       primitive.ObjectID `bson:"slotID" json:"slotId"`
+	UserID       primitive.ObjectID `bson:"userID" json:"userId"`
+	Seats        int                `bson:"seats" json:"seats"`
+	Status       string             `bson:"status" json:"status" enums:"confirmed,cancelled,checkedIn"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	CancelledAt  *time.Time         `bson:"cancelledAt,omitempty" json:"cancelledAt,omitempty"`
+	CheckedInAt  *time.Time         `bson:"checkedInAt,omitempty" json:"checkedInAt,omitempty"`
+}
+
+// RequestCreateReservation represents the structure of the request to book a time slot.
+// Seats defaults to one.
+type RequestCreateReservation struct {
+	SlotID primitive.ObjectID `json:"slotId" validate:"required"`
+	Seats  int                `json:"seats,omitempty" validate:"omitempty,min=1,max=10"`
+}
+
+// ResponseReservation is a reservation with its time slot and the booking token encoded in
+// its QR code.
+type ResponseReservation struct {
+	Reservation `bson:",inline"`
+	Slot        *TimeSlot `json:"slot,omitempty"`
+	Token       string    `json:"token,omitempty"`
+}
+
+// RequestCheckIn represents the structure of the request to check in a booking token.
+type RequestCheckIn struct {
+	Token string `json:"token" validate:"required"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Licences media can be published under.
+const (
+	LicenseAllRightsReserved = "all-rights-reserved"
+	LicensePublicDomain      = "public-domain"
+	LicenseCC0               = "CC0-1.0"
+	LicenseCCBY              = "CC-BY-4.0"
+	LicenseCCBYSA            = "CC-BY-SA-4.0"
+	LicenseCCBYND            = "CC-BY-ND-4.0"
+	LicenseCCBYNC            = "CC-BY-NC-4.0"
+	LicenseCCBYNCSA          = "CC-BY-NC-SA-4.0"
+	LicenseCCBYNCND          = "CC-BY-NC-ND-4.0"
+)
+
+// MediaRights holds the rights metadata of a media reference used by an exhibition, such as its
+// thumbnail, a section image or the source of a room item.
+type MediaRights struct {
+	Ref          string     `bson:"ref" json:"ref" validate:"required"`
+	RightsHolder string     `bson:"rightsHolder,omitempty" json:"rightsHolder,omitempty"`
+	License      string     `bson:"license" json:"license" validate:"required"`
+	Attribution  string     `bson:"attribution,omitempty" json:"attribution,omitempty"`
+	EmbargoUntil *time.Time `bson:"embargoUntil,omitempty" json:"embargoUntil,omitempty"`
+	// Credit is the credit line shown with the media. It is derived when the exhibition is loaded.
+	Credit string `bson:"-" json:"credit,omitempty"`
+}
+
+// RequestMediaRights represents the structure of the request to replace the media rights of an exhibition.
+type RequestMediaRights struct {
+	MediaRights []MediaRights `json:"mediaRights" validate:"dive"`
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Types of screening rules.
+const (
+	ScreeningWords   = "words"
+	ScreeningPattern = "pattern"
+)
+
+// Severities of screening rules: flagged content is held for review, blocked content is
+// rejected.
+const (
+	ScreeningFlag  = "flag"
+	ScreeningBlock = "block"
+)
+
+// Verdicts of screening content.
+const (
+	ScreeningClean   = "clean"
+	ScreeningFlagged = "flagged"
+	ScreeningBlocked = "blocked"
+)
+
+// ScreeningRule is a list of words or a regular expression that the texts of exhibitions,
+// sections and rooms are screened against when they are written.
+type ScreeningRule struct {
+	ID   primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	Name string             `bson:"name" json:"name"`
+	Type string             `bson:"type" json:"type" enums:"words,pattern"`
+	// Words match whole words, ignoring case, or anywhere in Thai text, which does not separate
+	// words with spaces.
+	Words []string `bson:"words,omitempty" json:"words,omitempty"`
+	// Pattern is a regular expression in RE2 syntax matched against the lower-cased text.
+	Pattern   string    `bson:"pattern,omitempty" json:"pattern,omitempty"`
+	Severity  string    `bson:"severity" json:"severity" enums:"flag,block"`
+	Enabled   bool      `bson:"enabled" json:"enabled"`
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt"`
+	UpdatedAt time.Time `bson:"updatedAt" json:"updatedAt"`
+}
+
+// RequestScreeningRule represents the structure of the request to create or replace a
+// screening rule.
+type RequestScreeningRule struct {
+	Name     string   `json:"name" validate:"required,max=100"`
+	Type     string   `json:"type" validate:"required,oneof=words pattern"`
+	Words    []string `json:"words,omitempty" validate:"required_if=Type words,max=1000,dive,required,max=100"`
+	Pattern  string   `json:"pattern,omitempty" validate:"required_if=Type pattern,max=1000"`
+	Severity string   `json:"severity" validate:"required,oneof=flag block"`
+	Enabled  *bool    `json:"enabled" validate:"required"`
+}
+
+// ScreeningMatch is a rule matched by a text, and the text it matched.
+type ScreeningMatch struct {
+	RuleID   primitive.ObjectID `bson:"ruleID" json:"ruleId"`
+	Rule     string             `bson:"rule" json:"rule"`
+	Severity string             `bson:"severity" json:"severity"`
+	// Field names the text that matched, such as exhibitionName or translations.th.text.
+	Field string `bson:"field" json:"field"`
+	Match string `bson:"match" json:"match"`
+}
+
+// ScreeningResult is the verdict on the texts of a submission and the rules they matched.
+type ScreeningResult struct {
+	Verdict string           `json:"verdict" enums:"clean,flagged,blocked"`
+	Matches []ScreeningMatch `json:"matches"`
+}
+
+// ScreeningHold is what holding an exhibition for review changed, so that the hold can be lifted
+// again when the flagged content is not written after all.
+type ScreeningHold struct {
+	// Status is the status of the exhibition before it was held.
+	Status string
+	// ReportID is the screening report the hold filed, or nil when it added to an open one.
+	ReportID *primitive.ObjectID
+}
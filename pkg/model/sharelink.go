@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ShareLink represents a tokenised preview link to an exhibition.
+// Only hashes of the token and password are stored.
+type ShareLink struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID `bson:"exhibitionID" json:"exhibitionId"`
+	Label        string             `bson:"label,omitempty" json:"label,omitempty"`
+	TokenHash    string             `bson:"tokenHash" json:"-"`
+	PasswordHash string             `bson:"passwordHash,omitempty" json:"-"`
+	HasPassword  bool               `bson:"hasPassword" json:"hasPassword"`
+	CreatedBy    primitive.ObjectID `bson:"createdBy" json:"createdBy"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	ExpiresAt    *time.Time         `bson:"expiresAt,omitempty" json:"expiresAt,omitempty"`
+	RevokedAt    *time.Time         `bson:"revokedAt,omitempty" json:"revokedAt,omitempty"`
+}
+
+// RequestCreateShareLink represents the structure of the request to create a share link.
+type RequestCreateShareLink struct {
+	Label     string     `json:"label,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Password  string     `json:"password,omitempty" validate:"omitempty,min=4"`
+}
+
+// ResponseCreateShareLink returns the share link along with its token, which is only shown once.
+type ResponseCreateShareLink struct {
+	ShareLink
+	Token string `json:"token"`
+}
+
+// ExhibitionViewer describes who requests an exhibition and the share link they present.
+type ExhibitionViewer struct {
+	Actor         *Actor
+	ShareToken    string
+	SharePassword string
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Template scopes.
+const (
+	TemplateScopeSystem = "system"
+	TemplateScopeUser   = "user"
+)
+
+// ExhibitionTemplate represents a reusable exhibition skeleton with its sections and rooms.
+// System templates are curated by admins, user templates are only visible to their owner.
+type ExhibitionTemplate struct {
+	ID                   primitive.ObjectID  `bson:"_id,omitempty" json:"_id,omitempty"`
+	Name                 string              `bson:"name" json:"name"`
+	Description          string              `bson:"description,omitempty" json:"description,omitempty"`
+	ThumbnailImg         string              `bson:"thumbnailImg,omitempty" json:"thumbnailImg,omitempty"`
+	Scope                string              `bson:"scope" json:"scope"`
+	OwnerID              primitive.ObjectID  `bson:"ownerId" json:"ownerId"`
+	LayoutUsed           string              `bson:"layoutUsed" json:"layoutUsed"`
+	ExhibitionCategories []string            `bson:"exhibitionCategories,omitempty" json:"exhibitionCategories,omitempty"`
+	ExhibitionTags       []string            `bson:"exhibitionTags,omitempty" json:"exhibitionTags,omitempty"`
+	Sections             []ExhibitionSection `bson:"sections,omitempty" json:"sections,omitempty"`
+	Rooms                []Room              `bson:"rooms,omitempty" json:"rooms,omitempty"`
+	Timeline             []TimelineEntry     `bson:"timeline,omitempty" json:"timeline,omitempty"`
+	PointsOfInterest     []PointOfInterest   `bson:"pointsOfInterest,omitempty" json:"pointsOfInterest,omitempty"`
+	CreatedAt            time.Time           `bson:"createdAt" json:"createdAt"`
+}
+
+// RequestCloneExhibition represents the structure of the request to clone an exhibition.
+type RequestCloneExhibition struct {
+	ExhibitionName string `json:"exhibitionName,omitempty"`
+}
+
+// RequestCreateTemplate represents the structure of the request to save an exhibition as a template.
+type RequestCreateTemplate struct {
+	ExhibitionID primitive.ObjectID `json:"exhibitionId" validate:"required"`
+	Name         string             `json:"name" validate:"required"`
+	Description  string             `json:"description,omitempty"`
+	Scope        string             `json:"scope,omitempty" validate:"omitempty,oneof=system user"`
+	KeepContent  bool               `json:"keepContent,omitempty"`
+}
+
+// RequestInstantiateTemplate represents the structure of the request to create an exhibition from a template.
+type RequestInstantiateTemplate struct {
+	ExhibitionName        string `json:"exhibitionName" validate:"required"`
+	ExhibitionDescription string `json:"exhibitionDescription,omitempty"`
+	StartDate             string `json:"startDate,omitempty"`
+	EndDate               string `json:"endDate,omitempty"`
+}
@@ -0,0 +1,51 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// TimelineEntry is a dated entry of a timelineLayout exhibition. Dates are ISO 8601 calendar
+// dates of year, month or day precision, such as "1350", "1767-04" or "1932-06-24". Years
+// before the common era are negative, such as "-0500".
+type TimelineEntry struct {
+	ID           primitive.ObjectID            `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID            `bson:"exhibitionID" json:"exhibitionId"`
+	StartDate    string                        `bson:"startDate" json:"startDate"`
+	EndDate      string                        `bson:"endDate,omitempty" json:"endDate,omitempty"`
+	Era          string                        `bson:"era,omitempty" json:"era,omitempty"`
+	Title        string                        `bson:"title" json:"title"`
+	Text         string                        `bson:"text,omitempty" json:"text,omitempty"`
+	Media        []string                      `bson:"media,omitempty" json:"media,omitempty"`
+	Translations map[string]SectionTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+}
+
+// RequestCreateTimelineEntry represents the structure of the request to create a timeline entry.
+type RequestCreateTimelineEntry struct {
+	ExhibitionID primitive.ObjectID `json:"exhibitionId" validate:"required"`
+	RequestUpdateTimelineEntry
+}
+
+// RequestUpdateTimelineEntry represents the structure of the request to replace a timeline entry.
+type RequestUpdateTimelineEntry struct {
+	StartDate    string                        `json:"startDate" validate:"required"`
+	EndDate      string                        `json:"endDate,omitempty"`
+	Era          string                        `json:"era,omitempty"`
+	Title        string                        `json:"title" validate:"required"`
+	Text         string                        `json:"text,omitempty"`
+	Media        []string                      `json:"media,omitempty" validate:"omitempty,dive,required"`
+	Translations map[string]SectionTranslation `json:"translations,omitempty"`
+}
+
+// TimelineEra groups the entries of a timeline that share an era. The era spans from the
+// earliest start to the latest end of its entries.
+type TimelineEra struct {
+	Era       string          `json:"era"`
+	StartDate string          `json:"startDate"`
+	EndDate   string          `json:"endDate,omitempty"`
+	Entries   []TimelineEntry `json:"entries"`
+}
+
+// ResponseTimeline represents the timeline of an exhibition grouped by era.
+type ResponseTimeline struct {
+	ExhibitionID primitive.ObjectID `json:"exhibitionId"`
+	Locale       string             `json:"locale"`
+	Eras         []TimelineEra      `json:"eras"`
+}
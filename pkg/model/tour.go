@@ -0,0 +1,99 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Walls of a room that hold items.
+const (
+	WallLeft   = "left"
+	WallCenter = "center"
+	WallRight  = "right"
+)
+
+// Tour is a curated path through an exhibition, such as a highlights or kids tour.
+type Tour struct {
+	ID           primitive.ObjectID         `bson:"_id,omitempty" json:"_id,omitempty"`
+	ExhibitionID primitive.ObjectID         `bson:"exhibitionID" json:"exhibitionId"`
+	Name         string                     `bson:"name" json:"name"`
+	Description  string                     `bson:"description,omitempty" json:"description,omitempty"`
+	Stops        []TourStop                 `bson:"stops" json:"stops"`
+	Translations map[string]TourTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	CreatedAt    time.Time                  `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time                  `bson:"updatedAt" json:"updatedAt"`
+}
+
+// TourTranslation holds the texts of a tour in another locale.
+type TourTranslation struct {
+	Name        string `bson:"name,omitempty" json:"name,omitempty"`
+	Description string `bson:"description,omitempty" json:"description,omitempty"`
+}
+
+// TourStop is a stop of a tour. It references either a section of a blogLayout exhibition or
+// an item on a wall of a room of a liveLayout exhibition, by its position on the wall.
+type TourStop struct {
+	SectionID *primitive.ObjectID `bson:"sectionId,omitempty" json:"sectionId,omitempty"`
+	RoomID    *primitive.ObjectID `bson:"roomId,omitempty" json:"roomId,omitempty"`
+	Wall      string              `bson:"wall,omitempty" json:"wall,omitempty" enums:"left,center,right"`
+	ItemIndex int                 `bson:"itemIndex,omitempty" json:"itemIndex,omitempty"`
+	Title     string              `bson:"title,omitempty" json:"title,omitempty"`
+	Narration string              `bson:"narration,omitempty" json:"narration,omitempty"`
+	// Audio is the media reference of the narration recording, Duration its length in seconds.
+	Audio        string                         `bson:"audio,omitempty" json:"audio,omitempty"`
+	Duration     int                            `bson:"duration,omitempty" json:"duration,omitempty"`
+	Translations map[string]TourStopTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+}
+
+// TourStopTranslation holds the narration of a stop in another locale.
+type TourStopTranslation struct {
+	Title     string `bson:"title,omitempty" json:"title,omitempty"`
+	Narration string `bson:"narration,omitempty" json:"narration,omitempty"`
+	Audio     string `bson:"audio,omitempty" json:"audio,omitempty"`
+	Duration  int    `bson:"duration,omitempty" json:"duration,omitempty"`
+}
+
+// RequestTour represents the structure of the request to create or replace a tour.
+type RequestTour struct {
+	ExhibitionID primitive.ObjectID         `json:"exhibitionId" validate:"required"`
+	Name         string                     `json:"name" validate:"required"`
+	Description  string                     `json:"description,omitempty"`
+	Stops        []TourStop                 `json:"stops" validate:"required,min=1"`
+	Translations map[string]TourTranslation `json:"translations,omitempty"`
+}
+
+// ResponseTour is a tour ready for playback, with the content of every stop in one locale.
+type ResponseTour struct {
+	ID           primitive.ObjectID `json:"_id"`
+	ExhibitionID primitive.ObjectID `json:"exhibitionId"`
+	Name         string             `json:"name"`
+	Description  string             `json:"description,omitempty"`
+	Locale       string             `json:"locale"`
+	// Duration is the total length of the narration recordings in seconds.
+	Duration int            `json:"duration"`
+	Stops    []ResolvedStop `json:"stops"`
+}
+
+// ResolvedStop is a stop of a tour with the content it references. Stops whose section or
+// room item no longer exists are left out of playback.
+type ResolvedStop struct {
+	Index     int                `json:"index"`
+	Title     string             `json:"title,omitempty"`
+	Narration string             `json:"narration,omitempty"`
+	Audio     string             `json:"audio,omitempty"`
+	Duration  int                `json:"duration,omitempty"`
+	Section   *ExhibitionSection `json:"section,omitempty"`
+	Item      *TourItem          `json:"item,omitempty"`
+}
+
+// TourItem is the room item a stop references.
+type TourItem struct {
+	RoomID      primitive.ObjectID `json:"roomId"`
+	Wall        string             `json:"wall"`
+	ItemIndex   int                `json:"itemIndex"`
+	PreviewType string             `json:"previewType,omitempty"`
+	Src         string             `json:"src,omitempty"`
+	Details     Details            `json:"details"`
+	Artwork     *Artwork           `json:"artwork,omitempty"`
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Statuses of a webhook delivery. Dead deliveries gave up retrying and wait to be redelivered.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryDead      = "dead"
+)
+
+// Webhook is an endpoint of another service that receives the events it subscribed to.
+type Webhook struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	URL         string             `bson:"url" json:"url"`
+	Description string             `bson:"description,omitempty" json:"description,omitempty"`
+	// Events lists the event types the webhook receives; empty receives every type.
+	Events []string `bson:"events,omitempty" json:"events,omitempty"`
+	Active bool     `bson:"active" json:"active"`
+	// Secret signs the payloads; it is only returned when the webhook is created.
+	Secret    string             `bson:"secret" json:"-"`
+	CreatedBy primitive.ObjectID `bson:"createdBy" json:"createdBy"`
+	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// RequestWebhook represents the structure of the request to register or change a webhook.
+// Active defaults to true.
+type RequestWebhook struct {
+	URL         string   `json:"url" validate:"required,url"`
+	Description string   `json:"description,omitempty" validate:"max=500"`
+	Events      []string `json:"events,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+// ResponseCreateWebhook is a registered webhook with the secret its payloads are signed with.
+type ResponseCreateWebhook struct {
+	Webhook `bson:",inline"`
+	Secret  string `json:"secret"`
+}
+
+// WebhookDelivery is an event to deliver to a webhook and the history of the attempts.
+type WebhookDelivery struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	WebhookID primitive.ObjectID `bson:"webhookID" json:"webhookId"`
+	Event     Event              `bson:"event" json:"event"`
+	Status    string             `bson:"status" json:"status" enums:"pending,delivered,dead"`
+	// Failures counts the failed attempts since the delivery was created or redelivered.
+	Failures      int               `bson:"failures" json:"failures"`
+	NextAttemptAt *time.Time        `bson:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty"`
+	Attempts      []DeliveryAttempt `bson:"attempts" json:"attempts"`
+	CreatedAt     time.Time         `bson:"createdAt" json:"createdAt"`
+	DeliveredAt   *time.Time        `bson:"deliveredAt,omitempty" json:"deliveredAt,omitempty"`
+}
+
+// DeliveryAttempt is one request to a webhook. Error is set when no response was received.
+type DeliveryAttempt struct {
+	AttemptedAt time.Time `bson:"attemptedAt" json:"attemptedAt"`
+	StatusCode  int       `bson:"statusCode,omitempty" json:"statusCode,omitempty"`
+	Response    string    `bson:"response,omitempty" json:"response,omitempty"`
+	Error       string    `bson:"error,omitempty" json:"error,omitempty"`
+	// Duration is how long the request took, in milliseconds.
+	Duration int64 `bson:"duration" json:"duration"`
+}
+
+// DeliveryQuery filters the deliveries of webhooks, most recent first.
+type DeliveryQuery struct {
+	WebhookID *primitive.ObjectID
+	Status    string
+	Limit     int64
+}
+
+// WebhookPayload is the body posted to a webhook. ID identifies the delivery and stays the
+// same when it is retried or redelivered.
+type WebhookPayload struct {
+	ID        primitive.ObjectID `json:"id"`
+	Type      string             `json:"type"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Data      Event              `json:"data"`
+}
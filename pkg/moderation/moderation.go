@@ -0,0 +1,62 @@
+package moderation
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Banned is the status of banned exhibitions, Review the status of exhibitions held for review
+// by the content screening, and Active the status they return to when they are unbanned or
+// approved.
+const (
+	Banned = "banned"
+	Review = "review"
+	Active = "created"
+)
+
+// Precondition returns the condition an exhibition must meet for an action to apply to it:
+// only public exhibitions are unpublished, only banned ones are unbanned and only those held
+// for review are approved.
+func Precondition(action string) bson.M {
+	switch action {
+	case model.ModerationUnpublish:
+		return bson.M{"isPublic": true, "status": bson.M{"$ne": Banned}}
+	case model.ModerationBan:
+		return bson.M{"status": bson.M{"$ne": Banned}}
+	case model.ModerationUnban:
+		return bson.M{"status": Banned}
+	case model.ModerationApprove:
+		return bson.M{"status": Review}
+	}
+	return bson.M{}
+}
+
+// Effect returns the fields an action sets on an exhibition. Dismissing reports and warning
+// the owner leave the exhibition as it is. Owners may publish an unpublished exhibition again
+// once they fixed it, but cannot lift a ban or a review.
+func Effect(action string) bson.M {
+	switch action {
+	case model.ModerationUnpublish:
+		return bson.M{"isPublic": false}
+	case model.ModerationBan:
+		return bson.M{"status": Banned}
+	case model.ModerationUnban, model.ModerationApprove:
+		return bson.M{"status": Active}
+	}
+	return bson.M{}
+}
+
+// Appealable reports whether owners may appeal an action.
+func Appealable(action string) bool {
+	return action == model.ModerationWarn || action == model.ModerationUnpublish || action == model.ModerationBan
+}
+
+// Reversal returns the action undoing an action whose appeal was granted, or an empty string
+// when there is nothing for a moderator to undo.
+func Reversal(action string) string {
+	if action == model.ModerationBan {
+		return model.ModerationUnban
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package moderation_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestActions(t *testing.T) {
+	tests := []struct {
+		action       string
+		precondition bson.M
+		effect       bson.M
+		appealable   bool
+		reversal     string
+	}{
+		{model.ModerationDismiss, bson.M{}, bson.M{}, false, ""},
+		{model.ModerationWarn, bson.M{}, bson.M{}, true, ""},
+		{model.ModerationUnpublish, bson.M{"isPublic": true, "status": bson.M{"$ne": "banned"}}, bson.M{"isPublic": false}, true, ""},
+		{model.ModerationBan, bson.M{"status": bson.M{"$ne": "banned"}}, bson.M{"status": "banned"}, true, model.ModerationUnban},
+		{model.ModerationUnban, bson.M{"status": "banned"}, bson.M{"status": "created"}, false, ""},
+		{model.ModerationApprove, bson.M{"status": "review"}, bson.M{"status": "created"}, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			assert.Equal(t, tt.precondition, moderation.Precondition(tt.action))
+			assert.Equal(t, tt.effect, moderation.Effect(tt.action))
+			assert.Equal(t, tt.appealable, moderation.Appealable(tt.action))
+			assert.Equal(t, tt.reversal, moderation.Reversal(tt.action))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package presence
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// writeWait is the time allowed to write a message to the visitor.
+	writeWait = 10 * time.Second
+	// pongWait is the time allowed to read the next pong from the visitor.
+	pongWait = 60 * time.Second
+	// pingPeriod sends pings before the visitor is considered gone.
+	pingPeriod = pongWait * 9 / 10
+	// maxMessageSize is the largest message accepted from a visitor.
+	maxMessageSize = 4096
+)
+
+// Upgrader upgrades presence requests to WebSocket connections. Visitors authenticate with a
+// bearer token rather than cookies, so connections are accepted from any origin like the rest
+// of the API.
+var Upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// Serve relays messages between a WebSocket connection and the session of a visitor until
+// either side goes away. It blocks and closes the connection when done.
+func Serve(conn *websocket.Conn, registry *Registry, client *Client) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		writePump(conn, client)
+	}()
+
+	readPump(conn, client)
+	registry.Leave(client)
+	<-done
+}
+
+// readPump applies the messages of the visitor until the connection fails.
+func readPump(conn *websocket.Conn, client *Client) {
+	conn.SetReadLimit(maxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				log.Printf("Error reading presence message: %v", err)
+			}
+			return
+		}
+
+		var message model.PresenceMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			message = model.PresenceMessage{}
+		}
+		client.Handle(message)
+	}
+}
+
+// writePump sends the queued events to the visitor and pings them. When the session ends, for
+// instance because the visitor fell too far behind, it closes the connection so the read pump
+// stops too.
+func writePump(conn *websocket.Conn, client *Client) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		conn.Close()
+	}()
+
+	for {
+		select {
+		case payload, ok := <-client.Send():
+			conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				// Closing the channel happens after slow is set, so reading it here is safe
+				if client.slow {
+					conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too slow"))
+				}
+				return
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				if !errors.Is(err, websocket.ErrCloseSent) {
+					log.Printf("Error writing presence event: %v", err)
+				}
+				return
+			}
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
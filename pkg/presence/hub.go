@@ -0,0 +1,292 @@
+package presence
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"encoding/json"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// SendBuffer is the number of events queued for a visitor before they count as too slow.
+	SendBuffer = 64
+	// MaxChatLength is the longest chat message in characters.
+	MaxChatLength = 500
+)
+
+// Registry holds the visitors connected to each exhibition. Visitors only receive the events
+// of the room they are in. Nothing is stored; presence ends with the connection.
+type Registry struct {
+	mu   sync.Mutex
+	hubs map[primitive.ObjectID]*hub
+}
+
+// hub holds the visitors of one exhibition.
+type hub struct {
+	mu      sync.Mutex
+	clients map[*Client]struct{}
+}
+
+// Client is the session of a connected visitor.
+type Client struct {
+	visitor      model.Visitor
+	exhibitionID primitive.ObjectID
+	rooms        map[primitive.ObjectID]bool
+	hub          *hub
+	send         chan []byte
+	closed       bool
+	// slow is set before send is closed when the visitor fell too far behind.
+	slow bool
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{hubs: map[primitive.ObjectID]*hub{}}
+}
+
+// Join connects a user to an exhibition whose rooms they may enter and sends them a welcome
+// event with their session.
+func (r *Registry) Join(exhibitionID primitive.ObjectID, user model.UserID, rooms []primitive.ObjectID) *Client {
+	client := &Client{
+		visitor:      model.Visitor{SessionID: primitive.NewObjectID().Hex(), UserID: user},
+		exhibitionID: exhibitionID,
+		rooms:        make(map[primitive.ObjectID]bool, len(rooms)),
+		send:         make(chan []byte, SendBuffer),
+	}
+	for _, roomID := range rooms {
+		client.rooms[roomID] = true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h, ok := r.hubs[exhibitionID]
+	if !ok {
+		h = &hub{clients: map[*Client]struct{}{}}
+		r.hubs[exhibitionID] = h
+	}
+	client.hub = h
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clients[client] = struct{}{}
+	visitor := client.visitor
+	client.deliver(model.PresenceEvent{Type: model.PresenceWelcome, Visitor: &visitor}, false)
+
+	return client
+}
+
+// Leave disconnects a visitor and tells the visitors of their room.
+func (r *Registry) Leave(client *Client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h := client.hub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[client]; !ok {
+		return
+	}
+	delete(h.clients, client)
+	client.close()
+
+	if client.visitor.RoomID != nil {
+		visitor := client.visitor
+		h.broadcast(client, *visitor.RoomID, model.PresenceEvent{Type: model.PresenceLeft, Visitor: &visitor}, false)
+	}
+	if len(h.clients) == 0 {
+		delete(r.hubs, client.exhibitionID)
+	}
+}
+
+// Occupancy counts the visitors of an exhibition, in total and per room.
+func (r *Registry) Occupancy(exhibitionID primitive.ObjectID) model.Occupancy {
+	occupancy := model.Occupancy{ExhibitionID: exhibitionID, Rooms: []model.RoomOccupancy{}}
+
+	r.mu.Lock()
+	h, ok := r.hubs[exhibitionID]
+	r.mu.Unlock()
+	if !ok {
+		return occupancy
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	users := map[primitive.ObjectID]bool{}
+	rooms := map[primitive.ObjectID]map[primitive.ObjectID]bool{}
+	for client := range h.clients {
+		if client.closed {
+			continue
+		}
+		userID := client.visitor.UserID.UserID
+		users[userID] = true
+
+		if roomID := client.visitor.RoomID; roomID != nil {
+			if _, ok := rooms[*roomID]; !ok {
+				rooms[*roomID] = map[primitive.ObjectID]bool{}
+			}
+			rooms[*roomID][userID] = true
+		}
+	}
+
+	occupancy.Visitors = len(users)
+	for roomID, visitors := range rooms {
+		occupancy.Rooms = append(occupancy.Rooms, model.RoomOccupancy{RoomID: roomID, Visitors: len(visitors)})
+	}
+	sort.Slice(occupancy.Rooms, func(i, j int) bool {
+		return occupancy.Rooms[i].RoomID.Hex() < occupancy.Rooms[j].RoomID.Hex()
+	})
+
+	return occupancy
+}
+
+// Send returns the channel of encoded events for the visitor. It is closed when the visitor
+// leaves or falls too far behind.
+func (c *Client) Send() <-chan []byte {
+	return c.send
+}
+
+// Visitor returns the visitor as others see them.
+func (c *Client) Visitor() model.Visitor {
+	c.hub.mu.Lock()
+	defer c.hub.mu.Unlock()
+	return c.visitor
+}
+
+// Handle applies a message of the visitor. Invalid messages are answered with an error event.
+func (c *Client) Handle(message model.PresenceMessage) {
+	h := c.hub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	switch message.Type {
+	case model.PresenceMove:
+		c.move(message)
+	case model.PresenceChat:
+		c.chat(message.Text)
+	default:
+		c.fail("type must be move or chat")
+	}
+}
+
+// move places the visitor in a room. Entering a room is announced to its visitors and answered
+// with the visitors already there; moving within a room only announces the new position.
+func (c *Client) move(message model.PresenceMessage) {
+	if message.RoomID == nil || !c.rooms[*message.RoomID] {
+		c.fail("roomId is not a room of the exhibition")
+		return
+	}
+
+	previous := c.visitor.RoomID
+	roomID := *message.RoomID
+	c.visitor.RoomID = &roomID
+	c.visitor.Position = message.Position
+	visitor := c.visitor
+
+	if previous != nil && *previous == roomID {
+		c.hub.broadcast(c, roomID, model.PresenceEvent{Type: model.PresenceMoved, Visitor: &visitor}, true)
+		return
+	}
+
+	if previous != nil {
+		c.hub.broadcast(c, *previous, model.PresenceEvent{Type: model.PresenceLeft, Visitor: &visitor}, false)
+	}
+	c.hub.broadcast(c, roomID, model.PresenceEvent{Type: model.PresenceJoined, Visitor: &visitor}, false)
+
+	others := []model.Visitor{}
+	for client := range c.hub.clients {
+		if client != c && !client.closed && client.visitor.RoomID != nil && *client.visitor.RoomID == roomID {
+			others = append(others, client.visitor)
+		}
+	}
+	c.deliver(model.PresenceEvent{Type: model.PresenceRoom, Visitor: &visitor, Visitors: others}, false)
+}
+
+// chat relays an ephemeral message to the other visitors of the room.
+func (c *Client) chat(text string) {
+	text = strings.TrimSpace(text)
+	switch {
+	case c.visitor.RoomID == nil:
+		c.fail("enter a room before chatting")
+		return
+	case text == "":
+		c.fail("text is required")
+		return
+	case utf8.RuneCountInString(text) > MaxChatLength:
+		c.fail("text is too long")
+		return
+	}
+
+	now := time.Now()
+	visitor := c.visitor
+	c.hub.broadcast(c, *visitor.RoomID, model.PresenceEvent{Type: model.PresenceChat, Visitor: &visitor, Text: text, SentAt: &now}, false)
+}
+
+func (c *Client) fail(problem string) {
+	c.deliver(model.PresenceEvent{Type: model.PresenceError, Error: problem}, false)
+}
+
+// broadcast delivers an event to the visitors of a room other than the sender. The hub lock
+// must be held.
+func (h *hub) broadcast(sender *Client, roomID primitive.ObjectID, event model.PresenceEvent, droppable bool) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Error encoding presence event: %v", err)
+		return
+	}
+
+	for client := range h.clients {
+		if client != sender && client.visitor.RoomID != nil && *client.visitor.RoomID == roomID {
+			client.queue(payload, droppable)
+		}
+	}
+}
+
+// deliver sends an event to the visitor. The hub lock must be held.
+func (c *Client) deliver(event model.PresenceEvent, droppable bool) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Error encoding presence event: %v", err)
+		return
+	}
+	c.queue(payload, droppable)
+}
+
+// queue adds an encoded event to the send buffer without blocking the sender. Position updates
+// are dropped once the buffer is three quarters full, since a later one supersedes them. A
+// visitor whose buffer is full is disconnected. The hub lock must be held.
+func (c *Client) queue(payload []byte, droppable bool) {
+	if c.closed {
+		return
+	}
+	if droppable && len(c.send) >= cap(c.send)*3/4 {
+		return
+	}
+
+	select {
+	case c.send <- payload:
+	default:
+		c.slow = true
+		c.close()
+	}
+}
+
+// close ends the send channel once. The hub lock must be held.
+func (c *Client) close() {
+	if !c.closed {
+		c.closed = true
+		close(c.send)
+	}
+}
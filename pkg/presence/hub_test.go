@@ -0,0 +1,156 @@
+package presence_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/presence"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	exhibitionID = primitive.NewObjectID()
+	lobby        = primitive.NewObjectID()
+	gallery      = primitive.NewObjectID()
+)
+
+func join(registry *presence.Registry, firstName string) *presence.Client {
+	user := model.UserID{UserID: primitive.NewObjectID(), FirstName: firstName}
+	client := registry.Join(exhibitionID, user, []primitive.ObjectID{lobby, gallery})
+	return client
+}
+
+// events drains the events queued for a client.
+func events(t *testing.T, client *presence.Client) []model.PresenceEvent {
+	var result []model.PresenceEvent
+	for {
+		select {
+		case payload, ok := <-client.Send():
+			if !ok {
+				return result
+			}
+			var event model.PresenceEvent
+			require.NoError(t, json.Unmarshal(payload, &event))
+			result = append(result, event)
+		default:
+			return result
+		}
+	}
+}
+
+func types(events []model.PresenceEvent) []string {
+	result := make([]string, len(events))
+	for i, event := range events {
+		result[i] = event.Type
+	}
+	return result
+}
+
+func TestRoomFanOut(t *testing.T) {
+	registry := presence.NewRegistry()
+	ada := join(registry, "Ada")
+	bo := join(registry, "Bo")
+	cy := join(registry, "Cy")
+	assert.Equal(t, []string{model.PresenceWelcome}, types(events(t, ada)))
+	events(t, bo)
+	events(t, cy)
+
+	ada.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &lobby})
+	bo.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &lobby, Position: &model.Position{X: 1}})
+	cy.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &gallery})
+
+	// Bo entered the lobby after Ada and sees her there
+	boEvents := events(t, bo)
+	require.Equal(t, []string{model.PresenceRoom}, types(boEvents))
+	require.Len(t, boEvents[0].Visitors, 1)
+	assert.Equal(t, "Ada", boEvents[0].Visitors[0].FirstName)
+	assert.Equal(t, []string{model.PresenceRoom, model.PresenceJoined}, types(events(t, ada)))
+
+	bo.Handle(model.PresenceMessage{Type: model.PresenceChat, Text: "  hello  "})
+	adaEvents := events(t, ada)
+	require.Equal(t, []string{model.PresenceChat}, types(adaEvents))
+	assert.Equal(t, "hello", adaEvents[0].Text)
+	assert.Equal(t, "Bo", adaEvents[0].Visitor.FirstName)
+
+	// Cy is in another room and hears nothing of the lobby
+	assert.Equal(t, []string{model.PresenceRoom}, types(events(t, cy)))
+
+	bo.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &gallery})
+	assert.Equal(t, []string{model.PresenceLeft}, types(events(t, ada)))
+	assert.Equal(t, []string{model.PresenceJoined}, types(events(t, cy)))
+
+	events(t, bo)
+	registry.Leave(bo)
+	assert.Equal(t, []string{model.PresenceLeft}, types(events(t, cy)))
+	_, open := <-bo.Send()
+	assert.False(t, open)
+}
+
+func TestInvalidMessages(t *testing.T) {
+	registry := presence.NewRegistry()
+	ada := join(registry, "Ada")
+	events(t, ada)
+
+	elsewhere := primitive.NewObjectID()
+	ada.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &elsewhere})
+	ada.Handle(model.PresenceMessage{Type: model.PresenceChat, Text: "hello"})
+	ada.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &lobby})
+	ada.Handle(model.PresenceMessage{Type: model.PresenceChat, Text: strings.Repeat("a", presence.MaxChatLength+1)})
+	ada.Handle(model.PresenceMessage{Type: "dance"})
+
+	assert.Equal(t, []string{
+		model.PresenceError, model.PresenceError, model.PresenceRoom, model.PresenceError, model.PresenceError,
+	}, types(events(t, ada)))
+}
+
+func TestBackpressure(t *testing.T) {
+	registry := presence.NewRegistry()
+	ada := join(registry, "Ada")
+	bo := join(registry, "Bo")
+	ada.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &lobby})
+	bo.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &lobby})
+
+	// Bo never reads: position updates stop filling his buffer before it is full
+	for i := 0; i < presence.SendBuffer*2; i++ {
+		ada.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &lobby, Position: &model.Position{X: float64(i)}})
+	}
+	assert.Less(t, len(bo.Send()), presence.SendBuffer)
+
+	// Chat is never dropped, so a full buffer disconnects him
+	for i := 0; i < presence.SendBuffer; i++ {
+		ada.Handle(model.PresenceMessage{Type: model.PresenceChat, Text: "hello"})
+	}
+	received := 0
+	for range bo.Send() {
+		received++
+	}
+	assert.Equal(t, presence.SendBuffer, received)
+}
+
+func TestOccupancy(t *testing.T) {
+	registry := presence.NewRegistry()
+	assert.Zero(t, registry.Occupancy(exhibitionID).Visitors)
+
+	ada := join(registry, "Ada")
+	bo := join(registry, "Bo")
+	// Ada on a second device counts once
+	adaPhone := registry.Join(exhibitionID, ada.Visitor().UserID, []primitive.ObjectID{lobby})
+	for _, client := range []*presence.Client{ada, bo, adaPhone} {
+		client.Handle(model.PresenceMessage{Type: model.PresenceMove, RoomID: &lobby})
+	}
+
+	occupancy := registry.Occupancy(exhibitionID)
+	assert.Equal(t, 2, occupancy.Visitors)
+	require.Len(t, occupancy.Rooms, 1)
+	assert.Equal(t, lobby, occupancy.Rooms[0].RoomID)
+	assert.Equal(t, 2, occupancy.Rooms[0].Visitors)
+
+	for _, client := range []*presence.Client{ada, bo, adaPhone} {
+		registry.Leave(client)
+	}
+	assert.Zero(t, registry.Occupancy(exhibitionID).Visitors)
+}
@@ -0,0 +1,40 @@
+package profile
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Fields returns the fields of an embedded copy of a profile with the values of the profile.
+func Fields(profile *model.UserProfile) bson.D {
+	return bson.D{
+		{Key: "firstName", Value: profile.FirstName},
+		{Key: "lastName", Value: profile.LastName},
+		{Key: "username", Value: profile.Username},
+		{Key: "profile", Value: profile.ProfileImage},
+	}
+}
+
+// Drifted returns the condition matching the copies of a profile at prefix that differ from
+// it. An empty value matches copies where the field is missing or empty.
+func Drifted(prefix string, profile *model.UserProfile) bson.M {
+	conditions := bson.A{}
+	for _, field := range Fields(profile) {
+		if field.Value == "" {
+			conditions = append(conditions, bson.M{prefix + field.Key: bson.M{"$nin": bson.A{nil, ""}}})
+			continue
+		}
+		conditions = append(conditions, bson.M{prefix + field.Key: bson.M{"$ne": field.Value}})
+	}
+	return bson.M{"$or": conditions}
+}
+
+// Set returns the update bringing the copy of a profile at prefix in line with it.
+func Set(prefix string, profile *model.UserProfile) bson.M {
+	set := bson.M{}
+	for _, field := range Fields(profile) {
+		set[prefix+field.Key] = field.Value
+	}
+	return bson.M{"$set": set}
+}
@@ -0,0 +1,33 @@
+package profile_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/profile"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestDrifted(t *testing.T) {
+	user := &model.UserProfile{UserID: primitive.NewObjectID(), FirstName: "Ada", LastName: "Lovelace", Username: "ada"}
+
+	assert.Equal(t, bson.M{"$or": bson.A{
+		bson.M{"userId.firstName": bson.M{"$ne": "Ada"}},
+		bson.M{"userId.lastName": bson.M{"$ne": "Lovelace"}},
+		bson.M{"userId.username": bson.M{"$ne": "ada"}},
+		bson.M{"userId.profile": bson.M{"$nin": bson.A{nil, ""}}},
+	}}, profile.Drifted("userId.", user))
+}
+
+func TestSet(t *testing.T) {
+	user := &model.UserProfile{FirstName: "Ada", LastName: "Lovelace", Username: "ada", ProfileImage: "https://cdn/ada.png"}
+
+	assert.Equal(t, bson.M{"$set": bson.M{
+		"collaborators.$[c].firstName": "Ada",
+		"collaborators.$[c].lastName":  "Lovelace",
+		"collaborators.$[c].username":  "ada",
+		"collaborators.$[c].profile":   "https://cdn/ada.png",
+	}}, profile.Set("collaborators.$[c].", user))
+}
@@ -0,0 +1,311 @@
+package quiz
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ValidationError lists the problems of a quiz definition.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid quiz: " + strings.Join(e.Problems, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return cerr.ErrInvalidQuiz
+}
+
+// AnswerError lists the problems of the answers submitted for a quiz.
+type AnswerError struct {
+	Problems []string
+}
+
+func (e *AnswerError) Error() string {
+	return "invalid answers: " + strings.Join(e.Problems, "; ")
+}
+
+func (e *AnswerError) Unwrap() error {
+	return cerr.ErrInvalidQuizAnswers
+}
+
+// Validate checks a quiz against the exhibition it belongs to and fills in defaults: trueFalse
+// questions without options get True and False, and questions without points are worth one.
+func Validate(quiz *model.Quiz, exhibition *model.ResponseExhibition) error {
+	var problems []string
+	addf := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	switch {
+	case quiz.SectionID != nil && quiz.RoomID != nil:
+		addf("a quiz is attached to a section or a room, not both")
+	case quiz.SectionID != nil:
+		if !hasSection(exhibition, *quiz.SectionID) {
+			addf("sectionId is not a section of the exhibition")
+		}
+	case quiz.RoomID != nil:
+		if !hasRoom(exhibition, *quiz.RoomID) {
+			addf("roomId is not a room of the exhibition")
+		}
+	}
+
+	for i := range quiz.Questions {
+		question := &quiz.Questions[i]
+		if question.Type == model.QuestionTrueFalse && len(question.Options) == 0 {
+			question.Options = []model.QuizOption{{Text: "True"}, {Text: "False"}}
+		}
+		if question.Points == 0 {
+			question.Points = 1
+		}
+
+		if strings.TrimSpace(question.Prompt) == "" {
+			addf("questions[%d].prompt is required", i)
+		}
+		if question.Points < 0 {
+			addf("questions[%d].points must not be negative", i)
+		}
+
+		switch question.Type {
+		case model.QuestionMultipleChoice:
+			if len(question.Options) < 2 {
+				addf("questions[%d] needs at least two options", i)
+			}
+			if len(question.Correct) == 0 {
+				addf("questions[%d] needs at least one correct option", i)
+			}
+		case model.QuestionTrueFalse:
+			if len(question.Options) != 2 {
+				addf("questions[%d] needs exactly two options", i)
+			}
+			if len(question.Correct) != 1 {
+				addf("questions[%d] needs exactly one correct option", i)
+			}
+		case model.QuestionImagePick:
+			if len(question.Options) < 2 {
+				addf("questions[%d] needs at least two options", i)
+			}
+			if len(question.Correct) != 1 {
+				addf("questions[%d] needs exactly one correct option", i)
+			}
+		default:
+			addf("questions[%d].type must be %s, %s or %s", i, model.QuestionMultipleChoice, model.QuestionTrueFalse, model.QuestionImagePick)
+			continue
+		}
+
+		for j, option := range question.Options {
+			if question.Type == model.QuestionImagePick && option.Image == "" {
+				addf("questions[%d].options[%d].image is required", i, j)
+			} else if option.Text == "" && option.Image == "" {
+				addf("questions[%d].options[%d] needs a text or an image", i, j)
+			}
+		}
+
+		seen := map[int]bool{}
+		for _, option := range question.Correct {
+			if option < 0 || option >= len(question.Options) {
+				addf("questions[%d].correct has no option %d", i, option)
+			} else if seen[option] {
+				addf("questions[%d].correct lists option %d twice", i, option)
+			}
+			seen[option] = true
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// Public returns a quiz as visitors see it, without the correct answers.
+func Public(quiz *model.Quiz) model.ResponseQuiz {
+	response := model.ResponseQuiz{
+		ID:           quiz.ID,
+		ExhibitionID: quiz.ExhibitionID,
+		SectionID:    quiz.SectionID,
+		RoomID:       quiz.RoomID,
+		Title:        quiz.Title,
+		Description:  quiz.Description,
+		MaxScore:     MaxScore(quiz),
+		Questions:    make([]model.ResponseQuestion, len(quiz.Questions)),
+	}
+
+	for i, question := range quiz.Questions {
+		response.Questions[i] = model.ResponseQuestion{
+			Index:    i,
+			Type:     question.Type,
+			Prompt:   question.Prompt,
+			Image:    question.Image,
+			Options:  question.Options,
+			Points:   question.Points,
+			Multiple: len(question.Correct) > 1,
+		}
+	}
+
+	return response
+}
+
+// MaxScore returns the score of a quiz answered without mistakes.
+func MaxScore(quiz *model.Quiz) int {
+	total := 0
+	for _, question := range quiz.Questions {
+		total += question.Points
+	}
+	return total
+}
+
+// Score checks the answers to a quiz and fills in the results and score of the attempt. A
+// question is answered correctly when exactly its correct options are picked; there is no
+// partial credit.
+func Score(quiz *model.Quiz, attempt *model.QuizAttempt) error {
+	var problems []string
+	picked := make(map[int][]int, len(attempt.Answers))
+
+	for i, answer := range attempt.Answers {
+		if answer.Question < 0 || answer.Question >= len(quiz.Questions) {
+			problems = append(problems, fmt.Sprintf("answers[%d]: the quiz has no question %d", i, answer.Question))
+			continue
+		}
+		if _, ok := picked[answer.Question]; ok {
+			problems = append(problems, fmt.Sprintf("answers[%d]: question %d is answered twice", i, answer.Question))
+			continue
+		}
+
+		question := quiz.Questions[answer.Question]
+		options := normalize(answer.Options)
+		for _, option := range options {
+			if option < 0 || option >= len(question.Options) {
+				problems = append(problems, fmt.Sprintf("answers[%d]: question %d has no option %d", i, answer.Question, option))
+			}
+		}
+		if len(options) > 1 && len(question.Correct) == 1 {
+			problems = append(problems, fmt.Sprintf("answers[%d]: question %d takes a single option", i, answer.Question))
+		}
+		picked[answer.Question] = options
+	}
+
+	if len(problems) > 0 {
+		return &AnswerError{Problems: problems}
+	}
+
+	attempt.Results = make([]model.QuestionResult, len(quiz.Questions))
+	attempt.Score = 0
+	attempt.MaxScore = MaxScore(quiz)
+	for i, question := range quiz.Questions {
+		correct := normalize(question.Correct)
+		result := model.QuestionResult{
+			Question:    i,
+			Correct:     equal(picked[i], correct),
+			Answer:      correct,
+			Explanation: question.Explanation,
+		}
+		if result.Correct {
+			result.Points = question.Points
+			attempt.Score += question.Points
+		}
+		attempt.Results[i] = result
+	}
+
+	return nil
+}
+
+// Statistics combines the counts aggregated over the attempts of a quiz with its questions.
+// Counts of questions or options the quiz no longer has are ignored.
+func Statistics(quiz *model.Quiz, tally *model.QuizTally) model.QuizStatistics {
+	statistics := model.QuizStatistics{
+		QuizID:       quiz.ID,
+		Attempts:     tally.Attempts,
+		Participants: tally.Participants,
+		AverageScore: tally.AverageScore,
+		MaxScore:     MaxScore(quiz),
+		Questions:    make([]model.QuestionStatistics, len(quiz.Questions)),
+	}
+
+	for i, question := range quiz.Questions {
+		statistics.Questions[i] = model.QuestionStatistics{
+			Question:     i,
+			Prompt:       question.Prompt,
+			OptionCounts: make([]int, len(question.Options)),
+		}
+	}
+
+	valid := func(question int) bool {
+		return question >= 0 && question < len(statistics.Questions)
+	}
+	for _, count := range tally.Answered {
+		if valid(count.Question) {
+			statistics.Questions[count.Question].Answered = count.Count
+		}
+	}
+	for _, count := range tally.Correct {
+		if valid(count.Question) {
+			statistics.Questions[count.Question].Correct = count.Count
+		}
+	}
+	for _, count := range tally.Options {
+		if valid(count.Question) && count.Option >= 0 && count.Option < len(statistics.Questions[count.Question].OptionCounts) {
+			statistics.Questions[count.Question].OptionCounts[count.Option] = count.Count
+		}
+	}
+
+	for i := range statistics.Questions {
+		question := &statistics.Questions[i]
+		if question.Answered > 0 {
+			question.CorrectRate = float64(question.Correct) / float64(question.Answered)
+		}
+	}
+
+	return statistics
+}
+
+func hasSection(exhibition *model.ResponseExhibition, sectionID primitive.ObjectID) bool {
+	for _, section := range exhibition.ExhibitionSections {
+		if section.ID == sectionID {
+			return true
+		}
+	}
+	return false
+}
+
+func hasRoom(exhibition *model.ResponseExhibition, roomID primitive.ObjectID) bool {
+	for _, room := range exhibition.Room {
+		if room.ID == roomID {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize sorts options and drops duplicates, so answers compare as sets.
+func normalize(options []int) []int {
+	result := make([]int, 0, len(options))
+	seen := map[int]bool{}
+	for _, option := range options {
+		if !seen[option] {
+			seen[option] = true
+			result = append(result, option)
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
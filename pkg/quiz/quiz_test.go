@@ -0,0 +1,118 @@
+package quiz_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/quiz"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var roomID = primitive.NewObjectID()
+
+func exhibition() *model.ResponseExhibition {
+	return &model.ResponseExhibition{Room: []model.Room{{ID: roomID}}}
+}
+
+func subject() *model.Quiz {
+	return &model.Quiz{
+		RoomID: &roomID,
+		Questions: []model.QuizQuestion{
+			{Type: model.QuestionTrueFalse, Prompt: "Celadon is green", Correct: []int{0}},
+			{Type: model.QuestionMultipleChoice, Prompt: "Which are kilns?", Points: 2,
+				Options: []model.QuizOption{{Text: "Sawankhalok"}, {Text: "Sukhothai"}, {Text: "Ayutthaya"}}, Correct: []int{1, 0}},
+			{Type: model.QuestionImagePick, Prompt: "Pick the jar", Explanation: "It has four lugs",
+				Options: []model.QuizOption{{Image: "/uploads/jar.jpg"}, {Image: "/uploads/bowl.jpg"}}, Correct: []int{0}},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := subject()
+	require.NoError(t, quiz.Validate(valid, exhibition()))
+	assert.Len(t, valid.Questions[0].Options, 2)
+	assert.Equal(t, 1, valid.Questions[0].Points)
+	assert.Equal(t, 4, quiz.MaxScore(valid))
+
+	otherRoom := primitive.NewObjectID()
+	invalid := &model.Quiz{
+		RoomID: &otherRoom,
+		Questions: []model.QuizQuestion{
+			{Type: "essay", Prompt: "Why?"},
+			{Type: model.QuestionTrueFalse, Prompt: "Both?", Correct: []int{0, 1}},
+			{Type: model.QuestionImagePick, Prompt: "Pick", Options: []model.QuizOption{{Text: "jar"}, {Image: "/b.jpg"}}, Correct: []int{2}},
+		},
+	}
+	err := quiz.Validate(invalid, exhibition())
+	assert.ErrorIs(t, err, cerr.ErrInvalidQuiz)
+
+	var problems *quiz.ValidationError
+	require.ErrorAs(t, err, &problems)
+	assert.Len(t, problems.Problems, 5)
+}
+
+func TestPublic(t *testing.T) {
+	valid := subject()
+	require.NoError(t, quiz.Validate(valid, exhibition()))
+
+	public := quiz.Public(valid)
+	assert.Equal(t, 4, public.MaxScore)
+	require.Len(t, public.Questions, 3)
+	assert.False(t, public.Questions[0].Multiple)
+	assert.True(t, public.Questions[1].Multiple)
+}
+
+func TestScore(t *testing.T) {
+	valid := subject()
+	require.NoError(t, quiz.Validate(valid, exhibition()))
+
+	attempt := &model.QuizAttempt{Answers: []model.QuizAnswer{
+		{Question: 0, Options: []int{0}},
+		{Question: 1, Options: []int{0, 1, 0}},
+	}}
+	require.NoError(t, quiz.Score(valid, attempt))
+	assert.Equal(t, 3, attempt.Score)
+	assert.Equal(t, 4, attempt.MaxScore)
+	require.Len(t, attempt.Results, 3)
+	assert.True(t, attempt.Results[1].Correct)
+	assert.False(t, attempt.Results[2].Correct)
+	assert.Equal(t, []int{0}, attempt.Results[2].Answer)
+	assert.Equal(t, "It has four lugs", attempt.Results[2].Explanation)
+
+	invalid := &model.QuizAttempt{Answers: []model.QuizAnswer{
+		{Question: 3, Options: []int{0}},
+		{Question: 0, Options: []int{0, 1}},
+		{Question: 0, Options: []int{0}},
+		{Question: 2, Options: []int{5}},
+	}}
+	err := quiz.Score(valid, invalid)
+	assert.ErrorIs(t, err, cerr.ErrInvalidQuizAnswers)
+
+	var problems *quiz.AnswerError
+	require.ErrorAs(t, err, &problems)
+	assert.Len(t, problems.Problems, 4)
+}
+
+func TestStatistics(t *testing.T) {
+	valid := subject()
+	require.NoError(t, quiz.Validate(valid, exhibition()))
+
+	statistics := quiz.Statistics(valid, &model.QuizTally{
+		Attempts:     4,
+		Participants: 3,
+		AverageScore: 2.5,
+		Answered:     []model.QuestionCount{{Question: 0, Count: 4}, {Question: 7, Count: 1}},
+		Correct:      []model.QuestionCount{{Question: 0, Count: 3}},
+		Options:      []model.OptionTally{{Question: 0, Option: 0, Count: 3}, {Question: 0, Option: 1, Count: 1}, {Question: 2, Option: 9, Count: 1}},
+	})
+
+	assert.Equal(t, 4, statistics.MaxScore)
+	require.Len(t, statistics.Questions, 3)
+	assert.Equal(t, 0.75, statistics.Questions[0].CorrectRate)
+	assert.Equal(t, []int{3, 1}, statistics.Questions[0].OptionCounts)
+	assert.Equal(t, []int{0, 0}, statistics.Questions[2].OptionCounts)
+	assert.Zero(t, statistics.Questions[1].CorrectRate)
+}
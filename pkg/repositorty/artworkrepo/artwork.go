@@ -0,0 +1,223 @@
+package artworkrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type IArtworkRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreateArtwork(ctx context.Context, artwork *model.Artwork) (*primitive.ObjectID, error)
+	GetArtworks(ctx context.Context, ownerID primitive.ObjectID, search string) ([]model.Artwork, error)
+	GetArtworkByID(ctx context.Context, artworkID string) (*model.Artwork, error)
+	GetArtworksByIDs(ctx context.Context, artworkIDs []primitive.ObjectID) ([]model.Artwork, error)
+	UpdateArtwork(ctx context.Context, artwork *model.Artwork) error
+	DeleteArtwork(ctx context.Context, artworkID string) error
+	IsArtworkReferenced(ctx context.Context, artworkID string) (bool, error)
+}
+
+// ArtworkRepository is the MongoDB implementation of the Repository interface.
+// It also reads sections and rooms to find where artworks are referenced.
+type ArtworkRepository struct {
+	Collection        *mongo.Collection
+	SectionCollection *mongo.Collection
+	RoomCollection    *mongo.Collection
+}
+
+// NewArtworkRepository creates a new instance of ArtworkRepository.
+func NewArtworkRepository(client *mongo.Client, databaseName string) *ArtworkRepository {
+	db := client.Database(databaseName)
+	return &ArtworkRepository{
+		Collection:        db.Collection("artworks"),
+		SectionCollection: db.Collection("exhibitionSections"),
+		RoomCollection:    db.Collection("exhibitionRooms"),
+	}
+}
+
+// EnsureIndexes creates the indexes of the catalogue. Accession numbers are unique per owner.
+func (r *ArtworkRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "ownerId", Value: 1}, {Key: "accessionNumber", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"accessionNumber": bson.M{"$exists": true}}),
+		},
+		{Keys: bson.D{{Key: "ownerId", Value: 1}, {Key: "title", Value: 1}}},
+	})
+	return err
+}
+
+func (r *ArtworkRepository) CreateArtwork(ctx context.Context, artwork *model.Artwork) (*primitive.ObjectID, error) {
+	result, err := r.Collection.InsertOne(ctx, artwork)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, cerr.ErrArtworkExists
+		}
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted artwork ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetArtworks retrieves the artworks of an owner sorted by title. A search matches the title,
+// creator or accession number, ignoring case.
+func (r *ArtworkRepository) GetArtworks(ctx context.Context, ownerID primitive.ObjectID, search string) ([]model.Artwork, error) {
+	filter := bson.M{"ownerId": ownerID}
+	if search != "" {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(search), Options: "i"}
+		filter["$or"] = []bson.M{
+			{"title": pattern},
+			{"creator": pattern},
+			{"accessionNumber": pattern},
+		}
+	}
+
+	cursor, err := r.Collection.Find(ctx, filter, options.Find().SetSort(bson.M{"title": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	artworks := []model.Artwork{}
+	if err := cursor.All(ctx, &artworks); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return artworks, nil
+}
+
+func (r *ArtworkRepository) GetArtworkByID(ctx context.Context, artworkID string) (*model.Artwork, error) {
+	objectID, err := primitive.ObjectIDFromHex(artworkID)
+	if err != nil {
+		return nil, cerr.ErrArtworkNotFound
+	}
+
+	var artwork model.Artwork
+	if err := r.Collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&artwork); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrArtworkNotFound
+		}
+		return nil, err
+	}
+
+	return &artwork, nil
+}
+
+func (r *ArtworkRepository) GetArtworksByIDs(ctx context.Context, artworkIDs []primitive.ObjectID) ([]model.Artwork, error) {
+	cursor, err := r.Collection.Find(ctx, bson.M{"_id": bson.M{"$in": artworkIDs}})
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	artworks := []model.Artwork{}
+	if err := cursor.All(ctx, &artworks); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return artworks, nil
+}
+
+// UpdateArtwork replaces the catalogue fields of an artwork, keeping its owner and creation time.
+func (r *ArtworkRepository) UpdateArtwork(ctx context.Context, artwork *model.Artwork) error {
+	set := bson.M{
+		"title":       artwork.Title,
+		"creator":     artwork.Creator,
+		"date":        artwork.Date,
+		"medium":      artwork.Medium,
+		"dimensions":  artwork.Dimensions,
+		"creditLine":  artwork.CreditLine,
+		"rights":      artwork.Rights,
+		"license":     artwork.License,
+		"description": artwork.Description,
+		"images":      artwork.Images,
+		"updatedAt":   artwork.UpdatedAt,
+	}
+	update := bson.M{"$set": set}
+
+	// An empty accession number is removed so the unique index ignores the artwork
+	if artwork.AccessionNumber != "" {
+		set["accessionNumber"] = artwork.AccessionNumber
+	} else {
+		update["$unset"] = bson.M{"accessionNumber": ""}
+	}
+
+	result, err := r.Collection.UpdateOne(ctx, bson.M{"_id": artwork.ID}, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return cerr.ErrArtworkExists
+		}
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrArtworkNotFound
+	}
+
+	return nil
+}
+
+func (r *ArtworkRepository) DeleteArtwork(ctx context.Context, artworkID string) error {
+	objectID, err := primitive.ObjectIDFromHex(artworkID)
+	if err != nil {
+		return cerr.ErrArtworkNotFound
+	}
+
+	result, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return cerr.ErrArtworkNotFound
+	}
+
+	return nil
+}
+
+// IsArtworkReferenced reports whether a section column or room item references the artwork.
+func (r *ArtworkRepository) IsArtworkReferenced(ctx context.Context, artworkID string) (bool, error) {
+	objectID, err := primitive.ObjectIDFromHex(artworkID)
+	if err != nil {
+		return false, cerr.ErrArtworkNotFound
+	}
+
+	opts := options.Count().SetLimit(1)
+
+	sections, err := r.SectionCollection.CountDocuments(ctx, bson.M{"$or": []bson.M{
+		{"leftCol.artworkId": objectID},
+		{"rightCol.artworkId": objectID},
+	}}, opts)
+	if err != nil {
+		return false, err
+	}
+	if sections > 0 {
+		return true, nil
+	}
+
+	rooms, err := r.RoomCollection.CountDocuments(ctx, bson.M{"$or": []bson.M{
+		{"left.artworkId": objectID},
+		{"center.artworkId": objectID},
+		{"right.artworkId": objectID},
+	}}, opts)
+	if err != nil {
+		return false, err
+	}
+
+	return rooms > 0, nil
+}
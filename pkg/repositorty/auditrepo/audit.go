@@ -0,0 +1,128 @@
+package auditrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection holds the audit log.
+const Collection = "auditLog"
+
+// retentionIndex is the name of the index expiring old entries.
+const retentionIndex = "retention"
+
+// indexOptionsConflict is the error code of creating an index that exists with other options.
+const indexOptionsConflict = 85
+
+// The audit log is append-only: entries are added and expire, but are never changed or
+// removed otherwise.
+type IAuditRepository interface {
+	EnsureIndexes(ctx context.Context, retention time.Duration) error
+	AppendEntry(ctx context.Context, entry *model.AuditEntry) error
+	GetEntries(ctx context.Context, query model.AuditQuery) ([]model.AuditEntry, error)
+	FindDocument(ctx context.Context, collection string, id primitive.ObjectID) (bson.Raw, error)
+}
+
+// AuditRepository is the MongoDB implementation of the Repository interface.
+type AuditRepository struct {
+	Collection *mongo.Collection
+}
+
+// NewAuditRepository creates a new instance of AuditRepository.
+func NewAuditRepository(client *mongo.Client, databaseName string) *AuditRepository {
+	return &AuditRepository{Collection: client.Database(databaseName).Collection(Collection)}
+}
+
+// EnsureIndexes creates the indexes used to filter the log, and the index expiring entries
+// after the retention. A changed retention applies to the existing entries too.
+func (r *AuditRepository) EnsureIndexes(ctx context.Context, retention time.Duration) error {
+	_, err := r.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "actorID", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "targetType", Value: 1}, {Key: "targetID", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "action", Value: 1}, {Key: "_id", Value: -1}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	expireAfter := int32(retention.Seconds())
+	_, err = r.Collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "at", Value: 1}},
+		Options: options.Index().SetName(retentionIndex).SetExpireAfterSeconds(expireAfter),
+	})
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && commandErr.Code == indexOptionsConflict {
+		// The retention changed since the index was created
+		return r.Collection.Database().RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: Collection},
+			{Key: "index", Value: bson.M{"name": retentionIndex, "expireAfterSeconds": expireAfter}},
+		}).Err()
+	}
+	return err
+}
+
+func (r *AuditRepository) AppendEntry(ctx context.Context, entry *model.AuditEntry) error {
+	_, err := r.Collection.InsertOne(ctx, entry)
+	return err
+}
+
+// GetEntries retrieves the entries matching the query, the latest first.
+func (r *AuditRepository) GetEntries(ctx context.Context, query model.AuditQuery) ([]model.AuditEntry, error) {
+	filter := bson.M{}
+	if query.ActorID != nil {
+		filter["actorID"] = *query.ActorID
+	}
+	if query.Action != "" {
+		filter["action"] = query.Action
+	}
+	if query.TargetType != "" {
+		filter["targetType"] = query.TargetType
+	}
+	if query.TargetID != "" {
+		filter["targetID"] = query.TargetID
+	}
+	at := bson.M{}
+	if query.From != nil {
+		at["$gte"] = *query.From
+	}
+	if query.To != nil {
+		at["$lt"] = *query.To
+	}
+	if len(at) > 0 {
+		filter["at"] = at
+	}
+	if query.Before != nil {
+		filter["_id"] = bson.M{"$lt": *query.Before}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(query.Limit)
+	cursor, err := r.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	entries := []model.AuditEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// FindDocument retrieves a document of another collection to hash it, or nil when there is
+// none.
+func (r *AuditRepository) FindDocument(ctx context.Context, collection string, id primitive.ObjectID) (bson.Raw, error) {
+	document, err := r.Collection.Database().Collection(collection).FindOne(ctx, bson.M{"_id": id}).Raw()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	return document, err
+}
@@ -0,0 +1,228 @@
+package collabrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ICollaboratorRepository interface {
+	GetExhibitionAccess(ctx context.Context, exhibitionID string) (*model.ExhibitionAccess, error)
+	AddCollaborator(ctx context.Context, exhibitionID string, collaborator *model.Collaborator) error
+	UpdateCollaboratorRole(ctx context.Context, exhibitionID string, userID primitive.ObjectID, role string) error
+	RemoveCollaborator(ctx context.Context, exhibitionID string, userID primitive.ObjectID) error
+	AcceptInvitation(ctx context.Context, exhibitionID string, user model.UserID) error
+	TransferOwnership(ctx context.Context, exhibitionID string, previousOwner model.UserID, newOwner model.Collaborator) error
+}
+
+// CollaboratorRepository is the MongoDB implementation of the Repository interface.
+// Collaborators are embedded in the exhibition documents.
+type CollaboratorRepository struct {
+	Collection *mongo.Collection
+}
+
+// GetExhibitionAccess retrieves the owner and collaborators of an exhibition.
+func (r *CollaboratorRepository) GetExhibitionAccess(ctx context.Context, exhibitionID string) (*model.ExhibitionAccess, error) {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return nil, cerr.ErrExhibitionNotFound
+	}
+
+	projection := bson.M{"_id": 1, "userId": 1, "collaborators": 1}
+
+	var access model.ExhibitionAccess
+	err = r.Collection.FindOne(ctx, bson.M{"_id": objectID}, options.FindOne().SetProjection(projection)).Decode(&access)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrExhibitionNotFound
+		}
+		return nil, err
+	}
+
+	return &access, nil
+}
+
+// AddCollaborator adds a pending collaborator unless the user already owns or collaborates on the exhibition.
+func (r *CollaboratorRepository) AddCollaborator(ctx context.Context, exhibitionID string, collaborator *model.Collaborator) error {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return cerr.ErrExhibitionNotFound
+	}
+
+	filter := bson.M{
+		"_id":                  objectID,
+		"userId.userId":        bson.M{"$ne": collaborator.UserID},
+		"collaborators.userId": bson.M{"$ne": collaborator.UserID},
+	}
+	update := bson.M{"$push": bson.M{"collaborators": collaborator}}
+
+	result, err := r.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missingOr(ctx, objectID, cerr.ErrCollaboratorExists)
+	}
+
+	return nil
+}
+
+// UpdateCollaboratorRole changes the role of an existing collaborator.
+func (r *CollaboratorRepository) UpdateCollaboratorRole(ctx context.Context, exhibitionID string, userID primitive.ObjectID, role string) error {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return cerr.ErrExhibitionNotFound
+	}
+
+	filter := bson.M{"_id": objectID, "collaborators.userId": userID}
+	update := bson.M{"$set": bson.M{"collaborators.$.role": role}}
+
+	result, err := r.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missingOr(ctx, objectID, cerr.ErrCollaboratorNotFound)
+	}
+
+	return nil
+}
+
+// RemoveCollaborator removes a collaborator or pending invitation from an exhibition.
+func (r *CollaboratorRepository) RemoveCollaborator(ctx context.Context, exhibitionID string, userID primitive.ObjectID) error {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return cerr.ErrExhibitionNotFound
+	}
+
+	filter := bson.M{"_id": objectID, "collaborators.userId": userID}
+	update := bson.M{"$pull": bson.M{"collaborators": bson.M{"userId": userID}}}
+
+	result, err := r.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missingOr(ctx, objectID, cerr.ErrCollaboratorNotFound)
+	}
+
+	return nil
+}
+
+// AcceptInvitation marks a pending invitation as accepted and refreshes the invitee profile.
+func (r *CollaboratorRepository) AcceptInvitation(ctx context.Context, exhibitionID string, user model.UserID) error {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return cerr.ErrExhibitionNotFound
+	}
+
+	filter := bson.M{
+		"_id": objectID,
+		"collaborators": bson.M{"$elemMatch": bson.M{
+			"userId": user.UserID,
+			"status": model.InvitationPending,
+		}},
+	}
+	update := bson.M{"$set": bson.M{
+		"collaborators.$.status":     model.InvitationAccepted,
+		"collaborators.$.acceptedAt": time.Now(),
+		"collaborators.$.firstName":  user.FirstName,
+		"collaborators.$.lastName":   user.LastName,
+		"collaborators.$.username":   user.Username,
+		"collaborators.$.profile":    user.ProfileImage,
+	}}
+
+	result, err := r.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missingOr(ctx, objectID, cerr.ErrCollaboratorNotFound)
+	}
+
+	return nil
+}
+
+// TransferOwnership makes newOwner the owner of the exhibition and keeps previousOwner as an editor.
+// The update only applies while previousOwner still owns the exhibition.
+func (r *CollaboratorRepository) TransferOwnership(ctx context.Context, exhibitionID string, previousOwner model.UserID, newOwner model.Collaborator) error {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return cerr.ErrExhibitionNotFound
+	}
+
+	formerOwner := model.Collaborator{
+		UserID:       previousOwner.UserID,
+		FirstName:    previousOwner.FirstName,
+		LastName:     previousOwner.LastName,
+		Username:     previousOwner.Username,
+		ProfileImage: previousOwner.ProfileImage,
+		Role:         model.RoleEditor,
+		Status:       model.InvitationAccepted,
+		InvitedBy:    newOwner.UserID,
+		InvitedAt:    time.Now(),
+	}
+
+	filter := bson.M{
+		"_id":           objectID,
+		"userId.userId": previousOwner.UserID,
+		"collaborators": bson.M{"$elemMatch": bson.M{
+			"userId": newOwner.UserID,
+			"status": model.InvitationAccepted,
+		}},
+	}
+
+	// Swap the owner and collaborator entries in a single pipeline update
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"userId": model.UserID{
+				UserID:       newOwner.UserID,
+				FirstName:    newOwner.FirstName,
+				LastName:     newOwner.LastName,
+				Username:     newOwner.Username,
+				ProfileImage: newOwner.ProfileImage,
+			},
+			"collaborators": bson.M{"$concatArrays": bson.A{
+				bson.M{"$filter": bson.M{
+					"input": "$collaborators",
+					"cond":  bson.M{"$ne": bson.A{"$$this.userId", newOwner.UserID}},
+				}},
+				bson.A{formerOwner},
+			}},
+		}}},
+	}
+
+	result, err := r.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missingOr(ctx, objectID, cerr.ErrCollaboratorNotFound)
+	}
+
+	return nil
+}
+
+// missingOr returns ErrExhibitionNotFound when the exhibition does not exist, otherwise err.
+func (r *CollaboratorRepository) missingOr(ctx context.Context, objectID primitive.ObjectID, err error) error {
+	count, countErr := r.Collection.CountDocuments(ctx, bson.M{"_id": objectID})
+	if countErr != nil {
+		return countErr
+	}
+	if count == 0 {
+		return cerr.ErrExhibitionNotFound
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+package commentrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CleanupCollection holds the queued comment cleanups.
+const CleanupCollection = "commentCleanups"
+
+type ICleanupRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	EnqueueCleanup(ctx context.Context, exhibitionID primitive.ObjectID, next time.Time, reason string) error
+	ClaimDueCleanup(ctx context.Context, now time.Time, lease time.Duration) (*model.CommentCleanup, error)
+	CompleteCleanup(ctx context.Context, cleanupID primitive.ObjectID) error
+	RescheduleCleanup(ctx context.Context, cleanupID primitive.ObjectID, next time.Time, reason string) error
+}
+
+// CleanupRepository is the MongoDB implementation of the Repository interface.
+type CleanupRepository struct {
+	Collection *mongo.Collection
+}
+
+// NewCleanupRepository creates a new instance of CleanupRepository.
+func NewCleanupRepository(client *mongo.Client, databaseName string) *CleanupRepository {
+	return &CleanupRepository{Collection: client.Database(databaseName).Collection(CleanupCollection)}
+}
+
+// EnsureIndexes creates the unique index that queues an exhibition once and the index used
+// to find due cleanups.
+func (r *CleanupRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "exhibitionID", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "nextAttemptAt", Value: 1}}},
+	})
+	return err
+}
+
+// EnqueueCleanup queues the cleanup of the comments of an exhibition that failed, to be tried
+// again at next. An exhibition already queued stays queued once, at the time it was queued for.
+func (r *CleanupRepository) EnqueueCleanup(ctx context.Context, exhibitionID primitive.ObjectID, next time.Time, reason string) error {
+	now := time.Now()
+	_, err := r.Collection.UpdateOne(ctx,
+		bson.M{"exhibitionID": exhibitionID},
+		bson.M{
+			"$set":         bson.M{"lastError": reason},
+			"$inc":         bson.M{"failures": 1},
+			"$setOnInsert": bson.M{"nextAttemptAt": next, "createdAt": now},
+		},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// ClaimDueCleanup takes the cleanup due the longest and hides it from other workers for the
+// lease. It returns nil when no cleanup is due.
+func (r *CleanupRepository) ClaimDueCleanup(ctx context.Context, now time.Time, lease time.Duration) (*model.CommentCleanup, error) {
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var cleanup model.CommentCleanup
+	err := r.Collection.FindOneAndUpdate(ctx,
+		bson.M{"nextAttemptAt": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"nextAttemptAt": now.Add(lease)}},
+		opts,
+	).Decode(&cleanup)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &cleanup, nil
+}
+
+// CompleteCleanup removes a cleanup that is done.
+func (r *CleanupRepository) CompleteCleanup(ctx context.Context, cleanupID primitive.ObjectID) error {
+	_, err := r.Collection.DeleteOne(ctx, bson.M{"_id": cleanupID})
+	return err
+}
+
+// RescheduleCleanup counts a failed cleanup and sets when to try it again.
+func (r *CleanupRepository) RescheduleCleanup(ctx context.Context, cleanupID primitive.ObjectID, next time.Time, reason string) error {
+	_, err := r.Collection.UpdateOne(ctx,
+		bson.M{"_id": cleanupID},
+		bson.M{
+			"$set": bson.M{"nextAttemptAt": next, "lastError": reason},
+			"$inc": bson.M{"failures": 1},
+		},
+	)
+	return err
+}
@@ -0,0 +1,127 @@
+package eventrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Server error codes of change streams that cannot be opened or resumed.
+const (
+	codeInvalidResumeToken      = 260
+	codeChangeStreamFatal       = 280
+	codeChangeStreamHistoryLost = 286
+	codeNotReplicaSet           = 40573
+)
+
+// ChangeHandler receives the changes of a stream with the token to resume after them. It is
+// called with a nil change once the stream is open and whenever it has been idle for the
+// heartbeat interval. Returning an error closes the stream.
+type ChangeHandler func(change *event.Change, token string) error
+
+type IEventRepository interface {
+	WatchChanges(ctx context.Context, query model.EventQuery, handle ChangeHandler) error
+}
+
+// EventRepository is the MongoDB implementation of the Repository interface. Events are
+// read from a change stream on the database, which requires a replica set.
+type EventRepository struct {
+	Database *mongo.Database
+}
+
+// NewEventRepository creates a new instance of EventRepository.
+func NewEventRepository(client *mongo.Client, databaseName string) *EventRepository {
+	return &EventRepository{Database: client.Database(databaseName)}
+}
+
+// WatchChanges follows the changes to exhibitions, sections and rooms selected by the query
+// until the context is cancelled, starting after the resume token of the query if any.
+func (r *EventRepository) WatchChanges(ctx context.Context, query model.EventQuery, handle ChangeHandler) error {
+	opts := options.ChangeStream().
+		SetFullDocument(options.UpdateLookup).
+		SetMaxAwaitTime(event.HeartbeatInterval)
+	if query.ResumeToken != "" {
+		token, err := event.DecodeToken(query.ResumeToken)
+		if err != nil {
+			return err
+		}
+		opts.SetResumeAfter(token)
+	}
+
+	stream, err := r.Database.Watch(ctx, pipeline(query), opts)
+	if err != nil {
+		return streamError(err)
+	}
+	defer stream.Close(context.Background())
+
+	if err := handle(nil, event.EncodeToken(stream.ResumeToken())); err != nil {
+		return err
+	}
+
+	for {
+		// TryNext waits on the server for at most the heartbeat interval
+		if stream.TryNext(ctx) {
+			var change event.Change
+			if err := stream.Decode(&change); err != nil {
+				return fmt.Errorf("decode change: %v", err)
+			}
+			if err := handle(&change, event.EncodeToken(stream.ResumeToken())); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := stream.Err(); err != nil {
+			return streamError(err)
+		}
+		if err := handle(nil, event.EncodeToken(stream.ResumeToken())); err != nil {
+			return err
+		}
+	}
+}
+
+// pipeline selects the changes of the query. Owner streams only see changes that carry the
+// exhibition document, which deleted exhibitions do not.
+func pipeline(query model.EventQuery) mongo.Pipeline {
+	match := bson.D{
+		{Key: "operationType", Value: bson.M{"$in": bson.A{"insert", "update", "replace", "delete"}}},
+		{Key: "ns.coll", Value: bson.M{"$in": bson.A{event.ExhibitionCollection, event.SectionCollection, event.RoomCollection}}},
+	}
+	if query.ExhibitionID != nil {
+		match = append(match, bson.E{Key: "$or", Value: bson.A{
+			bson.M{"ns.coll": event.ExhibitionCollection, "documentKey._id": *query.ExhibitionID},
+			bson.M{"fullDocument.exhibitionID": *query.ExhibitionID},
+		}})
+	}
+	if query.OwnerID != nil {
+		match = append(match, bson.E{Key: "fullDocument.userId.userId", Value: *query.OwnerID})
+	}
+
+	return mongo.Pipeline{{{Key: "$match", Value: match}}}
+}
+
+// streamError maps the server errors of change streams to the errors of the service.
+func streamError(err error) error {
+	var serverError mongo.ServerError
+	if errors.As(err, &serverError) {
+		switch {
+		case serverError.HasErrorCode(codeInvalidResumeToken):
+			return cerr.ErrInvalidResumeToken
+		case serverError.HasErrorCode(codeChangeStreamHistoryLost), serverError.HasErrorCode(codeChangeStreamFatal):
+			return cerr.ErrEventHistoryLost
+		case serverError.HasErrorCode(codeNotReplicaSet):
+			return cerr.ErrEventsUnavailable
+		}
+	}
+	return fmt.Errorf("change stream error: %v", err)
+}
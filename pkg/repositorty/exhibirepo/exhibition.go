@@ -1,7 +1,15 @@
 package exhibirepo
 
 import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/layout"
 	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/quizrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/reservationrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/tourrepo"
 	"atommuse/backend/exhibition-service/pkg/utils"
 	"context"
 	"errors"
@@ -14,6 +22,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type IExhibitionRepository interface {
@@ -28,18 +37,25 @@ type IExhibitionRepository interface {
 	LikeExhibition(ctx *gin.Context, exhibitionID, userID string) error
 	UnlikeExhibition(ctx *gin.Context, exhibitionID, userID string) error
 	GetExhibitionsByCategory(ctx context.Context, category string) ([]model.ResponseExhibition, error)
+	GetPublishedExhibitions(ctx context.Context, query model.PublishedQuery) ([]model.ResponseExhibition, error)
+	CountPublishedExhibitions(ctx context.Context) (int64, error)
 	GetCurrentlyExhibitions(ctx context.Context) ([]model.ResponseExhibition, error)
 	GetPreviouslyExhibitions(ctx context.Context) ([]model.ResponseExhibition, error)
 	GetUpcomingExhibitions(ctx context.Context) ([]model.ResponseExhibition, error)
 	GetExhibitionsByFilter(ctx context.Context, category, status, sortOrder string) ([]model.ResponseExhibition, error)
 	GetExhibitionSectionInfo(ctx context.Context, exhibitionID string) ([]model.ExhibitionSectionInfo, error)
-	BanExhibition(ctx context.Context, exhibitionID string) error
+	UpdateMediaRights(ctx context.Context, exhibitionID string, rights []model.MediaRights) error
+	EnsureIndexes(ctx context.Context) error
+	SearchExhibitions(ctx context.Context, text, language string, limit int) ([]model.ResponseExhibition, error)
+	GetNearbyExhibitions(ctx context.Context, query model.NearbyQuery) ([]model.ResponseNearbyExhibition, error)
 }
 
-// ExhibitionRepository is the MongoDB implementation of the Repository interface.
+// ExhibitionRepository is the MongoDB implementation of the Repository interface. Writes
+// record their events in the outbox in the same transaction.
 type ExhibitionRepository struct {
 	Collection         *mongo.Collection
 	SectionsCollection *mongo.Collection
+	Outbox             outboxrepo.IOutboxRepository
 }
 
 // NewExhibitionRepository creates a new instance of ExhibitionRepository.
@@ -51,6 +67,7 @@ func NewExhibitionRepository(ctx context.Context, client *mongo.Client, database
 	return &ExhibitionRepository{
 		Collection:         collection,
 		SectionsCollection: sectionCollection,
+		Outbox:             outboxrepo.NewOutboxRepository(client, databaseName),
 	}, nil
 }
 
@@ -91,7 +108,7 @@ func (r *ExhibitionRepository) GetExhibitionByID(ctx *gin.Context, exhibitionID
 	err = r.Collection.FindOne(ctx, bson.M{"_id": objID}).Decode(&exhibition)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, errors.New("exhibition not found")
+			return nil, cerr.ErrExhibitionNotFound
 		}
 		return nil, err
 	}
@@ -106,106 +123,9 @@ func (r *ExhibitionRepository) GetExhibitionByID(ctx *gin.Context, exhibitionID
 	}
 	exhibition.IsLike = isLiked
 
-	if exhibition.LayoutUsed == "blogLayout" {
-
-		// Find sections related to the exhibition
-		mongoURI := os.Getenv("MONGO_URI")
-		if mongoURI == "" {
-			log.Fatal("MONGO_URI environment variable not set.")
-		}
-		log.Println("MongoURI:", mongoURI)
-
-		client, err := utils.ConnectToMongoDB(mongoURI)
-		if err != nil {
-			log.Fatal("Error connecting to MongoDB:", err)
-		}
-		defer func() {
-			if err := client.Disconnect(context.Background()); err != nil {
-				log.Println("Error disconnecting from MongoDB:", err)
-			}
-		}()
-
-		// Specify the collection names
-		sectionCollection := client.Database("atommuse").Collection("exhibitionSections")
-
-		// Loop through exhibition section IDs
-		var sections []model.ExhibitionSection
-
-		for _, sectionID := range exhibition.ExhibitionSectionsID {
-			// Convert sectionID to ObjectID
-			sectionObjID, err := primitive.ObjectIDFromHex(sectionID)
-			if err != nil {
-				return nil, err
-			}
-
-			// Find section by ID
-			var section model.ExhibitionSection
-			err = sectionCollection.FindOne(ctx, bson.M{"_id": sectionObjID}).Decode(&section)
-			if err != nil {
-				if errors.Is(err, mongo.ErrNoDocuments) {
-					return nil, errors.New("section not found")
-				}
-				return nil, err
-			}
-
-			sections = append(sections, section)
-		}
-
-		// Assign sections to the exhibition
-		exhibition.ExhibitionSections = sections
-	}
-
-	if exhibition.LayoutUsed == "liveLayout" {
-
-		// Find rooms related to the exhibition
-		mongoURI := os.Getenv("MONGO_URI")
-		if mongoURI == "" {
-			log.Fatal("MONGO_URI environment variable not set.")
-		}
-		log.Println("MongoURI:", mongoURI)
-
-		client, err := utils.ConnectToMongoDB(mongoURI)
-		if err != nil {
-			log.Fatal("Error connecting to MongoDB:", err)
-		}
-		defer func() {
-			if err := client.Disconnect(context.Background()); err != nil {
-				log.Println("Error disconnecting from MongoDB:", err)
-			}
-		}()
-
-		// Specify the collection names
-		roomCollection := client.Database("atommuse").Collection("exhibitionRooms")
-
-		// Loop through exhibition room IDs
-		var rooms []model.Room
-		fmt.Println("ex Roomid: ", exhibition.RoomsID)
-
-		for _, roomID := range exhibition.RoomsID {
-			fmt.Println("roomID: ", roomID)
-			// Convert roomID to ObjectID
-			roomObjID, err := primitive.ObjectIDFromHex(roomID)
-			if err != nil {
-				return nil, err
-			}
-
-			// Find room by ID
-			var room model.Room
-			err = roomCollection.FindOne(ctx, bson.M{"_id": roomObjID}).Decode(&room)
-			if err != nil {
-				if errors.Is(err, mongo.ErrNoDocuments) {
-					return nil, errors.New("room not found")
-				}
-				return nil, err
-			}
-
-			rooms = append(rooms, room)
-		}
-		if rooms != nil {
-			// Assign rooms to the exhibition
-			exhibition.Room = rooms
-		}
-
+	// Fill in the sections, rooms or other content of the exhibition's layout
+	if err := layout.Load(ctx, r.Collection.Database(), &exhibition); err != nil {
+		return nil, err
 	}
 
 	return &exhibition, nil
@@ -257,9 +177,14 @@ func (r *ExhibitionRepository) GetSectionsByExhibitionID(ctx context.Context, ex
 	return sections, nil
 }
 
+// publishedFilter matches exhibitions that are public, and neither banned nor held for review.
+func publishedFilter() bson.M {
+	return bson.M{"isPublic": true, "status": "created"}
+}
+
 func (r *ExhibitionRepository) GetExhibitionsIsPublic(ctx context.Context) ([]model.ResponseExhibition, error) {
 	// Define the match stage for the aggregation pipeline
-	matchStage := bson.M{"$match": bson.M{"isPublic": true, "status": "created"}}
+	matchStage := bson.M{"$match": publishedFilter()}
 
 	// Define the sort stage for the aggregation pipeline
 	sortStage := bson.M{"$sort": bson.M{"startDate": 1}}
@@ -284,14 +209,23 @@ func (r *ExhibitionRepository) GetExhibitionsIsPublic(ctx context.Context) ([]mo
 }
 
 func (r *ExhibitionRepository) CreateExhibition(ctx context.Context, exhibition *model.RequestCreateExhibition) (*primitive.ObjectID, error) {
-	result, err := r.Collection.InsertOne(ctx, exhibition)
-	if err != nil {
-		return nil, err
-	}
+	var objectID primitive.ObjectID
+	err := r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		result, err := r.Collection.InsertOne(tx, exhibition)
+		if err != nil {
+			return err
+		}
 
-	// Extract the generated ObjectID from the result
-	objectID, ok := result.InsertedID.(primitive.ObjectID)
-	if !ok {
+		// Extract the generated ObjectID from the result
+		id, ok := result.InsertedID.(primitive.ObjectID)
+		if !ok {
+			return errors.New("invalid inserted exhibition ID")
+		}
+		objectID = id
+		tx.Inserted(event.ExhibitionCollection, objectID)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -299,41 +233,9 @@ func (r *ExhibitionRepository) CreateExhibition(ctx context.Context, exhibition
 }
 
 func (r *ExhibitionRepository) DeleteExhibition(ctx context.Context, exhibitionID string) error {
-	mongoURI := os.Getenv("MONGO_URI")
-	if mongoURI == "" {
-		log.Fatal("MONGO_URI environment variable not set.")
-	}
-	log.Println("MongoURI:", mongoURI)
-
-	client, err := utils.ConnectToMongoDB(mongoURI)
-	if err != nil {
-		log.Fatal("Error connecting to MongoDB:", err)
-	}
-	defer func() {
-		if err := client.Disconnect(context.Background()); err != nil {
-			log.Println("Error disconnecting from MongoDB:", err)
-		}
-	}()
-
 	// Specify the collection names
-	sectionCollection := client.Database("atommuse").Collection("exhibitionSections")
-
-	mongoURI1 := os.Getenv("MONGO_URI")
-	if mongoURI == "" {
-		log.Fatal("MONGO_URI environment variable not set.")
-	}
-	log.Println("MongoURI:", mongoURI)
-
-	clientRoom, err := utils.ConnectToMongoDB(mongoURI1)
-	if err != nil {
-		log.Fatal("Error connecting to MongoDB:", err)
-	}
-	defer func() {
-		if err := clientRoom.Disconnect(context.Background()); err != nil {
-			log.Println("Error disconnecting from MongoDB:", err)
-		}
-	}()
-	roomCollection := clientRoom.Database("atommuse").Collection("exhibitionRooms")
+	sectionCollection := r.Collection.Database().Collection("exhibitionSections")
+	roomCollection := r.Collection.Database().Collection("exhibitionRooms")
 
 	// Convert the string ID to ObjectId
 	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
@@ -341,118 +243,88 @@ func (r *ExhibitionRepository) DeleteExhibition(ctx context.Context, exhibitionI
 		return fmt.Errorf("invalid exhibition ID format: %v", err)
 	}
 
-	// Define the match stage for the exhibition document in the aggregation pipeline
-	matchStage := bson.M{"$match": bson.M{"_id": objectID}}
-
-	// Aggregate pipeline for finding the exhibition document
-	pipeline := []bson.M{matchStage}
-
-	// Execute the aggregation to find the exhibition document
-	cursor, err := r.Collection.Aggregate(ctx, pipeline)
-	if err != nil {
-		return err
-	}
-	defer cursor.Close(ctx)
-
-	// Check if any result is found
-	if !cursor.Next(ctx) {
-		return fmt.Errorf("exhibition not found for ID %s", exhibitionID)
-	}
-
-	// Decode the main exhibition document
-	var exhibition model.ResponseExhibitionForDelete
-	if err := cursor.Decode(&exhibition); err != nil {
-		return err
-	}
-
-	// Retrieve the exhibitionSectionsIDs from the exhibition document
-	exhibitionSectionsIDs := exhibition.ExhibitionSectionsID
-	exhibitionRoomsIDs := exhibition.RoomsID
-
-	// Perform the deletion of the exhibition document
-	deleteResult, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
-	if err != nil {
-		return err
-	}
+	return r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		// Define the match stage for the exhibition document in the aggregation pipeline
+		matchStage := bson.M{"$match": bson.M{"_id": objectID}}
 
-	if deleteResult.DeletedCount == 0 {
-		return fmt.Errorf("exhibition not deleted for ID %s", exhibitionID)
-	}
+		// Aggregate pipeline for finding the exhibition document
+		pipeline := []bson.M{matchStage}
 
-	// Now, delete associated exhibitionSections
-	for _, sectionID := range exhibitionSectionsIDs {
-		sectionObjectID, err := primitive.ObjectIDFromHex(sectionID)
-		fmt.Println(sectionObjectID)
+		// Execute the aggregation to find the exhibition document
+		cursor, err := r.Collection.Aggregate(tx, pipeline)
 		if err != nil {
-			// Handle error
-			fmt.Println("err1", err)
+			return err
 		}
+		defer cursor.Close(tx)
 
-		_, err = sectionCollection.DeleteOne(ctx, bson.M{"_id": sectionObjectID})
-		if err != nil {
-			// Handle error
-			fmt.Println("err2", err)
+		// Check if any result is found
+		if !cursor.Next(tx) {
+			return fmt.Errorf("exhibition not found for ID %s", exhibitionID)
 		}
-	}
-	// Now, delete associated room
-	for _, roomID := range exhibitionRoomsIDs {
-		roomObjectID, err := primitive.ObjectIDFromHex(roomID)
-		fmt.Println(roomObjectID)
-		if err != nil {
-			// Handle error
-			fmt.Println("err1", err)
+
+		// Decode the main exhibition document
+		var exhibition model.ResponseExhibitionForDelete
+		if err := cursor.Decode(&exhibition); err != nil {
+			return err
 		}
 
-		_, err = roomCollection.DeleteOne(ctx, bson.M{"_id": roomObjectID})
+		// Retrieve the exhibitionSectionsIDs from the exhibition document
+		exhibitionSectionsIDs := exhibition.ExhibitionSectionsID
+		exhibitionRoomsIDs := exhibition.RoomsID
+
+		// Perform the deletion of the exhibition document
+		if err := tx.Track(event.ExhibitionCollection, objectID); err != nil {
+			return err
+		}
+		deleteResult, err := r.Collection.DeleteOne(tx, bson.M{"_id": objectID})
 		if err != nil {
-			// Handle error
-			fmt.Println("err2", err)
+			return err
 		}
-	}
-	mongoURI2 := os.Getenv("MONGO_URI_COMMENT")
-	if mongoURI == "" {
-		log.Fatal("MONGO_URI environment variable not set.")
-	}
-	log.Println("MongoURI:", mongoURI)
 
-	clientComment, err := utils.ConnectToMongoDB(mongoURI2)
-	if err != nil {
-		log.Fatal("Error connecting to MongoDB:", err)
-	}
-	defer func() {
-		if err := clientComment.Disconnect(context.Background()); err != nil {
-			log.Println("Error disconnecting from MongoDB:", err)
+		if deleteResult.DeletedCount == 0 {
+			return fmt.Errorf("exhibition not deleted for ID %s", exhibitionID)
 		}
-	}()
-	// Specify the collection names
-	commentCollection := clientComment.Database("atommuse-comment").Collection("comments")
-
-	// Now, call DeleteCommentsByExhibitionID from commentrepo
-	exhibitionObjectID, err := primitive.ObjectIDFromHex(exhibitionID)
-	if err != nil {
-		return fmt.Errorf("invalid exhibition ID format: %v", err)
-	}
-
-	// Check if there are any comments to delete
-	numComments, err := commentCollection.CountDocuments(ctx, bson.M{"exhibitionID": exhibitionObjectID})
-	if err != nil {
-		return fmt.Errorf("error counting comments for exhibition: %v", err)
-	}
 
-	fmt.Println(numComments)
+		// Now, delete associated exhibitionSections
+		for _, sectionID := range exhibitionSectionsIDs {
+			sectionObjectID, err := primitive.ObjectIDFromHex(sectionID)
+			if err != nil {
+				log.Printf("Error parsing section ID %s: %v", sectionID, err)
+				continue
+			}
 
-	if numComments > 0 {
-		// If there are comments, delete them
-		if _, err := commentCollection.DeleteMany(ctx, bson.M{"exhibitionID": exhibitionObjectID}); err != nil {
-			return fmt.Errorf("error deleting comments for exhibition: %v", err)
+			if _, err := sectionCollection.DeleteOne(tx, bson.M{"_id": sectionObjectID, "exhibitionID": objectID}); err != nil {
+				return err
+			}
 		}
-		fmt.Println("Deleted comments for exhibition ID:", exhibitionID)
-	} else {
-		// If there are no comments, continue execution without error
-		log.Printf("No comments found for exhibition ID: %s", exhibitionID)
-	}
+		// Now, delete associated room
+		for _, roomID := range exhibitionRoomsIDs {
+			roomObjectID, err := primitive.ObjectIDFromHex(roomID)
+			if err != nil {
+				log.Printf("Error parsing room ID %s: %v", roomID, err)
+				continue
+			}
 
-	return nil
+			if _, err := roomCollection.DeleteOne(tx, bson.M{"_id": roomObjectID, "exhibitionID": objectID}); err != nil {
+				return err
+			}
+		}
+		// Delete content the layout stores apart from the exhibition, such as timeline entries
+		if err := layout.Delete(tx, r.Collection.Database(), exhibition.LayoutUsed, objectID); err != nil {
+			return err
+		}
+		// Tours and quizzes reference sections and rooms of any layout; time slots and
+		// reservations belong to the exhibition itself
+		for _, collection := range []string{
+			tourrepo.Collection, quizrepo.Collection, quizrepo.AttemptCollection,
+			reservationrepo.SlotCollection, reservationrepo.ReservationCollection,
+		} {
+			if _, err := r.Collection.Database().Collection(collection).DeleteMany(tx, bson.M{"exhibitionID": objectID}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (r *ExhibitionRepository) UpdateExhibition(ctx context.Context, exhibitionID string, update *model.RequestUpdateExhibition) (*primitive.ObjectID, error) {
@@ -465,7 +337,7 @@ func (r *ExhibitionRepository) UpdateExhibition(ctx context.Context, exhibitionI
 	updateDoc := bson.M{}
 
 	// Iterate over fields in the update struct and set them in the update document
-	updateDoc["$set"] = bson.M{
+	setDoc := bson.M{
 		"exhibitionName":        update.ExhibitionName,
 		"exhibitionDescription": update.ExhibitionDescription,
 		"thumbnailImg":          update.ThumbnailImg,
@@ -474,28 +346,106 @@ func (r *ExhibitionRepository) UpdateExhibition(ctx context.Context, exhibitionI
 		"isPublic":              update.IsPublic,
 		"exhibitionCategories":  update.ExhibitionCategories,
 		"exhibitionTags":        update.ExhibitionTags,
-		"userId":                update.UserID,
 		"layoutUsed":            update.LayoutUsed,
 		"exhibitionSectionsID":  update.ExhibitionSectionsID,
 		"visitedNumber":         update.VisitedNumber,
 		"rooms":                 update.Room,
 		"roomsID":               update.RoomsID,
-		"status":                update.Status,
 	}
 
+	// Keep the current owner unless a new owner profile is given
+	if !update.UserID.UserID.IsZero() {
+		setDoc["userId"] = update.UserID
+	}
+
+	// Keep translations and the search index when clients do not send them
+	if update.DefaultLocale != "" {
+		setDoc["defaultLocale"] = update.DefaultLocale
+	}
+	if update.Translations != nil {
+		setDoc["translations"] = update.Translations
+	}
+	if update.SearchIndex != nil {
+		setDoc["searchIndex"] = update.SearchIndex
+	}
+	if update.Venue != nil {
+		setDoc["venue"] = update.Venue
+	}
+	updateDoc["$set"] = setDoc
+
 	// Perform the update operation
-	result, err := r.Collection.UpdateOne(ctx, filter, updateDoc)
+	err = r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		db := r.Collection.Database()
+		if err := checkOwnContent(tx, db.Collection(event.SectionCollection), objectID, update.ExhibitionSectionsID); err != nil {
+			return err
+		}
+		if err := checkOwnContent(tx, db.Collection(event.RoomCollection), objectID, update.RoomsID); err != nil {
+			return err
+		}
+
+		if err := tx.Track(event.ExhibitionCollection, objectID); err != nil {
+			return err
+		}
+		result, err := r.Collection.UpdateOne(tx, filter, updateDoc)
+		if err != nil {
+			return err
+		}
+		modified := result.ModifiedCount
+
+		// Only moderators ban exhibitions and lift bans and reviews, so the status of a banned
+		// exhibition or one held for review is kept
+		if update.Status != moderation.Banned && update.Status != moderation.Review {
+			result, err = r.Collection.UpdateOne(tx,
+				bson.M{"_id": objectID, "status": bson.M{"$nin": bson.A{moderation.Banned, moderation.Review}}},
+				bson.M{"$set": bson.M{"status": update.Status}},
+			)
+			if err != nil {
+				return err
+			}
+			modified += result.ModifiedCount
+		}
+
+		if modified == 0 {
+			return errors.New("no exhibition updated")
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if result.ModifiedCount == 0 {
-		return nil, errors.New("no exhibition updated")
+	return &objectID, nil
+}
+
+// checkOwnContent checks that the sections or rooms an exhibition lists belong to it, so that
+// listing the IDs of another exhibition neither shows its content nor deletes it along with
+// the exhibition.
+func checkOwnContent(ctx context.Context, collection *mongo.Collection, exhibitionID primitive.ObjectID, ids []string) error {
+	if len(ids) == 0 {
+		return nil
 	}
 
-	return &objectID, nil
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return fmt.Errorf("%w: %s", cerr.ErrForeignContent, id)
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+
+	count, err := collection.CountDocuments(ctx, bson.M{"_id": bson.M{"$in": objectIDs}, "exhibitionID": bson.M{"$ne": exhibitionID}})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return cerr.ErrForeignContent
+	}
+	return nil
 }
 
+// UpdateVisitedNumber sets the number of visits of an exhibition. Visits are not worth an
+// event, so the write does not go through the outbox.
 func (r *ExhibitionRepository) UpdateVisitedNumber(ctx context.Context, exhibitionID string, visitedNumber int) error {
 	// Convert the string ID to ObjectId
 	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
@@ -524,24 +474,29 @@ func (r *ExhibitionRepository) LikeExhibition(ctx *gin.Context, exhibitionID, us
 		return fmt.Errorf("invalid exhibition ID format: %v", err)
 	}
 
-	// Update likeCount and remove from likeList
-	result, err := r.Collection.UpdateMany(ctx, bson.M{"_id": objectID}, bson.M{
-		"$inc":  bson.M{"likeCount": 1},
-		"$push": bson.M{"likeList": userID},
-	})
-	if err != nil {
-		return fmt.Errorf("failed to update like count: %v", err)
-	}
+	return r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		if err := tx.Track(event.ExhibitionCollection, objectID); err != nil {
+			return err
+		}
 
-	// Debug output
-	fmt.Printf("Modified count: %d\n", result.ModifiedCount)
+		// Update likeCount and remove from likeList
+		result, err := r.Collection.UpdateMany(tx, bson.M{"_id": objectID}, bson.M{
+			"$inc":  bson.M{"likeCount": 1},
+			"$push": bson.M{"likeList": userID},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update like count: %v", err)
+		}
 
-	// Check if any document was updated
-	if result.ModifiedCount == 0 {
-		return errors.New("no documents updated")
-	}
+		// Debug output
+		fmt.Printf("Modified count: %d\n", result.ModifiedCount)
 
-	return nil
+		// Check if any document was updated
+		if result.ModifiedCount == 0 {
+			return errors.New("no documents updated")
+		}
+		return nil
+	})
 }
 
 func (r *ExhibitionRepository) UnlikeExhibition(ctx *gin.Context, exhibitionID, userID string) error {
@@ -553,24 +508,29 @@ func (r *ExhibitionRepository) UnlikeExhibition(ctx *gin.Context, exhibitionID,
 	// Print the userID for debugging
 	fmt.Println("Removing userID:", userID)
 
-	// Update likeCount and remove from likeList
-	result, err := r.Collection.UpdateMany(ctx, bson.M{"_id": objectID}, bson.M{
-		"$inc":  bson.M{"likeCount": -1},
-		"$pull": bson.M{"likeList": userID},
-	})
-	if err != nil {
-		return fmt.Errorf("failed to update like count: %v", err)
-	}
+	return r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		if err := tx.Track(event.ExhibitionCollection, objectID); err != nil {
+			return err
+		}
 
-	// Debug output
-	fmt.Printf("Modified count: %d\n", result.ModifiedCount)
+		// Update likeCount and remove from likeList
+		result, err := r.Collection.UpdateMany(tx, bson.M{"_id": objectID}, bson.M{
+			"$inc":  bson.M{"likeCount": -1},
+			"$pull": bson.M{"likeList": userID},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update like count: %v", err)
+		}
 
-	// Check if any document was updated
-	if result.ModifiedCount == 0 {
-		return errors.New("no documents updated")
-	}
+		// Debug output
+		fmt.Printf("Modified count: %d\n", result.ModifiedCount)
 
-	return nil
+		// Check if any document was updated
+		if result.ModifiedCount == 0 {
+			return errors.New("no documents updated")
+		}
+		return nil
+	})
 }
 
 func (r *ExhibitionRepository) GetExhibitionByUserID(ctx context.Context, userID string) ([]*model.ResponseExhibition, error) {
@@ -579,8 +539,14 @@ func (r *ExhibitionRepository) GetExhibitionByUserID(ctx context.Context, userID
 	if err != nil {
 		return nil, err
 	}
-	// Define the filter for the query
-	filter := bson.M{"userId.userId": objectID}
+	// Define the filter for the query, including exhibitions the user collaborates on
+	filter := bson.M{"$or": []bson.M{
+		{"userId.userId": objectID},
+		{"collaborators": bson.M{"$elemMatch": bson.M{
+			"userId": objectID,
+			"status": model.InvitationAccepted,
+		}}},
+	}}
 
 	// Execute the find query
 	cursor, err := r.Collection.Find(ctx, filter)
@@ -607,7 +573,9 @@ func (r *ExhibitionRepository) GetExhibitionByUserID(ctx context.Context, userID
 
 func (r *ExhibitionRepository) GetExhibitionsByCategory(ctx context.Context, category string) ([]model.ResponseExhibition, error) {
 	// Define the match stage for the aggregation pipeline
-	matchStage := bson.M{"$match": bson.M{"exhibitionCategories": category, "isPublic": true, "status": "created"}}
+	filter := publishedFilter()
+	filter["exhibitionCategories"] = category
+	matchStage := bson.M{"$match": filter}
 
 	// Define the sort stage for the aggregation pipeline
 	sortStage := bson.M{"$sort": bson.M{"startDate": 1}}
@@ -631,16 +599,60 @@ func (r *ExhibitionRepository) GetExhibitionsByCategory(ctx context.Context, cat
 	return exhibitions, nil
 }
 
+// GetPublishedExhibitions retrieves a page of published exhibitions, ordered by start date or,
+// with Newest, by creation time from the newest.
+func (r *ExhibitionRepository) GetPublishedExhibitions(ctx context.Context, query model.PublishedQuery) ([]model.ResponseExhibition, error) {
+	filter := publishedFilter()
+	if query.Category != "" {
+		filter["exhibitionCategories"] = query.Category
+	}
+	if !query.UserID.IsZero() {
+		filter["userId.userId"] = query.UserID
+	}
+	if !query.EndingAfter.IsZero() {
+		filter["endDate"] = bson.M{"$gt": query.EndingAfter.UTC().Format("2006-01-02T15:04:05.000Z")}
+	}
+
+	sortStage := bson.M{"$sort": bson.M{"startDate": 1}}
+	if query.Newest {
+		sortStage = bson.M{"$sort": bson.M{"_id": -1}}
+	}
+
+	pipeline := []bson.M{{"$match": filter}, sortStage}
+	if query.Skip > 0 {
+		pipeline = append(pipeline, bson.M{"$skip": query.Skip})
+	}
+	if query.Limit > 0 {
+		pipeline = append(pipeline, bson.M{"$limit": query.Limit})
+	}
+
+	cursor, err := r.Collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregation error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	exhibitions := []model.ResponseExhibition{}
+	if err := cursor.All(ctx, &exhibitions); err != nil {
+		return nil, err
+	}
+
+	return exhibitions, nil
+}
+
+func (r *ExhibitionRepository) CountPublishedExhibitions(ctx context.Context) (int64, error) {
+	return r.Collection.CountDocuments(ctx, publishedFilter())
+}
+
 func (r *ExhibitionRepository) GetCurrentlyExhibitions(ctx context.Context) ([]model.ResponseExhibition, error) {
-	matchStage := bson.M{"$match": bson.M{
-		"isPublic": true,
-		"$expr": bson.M{
-			"$and": []bson.M{
-				bson.M{"$lte": []interface{}{"$startDate", time.Now()}},
-				bson.M{"$gte": []interface{}{"$endDate", time.Now()}},
-			},
+	filter := publishedFilter()
+	filter["$expr"] = bson.M{
+		"$and": []bson.M{
+			bson.M{"$lte": []interface{}{"$startDate", time.Now()}},
+			bson.M{"$gte": []interface{}{"$endDate", time.Now()}},
 		},
-	}}
+	}
+	matchStage := bson.M{"$match": filter}
 	sortStage := bson.M{"$sort": bson.M{"startDate": 1}}
 	pipeline := []bson.M{matchStage, sortStage}
 	cursor, err := r.Collection.Aggregate(ctx, pipeline)
@@ -656,10 +668,9 @@ func (r *ExhibitionRepository) GetCurrentlyExhibitions(ctx context.Context) ([]m
 }
 
 func (r *ExhibitionRepository) GetPreviouslyExhibitions(ctx context.Context) ([]model.ResponseExhibition, error) {
-	matchStage := bson.M{"$match": bson.M{
-		"isPublic": true,
-		"endDate":  bson.M{"$lt": time.Now().Format("2006-01-02T15:04:05.000Z")},
-	}}
+	filter := publishedFilter()
+	filter["endDate"] = bson.M{"$lt": time.Now().Format("2006-01-02T15:04:05.000Z")}
+	matchStage := bson.M{"$match": filter}
 	sortStage := bson.M{"$sort": bson.M{"startDate": 1}}
 	pipeline := []bson.M{matchStage, sortStage}
 	cursor, err := r.Collection.Aggregate(ctx, pipeline)
@@ -675,10 +686,9 @@ func (r *ExhibitionRepository) GetPreviouslyExhibitions(ctx context.Context) ([]
 }
 
 func (r *ExhibitionRepository) GetUpcomingExhibitions(ctx context.Context) ([]model.ResponseExhibition, error) {
-	matchStage := bson.M{"$match": bson.M{
-		"isPublic":  true,
-		"startDate": bson.M{"$gt": time.Now().Format("2006-01-02T15:04:05.000Z")},
-	}}
+	filter := publishedFilter()
+	filter["startDate"] = bson.M{"$gt": time.Now().Format("2006-01-02T15:04:05.000Z")}
+	matchStage := bson.M{"$match": filter}
 	sortStage := bson.M{"$sort": bson.M{"startDate": 1}}
 	pipeline := []bson.M{matchStage, sortStage}
 	cursor, err := r.Collection.Aggregate(ctx, pipeline)
@@ -750,14 +760,14 @@ func (r *ExhibitionRepository) GetExhibitionSectionInfo(ctx context.Context, exh
 	}
 
 	// Define the match stage for the aggregation pipeline
-	matchStage := bson.D{{"$match", bson.D{
-		{"_id", objectID},
+	matchStage := bson.D{{Key: "$match", Value: bson.D{
+		{Key: "_id", Value: objectID},
 	}}}
 
 	// Define the project stage to extract only the exhibitionSectionsID field
-	projectStage := bson.D{{"$project", bson.D{
-		{"_id", 0}, // Exclude _id field
-		{"exhibitionSectionsID", 1},
+	projectStage := bson.D{{Key: "$project", Value: bson.D{
+		{Key: "_id", Value: 0}, // Exclude _id field
+		{Key: "exhibitionSectionsID", Value: 1},
 	}}}
 
 	// Aggregate pipeline
@@ -796,12 +806,99 @@ func (r *ExhibitionRepository) GetExhibitionSectionInfo(ctx context.Context, exh
 	return infos, nil
 }
 
-// BanExhibition bans an exhibition by ID
-func (r *ExhibitionRepository) BanExhibition(ctx context.Context, exhibitionID string) error {
+// UpdateMediaRights replaces the rights metadata of the media an exhibition uses.
+func (r *ExhibitionRepository) UpdateMediaRights(ctx context.Context, exhibitionID string, rights []model.MediaRights) error {
 	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
 	if err != nil {
-		return err
+		return cerr.ErrExhibitionNotFound
 	}
-	_, err = r.Collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$set": bson.M{"status": "banned"}})
+
+	return r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		if err := tx.Track(event.ExhibitionCollection, objectID); err != nil {
+			return err
+		}
+		result, err := r.Collection.UpdateOne(tx, bson.M{"_id": objectID}, bson.M{"$set": bson.M{"mediaRights": rights}})
+		if err != nil {
+			return err
+		}
+
+		if result.MatchedCount == 0 {
+			return cerr.ErrExhibitionNotFound
+		}
+		return nil
+	})
+}
+
+// EnsureIndexes creates the full text index over the texts of exhibitions in every locale and
+// the geospatial index of venues. Each entry of the search index is analysed in its own language.
+func (r *ExhibitionRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "searchIndex.text", Value: "text"}},
+			Options: options.Index().
+				SetName("search").
+				SetDefaultLanguage("none").
+				SetLanguageOverride("language"),
+		},
+		{Keys: bson.D{{Key: "venue.location", Value: "2dsphere"}}},
+	})
 	return err
 }
+
+// SearchExhibitions finds published exhibitions matching a full text search, best matches
+// first. language is the MongoDB text search language the search terms are analysed in.
+func (r *ExhibitionRepository) SearchExhibitions(ctx context.Context, text, language string, limit int) ([]model.ResponseExhibition, error) {
+	filter := publishedFilter()
+	filter["$text"] = bson.M{"$search": text, "$language": language}
+
+	score := bson.M{"score": bson.M{"$meta": "textScore"}}
+	opts := options.Find().SetProjection(score).SetSort(score)
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	exhibitions := []model.ResponseExhibition{}
+	if err := cursor.All(ctx, &exhibitions); err != nil {
+		return nil, err
+	}
+
+	return exhibitions, nil
+}
+
+// GetNearbyExhibitions finds published exhibitions whose venue lies within the radius of a
+// place, nearest first.
+func (r *ExhibitionRepository) GetNearbyExhibitions(ctx context.Context, query model.NearbyQuery) ([]model.ResponseNearbyExhibition, error) {
+	pipeline := []bson.M{
+		// $geoNear must be the first stage and sorts by distance itself
+		{"$geoNear": bson.M{
+			"near":          bson.M{"type": model.GeoPointType, "coordinates": []float64{query.Longitude, query.Latitude}},
+			"key":           "venue.location",
+			"distanceField": "distance",
+			"maxDistance":   query.Radius,
+			"spherical":     true,
+			"query":         publishedFilter(),
+		}},
+	}
+	if query.Limit > 0 {
+		pipeline = append(pipeline, bson.M{"$limit": query.Limit})
+	}
+
+	cursor, err := r.Collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	exhibitions := []model.ResponseNearbyExhibition{}
+	if err := cursor.All(ctx, &exhibitions); err != nil {
+		return nil, err
+	}
+
+	return exhibitions, nil
+}
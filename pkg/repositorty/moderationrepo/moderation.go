@@ -0,0 +1,365 @@
+package moderationrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections holding the reports of exhibitions, the actions moderators took and the
+// appeals of the owners.
+const (
+	ReportCollection = "exhibitionReports"
+	ActionCollection = "moderationActions"
+	AppealCollection = "moderationAppeals"
+)
+
+type IModerationRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreateReport(ctx context.Context, report *model.Report) (*primitive.ObjectID, error)
+	GetQueue(ctx context.Context, query model.ModerationQuery) ([]model.ModerationCase, error)
+	GetReports(ctx context.Context, exhibitionID string, status string) ([]model.Report, error)
+	ApplyAction(ctx context.Context, action *model.ModerationAction) error
+	GetActions(ctx context.Context, exhibitionID string) ([]model.ModerationAction, error)
+	GetActionByID(ctx context.Context, actionID string) (*model.ModerationAction, error)
+	CreateAppeal(ctx context.Context, appeal *model.Appeal) (*primitive.ObjectID, error)
+	GetAppeals(ctx context.Context, exhibitionID *primitive.ObjectID, status string) ([]model.Appeal, error)
+	GetAppealByID(ctx context.Context, appealID string) (*model.Appeal, error)
+	DecideAppeal(ctx context.Context, appeal *model.Appeal) error
+}
+
+// ModerationRepository is the MongoDB implementation of the Repository interface.
+type ModerationRepository struct {
+	ReportCollection     *mongo.Collection
+	ActionCollection     *mongo.Collection
+	AppealCollection     *mongo.Collection
+	ExhibitionCollection *mongo.Collection
+	Outbox               outboxrepo.IOutboxRepository
+}
+
+// NewModerationRepository creates a new instance of ModerationRepository.
+func NewModerationRepository(client *mongo.Client, databaseName string) *ModerationRepository {
+	db := client.Database(databaseName)
+	return &ModerationRepository{
+		ReportCollection:     db.Collection(ReportCollection),
+		ActionCollection:     db.Collection(ActionCollection),
+		AppealCollection:     db.Collection(AppealCollection),
+		ExhibitionCollection: db.Collection(event.ExhibitionCollection),
+		Outbox:               outboxrepo.NewOutboxRepository(client, databaseName),
+	}
+}
+
+// EnsureIndexes creates the unique indexes that keep a visitor from reporting an exhibition
+// again while the report is open and an action from being appealed twice, and the indexes
+// used to build the queue and list the history of an exhibition.
+func (r *ModerationRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.ReportCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "exhibitionID", Value: 1}, {Key: "reporterID", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": model.ReportOpen}),
+		},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "exhibitionID", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.ActionCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "exhibitionID", Value: 1}, {Key: "createdAt", Value: -1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.AppealCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "actionID", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "createdAt", Value: 1}}},
+		{Keys: bson.D{{Key: "exhibitionID", Value: 1}, {Key: "createdAt", Value: -1}}},
+	})
+	return err
+}
+
+// CreateReport stores an open report. A visitor has one open report per exhibition.
+func (r *ModerationRepository) CreateReport(ctx context.Context, report *model.Report) (*primitive.ObjectID, error) {
+	result, err := r.ReportCollection.InsertOne(ctx, report)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, cerr.ErrAlreadyReported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id := result.InsertedID.(primitive.ObjectID)
+	return &id, nil
+}
+
+// GetQueue gathers the open reports per exhibition, the most reported first, along with the
+// count of every category. Reports of deleted exhibitions are left out.
+func (r *ModerationRepository) GetQueue(ctx context.Context, query model.ModerationQuery) ([]model.ModerationCase, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"status": model.ReportOpen}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":             bson.M{"exhibitionID": "$exhibitionID", "category": "$category"},
+			"count":           bson.M{"$sum": 1},
+			"firstReportedAt": bson.M{"$min": "$createdAt"},
+			"lastReportedAt":  bson.M{"$max": "$createdAt"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id.category", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":             "$_id.exhibitionID",
+			"openReports":     bson.M{"$sum": "$count"},
+			"categories":      bson.M{"$push": bson.M{"category": "$_id.category", "count": "$count"}},
+			"firstReportedAt": bson.M{"$min": "$firstReportedAt"},
+			"lastReportedAt":  bson.M{"$max": "$lastReportedAt"},
+		}}},
+	}
+	if query.Category != "" {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"categories.category": query.Category}}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "openReports", Value: -1}, {Key: "lastReportedAt", Value: -1}}}},
+		bson.D{{Key: "$lookup", Value: bson.M{
+			"from":         r.ExhibitionCollection.Name(),
+			"localField":   "_id",
+			"foreignField": "_id",
+			"as":           "exhibition",
+		}}},
+		bson.D{{Key: "$unwind", Value: "$exhibition"}},
+		bson.D{{Key: "$limit", Value: query.Limit}},
+		bson.D{{Key: "$set", Value: bson.M{
+			"exhibitionName": "$exhibition.exhibitionName",
+			"ownerID":        "$exhibition.userId.userId",
+			"status":         "$exhibition.status",
+			"isPublic":       "$exhibition.isPublic",
+			"moderation":     "$exhibition.moderation",
+		}}},
+		bson.D{{Key: "$unset", Value: "exhibition"}},
+	)
+
+	cursor, err := r.ReportCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	cases := []model.ModerationCase{}
+	if err := cursor.All(ctx, &cases); err != nil {
+		return nil, err
+	}
+
+	return cases, nil
+}
+
+// GetReports retrieves the reports of an exhibition, the latest first. Status is empty to
+// include resolved reports.
+func (r *ModerationRepository) GetReports(ctx context.Context, exhibitionID string, status string) ([]model.Report, error) {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return nil, cerr.ErrExhibitionNotFound
+	}
+
+	filter := bson.M{"exhibitionID": objectID}
+	if status != "" {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	cursor, err := r.ReportCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	reports := []model.Report{}
+	if err := cursor.All(ctx, &reports); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// ApplyAction changes the exhibition as the action requires, keeps the action as its
+// moderation outcome, resolves its open reports and stores the action, in one transaction.
+// The change of the outcome is what tells the owner through the events of the exhibition.
+func (r *ModerationRepository) ApplyAction(ctx context.Context, action *model.ModerationAction) error {
+	return r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		if err := tx.Track(event.ExhibitionCollection, action.ExhibitionID); err != nil {
+			return err
+		}
+
+		filter := moderation.Precondition(action.Action)
+		filter["_id"] = action.ExhibitionID
+		set := moderation.Effect(action.Action)
+		set["moderation"] = model.ModerationOutcome{
+			ActionID:    action.ID,
+			Action:      action.Action,
+			Reason:      action.Reason,
+			ModeratedAt: action.CreatedAt,
+		}
+		result, err := r.ExhibitionCollection.UpdateOne(tx, filter, bson.M{"$set": set})
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return r.missingOr(tx, action.ExhibitionID, cerr.ErrModerationNotApplicable)
+		}
+
+		resolved, err := r.ReportCollection.UpdateMany(tx,
+			bson.M{"exhibitionID": action.ExhibitionID, "status": model.ReportOpen},
+			bson.M{"$set": bson.M{
+				"status":     model.ReportResolved,
+				"actionID":   action.ID,
+				"resolvedAt": action.CreatedAt,
+			}},
+		)
+		if err != nil {
+			return err
+		}
+		action.Reports = resolved.ModifiedCount
+
+		_, err = r.ActionCollection.InsertOne(tx, action)
+		return err
+	})
+}
+
+// GetActions retrieves the actions taken on an exhibition, the latest first.
+func (r *ModerationRepository) GetActions(ctx context.Context, exhibitionID string) ([]model.ModerationAction, error) {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return nil, cerr.ErrExhibitionNotFound
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	cursor, err := r.ActionCollection.Find(ctx, bson.M{"exhibitionID": objectID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	actions := []model.ModerationAction{}
+	if err := cursor.All(ctx, &actions); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+func (r *ModerationRepository) GetActionByID(ctx context.Context, actionID string) (*model.ModerationAction, error) {
+	objectID, err := primitive.ObjectIDFromHex(actionID)
+	if err != nil {
+		return nil, cerr.ErrModerationNotFound
+	}
+
+	var action model.ModerationAction
+	if err := r.ActionCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&action); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrModerationNotFound
+		}
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// CreateAppeal stores a pending appeal. An action is appealed once.
+func (r *ModerationRepository) CreateAppeal(ctx context.Context, appeal *model.Appeal) (*primitive.ObjectID, error) {
+	result, err := r.AppealCollection.InsertOne(ctx, appeal)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, cerr.ErrAlreadyAppealed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id := result.InsertedID.(primitive.ObjectID)
+	return &id, nil
+}
+
+// GetAppeals retrieves the appeals of an exhibition, or of every exhibition when it is nil,
+// the oldest first. Status is empty to include every appeal.
+func (r *ModerationRepository) GetAppeals(ctx context.Context, exhibitionID *primitive.ObjectID, status string) ([]model.Appeal, error) {
+	filter := bson.M{}
+	if exhibitionID != nil {
+		filter["exhibitionID"] = *exhibitionID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	cursor, err := r.AppealCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	appeals := []model.Appeal{}
+	if err := cursor.All(ctx, &appeals); err != nil {
+		return nil, err
+	}
+
+	return appeals, nil
+}
+
+func (r *ModerationRepository) GetAppealByID(ctx context.Context, appealID string) (*model.Appeal, error) {
+	objectID, err := primitive.ObjectIDFromHex(appealID)
+	if err != nil {
+		return nil, cerr.ErrAppealNotFound
+	}
+
+	var appeal model.Appeal
+	if err := r.AppealCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&appeal); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrAppealNotFound
+		}
+		return nil, err
+	}
+
+	return &appeal, nil
+}
+
+// DecideAppeal records the decision of a pending appeal. It fails with ErrAppealDecided when
+// another moderator decided it first.
+func (r *ModerationRepository) DecideAppeal(ctx context.Context, appeal *model.Appeal) error {
+	result, err := r.AppealCollection.UpdateOne(ctx,
+		bson.M{"_id": appeal.ID, "status": model.AppealPending},
+		bson.M{"$set": bson.M{
+			"status":    appeal.Status,
+			"decision":  appeal.Decision,
+			"decidedBy": appeal.DecidedBy,
+			"decidedAt": appeal.DecidedAt,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrAppealDecided
+	}
+	return nil
+}
+
+// missingOr returns ErrExhibitionNotFound when the exhibition does not exist, otherwise err.
+func (r *ModerationRepository) missingOr(ctx context.Context, objectID primitive.ObjectID, err error) error {
+	count, countErr := r.ExhibitionCollection.CountDocuments(ctx, bson.M{"_id": objectID})
+	if countErr != nil {
+		return countErr
+	}
+	if count == 0 {
+		return cerr.ErrExhibitionNotFound
+	}
+	return err
+}
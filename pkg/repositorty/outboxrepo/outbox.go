@@ -0,0 +1,254 @@
+package outboxrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections holding the outbox, the last sequence number of every exhibition and the
+// leases of the relays.
+const (
+	Collection         = "outbox"
+	SequenceCollection = "outboxSequences"
+	LeaseCollection    = "outboxLeases"
+)
+
+// Retention is how long published messages are kept.
+const Retention = 7 * 24 * time.Hour
+
+type IOutboxRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Write(ctx context.Context, write func(tx *Tx) error) error
+	GetPending(ctx context.Context, limit int64) ([]model.OutboxMessage, error)
+	MarkPublished(ctx context.Context, messageID primitive.ObjectID, publishedAt time.Time) error
+	RecordFailure(ctx context.Context, messageID primitive.ObjectID, reason string) error
+	AcquireLease(ctx context.Context, name, owner string, now time.Time, duration time.Duration) (bool, error)
+}
+
+// OutboxRepository is the MongoDB implementation of the Repository interface.
+type OutboxRepository struct {
+	Collection         *mongo.Collection
+	SequenceCollection *mongo.Collection
+	LeaseCollection    *mongo.Collection
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository.
+func NewOutboxRepository(client *mongo.Client, databaseName string) *OutboxRepository {
+	db := client.Database(databaseName)
+	return &OutboxRepository{
+		Collection:         db.Collection(Collection),
+		SequenceCollection: db.Collection(SequenceCollection),
+		LeaseCollection:    db.Collection(LeaseCollection),
+	}
+}
+
+// CheckTransactions checks that the deployment a client is connected to supports the
+// transactions writes run in, which standalone servers do not. Replica sets, including a single
+// member one, and sharded clusters do.
+func CheckTransactions(ctx context.Context, client *mongo.Client) error {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return err
+	}
+
+	// mongos answers with msg isdbgrid instead of a replica set name
+	if hello.SetName == "" && hello.Msg != "isdbgrid" {
+		return errors.New("MongoDB is a standalone server, but writes run in transactions, which need a replica set: start mongod with --replSet and run rs.initiate()")
+	}
+	return nil
+}
+
+// Tx is a write running in a transaction. It is the context the operations of the write run
+// with, and it remembers the documents they change so their events can be recorded.
+type Tx struct {
+	mongo.SessionContext
+	db      *mongo.Database
+	tracked []trackedDocument
+}
+
+type trackedDocument struct {
+	collection string
+	id         primitive.ObjectID
+	before     bson.Raw
+}
+
+// Track remembers a document as it is before the write changes or deletes it.
+func (tx *Tx) Track(collection string, id primitive.ObjectID) error {
+	if tx.isTracked(collection, id) {
+		return nil
+	}
+	before, err := tx.find(collection, id)
+	if err != nil {
+		return err
+	}
+	tx.tracked = append(tx.tracked, trackedDocument{collection: collection, id: id, before: before})
+	return nil
+}
+
+// Inserted remembers a document the write inserted.
+func (tx *Tx) Inserted(collection string, id primitive.ObjectID) {
+	if !tx.isTracked(collection, id) {
+		tx.tracked = append(tx.tracked, trackedDocument{collection: collection, id: id})
+	}
+}
+
+func (tx *Tx) isTracked(collection string, id primitive.ObjectID) bool {
+	for _, document := range tx.tracked {
+		if document.collection == collection && document.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// find returns a document, or nil when there is none.
+func (tx *Tx) find(collection string, id primitive.ObjectID) (bson.Raw, error) {
+	document, err := tx.db.Collection(collection).FindOne(tx, bson.M{"_id": id}).Raw()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	return document, err
+}
+
+// EnsureIndexes creates the index the relay finds pending messages with in the order they
+// are published, and the index expiring published messages.
+func (r *OutboxRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "published", Value: 1}, {Key: "event.sequence", Value: 1}, {Key: "_id", Value: 1}}},
+		{
+			Keys:    bson.D{{Key: "publishedAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(Retention.Seconds())),
+		},
+	})
+	return err
+}
+
+// Write runs a write in a transaction and records the events of the documents it tracked in
+// the same transaction, so either both the write and its events are stored or neither is.
+// The write may run more than once when the transaction is retried.
+func (r *OutboxRepository) Write(ctx context.Context, write func(tx *Tx) error) error {
+	session, err := r.Collection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		tx := &Tx{SessionContext: sessionContext, db: r.Collection.Database()}
+		if err := write(tx); err != nil {
+			return nil, err
+		}
+		return nil, r.record(tx)
+	})
+	return err
+}
+
+// record appends the events of the documents a write changed to the outbox, numbering them
+// per exhibition. Concurrent writes to the same exhibition conflict on its sequence number,
+// so the numbers follow the order the writes commit in.
+func (r *OutboxRepository) record(tx *Tx) error {
+	now := time.Now()
+	for _, document := range tx.tracked {
+		after, err := tx.find(document.collection, document.id)
+		if err != nil {
+			return err
+		}
+		domainEvent, ok := event.FromWrite(document.collection, document.id, document.before, after, now)
+		if !ok {
+			continue
+		}
+
+		var counter struct {
+			Sequence int64 `bson:"sequence"`
+		}
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+		err = r.SequenceCollection.FindOneAndUpdate(tx,
+			bson.M{"_id": domainEvent.ExhibitionID},
+			bson.M{"$inc": bson.M{"sequence": 1}},
+			opts,
+		).Decode(&counter)
+		if err != nil {
+			return err
+		}
+
+		message := model.OutboxMessage{ID: primitive.NewObjectID(), Event: *domainEvent, CreatedAt: now}
+		message.Event.ID = message.ID.Hex()
+		message.Event.Sequence = counter.Sequence
+		if _, err := r.Collection.InsertOne(tx, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPending retrieves the messages waiting to be published, in the order of their sequence
+// numbers. An event of an exhibition therefore always comes after the events before it.
+func (r *OutboxRepository) GetPending(ctx context.Context, limit int64) ([]model.OutboxMessage, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "event.sequence", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(limit)
+	cursor, err := r.Collection.Find(ctx, bson.M{"published": false}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	messages := []model.OutboxMessage{}
+	if err := cursor.All(ctx, &messages); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, messageID primitive.ObjectID, publishedAt time.Time) error {
+	_, err := r.Collection.UpdateOne(ctx,
+		bson.M{"_id": messageID},
+		bson.M{"$set": bson.M{"published": true, "publishedAt": publishedAt}},
+	)
+	return err
+}
+
+// RecordFailure counts a failed attempt to publish a message and keeps the reason.
+func (r *OutboxRepository) RecordFailure(ctx context.Context, messageID primitive.ObjectID, reason string) error {
+	_, err := r.Collection.UpdateOne(ctx,
+		bson.M{"_id": messageID},
+		bson.M{"$inc": bson.M{"attempts": 1}, "$set": bson.M{"lastError": reason}},
+	)
+	return err
+}
+
+// AcquireLease takes or renews the named lease for the owner until now plus the duration.
+// It returns false while another owner holds the lease.
+func (r *OutboxRepository) AcquireLease(ctx context.Context, name, owner string, now time.Time, duration time.Duration) (bool, error) {
+	_, err := r.LeaseCollection.UpdateOne(ctx,
+		bson.M{
+			"_id": name,
+			"$or": bson.A{
+				bson.M{"owner": owner},
+				bson.M{"expiresAt": bson.M{"$lte": now}},
+			},
+		},
+		bson.M{"$set": bson.M{"owner": owner, "expiresAt": now.Add(duration)}},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		// The lease exists and belongs to someone else
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,127 @@
+package poirepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type IPointOfInterestRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreatePointOfInterest(ctx context.Context, point *model.PointOfInterest) (*primitive.ObjectID, error)
+	GetPointOfInterestByID(ctx context.Context, pointID string) (*model.PointOfInterest, error)
+	UpdatePointOfInterest(ctx context.Context, point *model.PointOfInterest) error
+	DeletePointOfInterest(ctx context.Context, pointID string) error
+	GetExhibitionLayout(ctx context.Context, exhibitionID primitive.ObjectID) (string, error)
+}
+
+// PointOfInterestRepository is the MongoDB implementation of the Repository interface.
+// It also reads exhibitions to check which layout they use.
+type PointOfInterestRepository struct {
+	Collection           *mongo.Collection
+	ExhibitionCollection *mongo.Collection
+}
+
+// NewPointOfInterestRepository creates a new instance of PointOfInterestRepository.
+func NewPointOfInterestRepository(client *mongo.Client, databaseName string) *PointOfInterestRepository {
+	db := client.Database(databaseName)
+	return &PointOfInterestRepository{
+		Collection:           db.Collection(layout.PointsOfInterestCollection),
+		ExhibitionCollection: db.Collection("exhibitions"),
+	}
+}
+
+// EnsureIndexes creates the index used to load the points of an exhibition in order and the
+// geospatial index of their locations.
+func (r *PointOfInterestRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "exhibitionID", Value: 1}, {Key: "order", Value: 1}}},
+		{Keys: bson.D{{Key: "location", Value: "2dsphere"}}},
+	})
+	return err
+}
+
+func (r *PointOfInterestRepository) CreatePointOfInterest(ctx context.Context, point *model.PointOfInterest) (*primitive.ObjectID, error) {
+	result, err := r.Collection.InsertOne(ctx, point)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted point of interest ID")
+	}
+
+	return &objectID, nil
+}
+
+func (r *PointOfInterestRepository) GetPointOfInterestByID(ctx context.Context, pointID string) (*model.PointOfInterest, error) {
+	objectID, err := primitive.ObjectIDFromHex(pointID)
+	if err != nil {
+		return nil, cerr.ErrPointOfInterestNotFound
+	}
+
+	var point model.PointOfInterest
+	if err := r.Collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&point); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrPointOfInterestNotFound
+		}
+		return nil, err
+	}
+
+	return &point, nil
+}
+
+// UpdatePointOfInterest replaces the content of a point, keeping the exhibition it belongs to.
+func (r *PointOfInterestRepository) UpdatePointOfInterest(ctx context.Context, point *model.PointOfInterest) error {
+	update := bson.M{"$set": bson.M{
+		"order":        point.Order,
+		"title":        point.Title,
+		"text":         point.Text,
+		"address":      point.Address,
+		"location":     point.Location,
+		"media":        point.Media,
+		"translations": point.Translations,
+	}}
+
+	result, err := r.Collection.UpdateOne(ctx, bson.M{"_id": point.ID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrPointOfInterestNotFound
+	}
+
+	return nil
+}
+
+func (r *PointOfInterestRepository) DeletePointOfInterest(ctx context.Context, pointID string) error {
+	objectID, err := primitive.ObjectIDFromHex(pointID)
+	if err != nil {
+		return cerr.ErrPointOfInterestNotFound
+	}
+
+	result, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return cerr.ErrPointOfInterestNotFound
+	}
+
+	return nil
+}
+
+// GetExhibitionLayout returns the layout an exhibition uses.
+func (r *PointOfInterestRepository) GetExhibitionLayout(ctx context.Context, exhibitionID primitive.ObjectID) (string, error) {
+	return layout.Of(ctx, r.ExhibitionCollection, exhibitionID)
+}
@@ -0,0 +1,134 @@
+package profilerepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/profile"
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections holding the latest profiles of the users and the exhibitions keeping copies of
+// them.
+const (
+	Collection           = "userProfiles"
+	ExhibitionCollection = "exhibitions"
+)
+
+type IProfileRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	SaveProfile(ctx context.Context, user *model.UserProfile) (bool, error)
+	GetProfile(ctx context.Context, userID primitive.ObjectID) (*model.UserProfile, error)
+	GetProfiles(ctx context.Context, after primitive.ObjectID, limit int64) ([]model.UserProfile, error)
+	ApplyProfile(ctx context.Context, user *model.UserProfile) (*model.ProfileSync, error)
+}
+
+// ProfileRepository is the MongoDB implementation of the Repository interface.
+type ProfileRepository struct {
+	Collection           *mongo.Collection
+	ExhibitionCollection *mongo.Collection
+}
+
+// NewProfileRepository creates a new instance of ProfileRepository.
+func NewProfileRepository(client *mongo.Client, databaseName string) *ProfileRepository {
+	db := client.Database(databaseName)
+	return &ProfileRepository{
+		Collection:           db.Collection(Collection),
+		ExhibitionCollection: db.Collection(ExhibitionCollection),
+	}
+}
+
+// EnsureIndexes creates the indexes finding the exhibitions a user owns or collaborates on.
+func (r *ProfileRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.ExhibitionCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "userId.userId", Value: 1}}},
+		{Keys: bson.D{{Key: "collaborators.userId", Value: 1}}},
+	})
+	return err
+}
+
+// SaveProfile stores a profile unless the stored profile of the user changed later. It
+// returns false when the profile is older and was not stored.
+func (r *ProfileRepository) SaveProfile(ctx context.Context, user *model.UserProfile) (bool, error) {
+	_, err := r.Collection.UpdateOne(ctx,
+		bson.M{"_id": user.UserID, "changedAt": bson.M{"$lt": user.ChangedAt}},
+		bson.M{"$set": user},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		// The stored profile is as recent or more recent
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetProfile retrieves the stored profile of a user, or nil when there is none.
+func (r *ProfileRepository) GetProfile(ctx context.Context, userID primitive.ObjectID) (*model.UserProfile, error) {
+	var user model.UserProfile
+	err := r.Collection.FindOne(ctx, bson.M{"_id": userID}).Decode(&user)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetProfiles retrieves the stored profiles in the order of their user IDs, starting after
+// the given one.
+func (r *ProfileRepository) GetProfiles(ctx context.Context, after primitive.ObjectID, limit int64) ([]model.UserProfile, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit)
+	cursor, err := r.Collection.Find(ctx, bson.M{"_id": bson.M{"$gt": after}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	users := []model.UserProfile{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// ApplyProfile brings the copies of a profile kept by the exhibitions the user owns or
+// collaborates on in line with it, and counts the exhibitions that differed.
+//
+// The copies are not part of the exhibition content, so unlike the other writes of
+// exhibitions the update records no events in the outbox.
+func (r *ProfileRepository) ApplyProfile(ctx context.Context, user *model.UserProfile) (*model.ProfileSync, error) {
+	ownerDrifted := profile.Drifted("userId.", user)
+	ownerDrifted["userId.userId"] = user.UserID
+	owned, err := r.ExhibitionCollection.UpdateMany(ctx, ownerDrifted, profile.Set("userId.", user))
+	if err != nil {
+		return nil, err
+	}
+
+	collaboratorDrifted := profile.Drifted("", user)
+	collaboratorDrifted["userId"] = user.UserID
+	collaborated, err := r.ExhibitionCollection.UpdateMany(ctx,
+		bson.M{"collaborators": bson.M{"$elemMatch": collaboratorDrifted}},
+		profile.Set("collaborators.$[c].", user),
+		options.Update().SetArrayFilters(options.ArrayFilters{
+			Filters: []interface{}{bson.M{"c.userId": user.UserID}},
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ProfileSync{
+		UserID:         user.UserID,
+		Exhibitions:    owned.ModifiedCount,
+		Collaborations: collaborated.ModifiedCount,
+	}, nil
+}
@@ -0,0 +1,292 @@
+package quizrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections holding quizzes and the attempts of visitors.
+const (
+	Collection        = "exhibitionQuizzes"
+	AttemptCollection = "quizAttempts"
+)
+
+type IQuizRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreateQuiz(ctx context.Context, quiz *model.Quiz) (*primitive.ObjectID, error)
+	GetQuizzes(ctx context.Context, query model.QuizQuery) ([]model.Quiz, error)
+	GetQuizByID(ctx context.Context, quizID string) (*model.Quiz, error)
+	UpdateQuiz(ctx context.Context, quiz *model.Quiz) error
+	DeleteQuiz(ctx context.Context, quizID string) error
+	CreateAttempt(ctx context.Context, attempt *model.QuizAttempt) (*primitive.ObjectID, error)
+	GetAttempts(ctx context.Context, quizID, userID primitive.ObjectID) ([]model.QuizAttempt, error)
+	GetQuizTally(ctx context.Context, quizID primitive.ObjectID) (*model.QuizTally, error)
+}
+
+// QuizRepository is the MongoDB implementation of the Repository interface.
+type QuizRepository struct {
+	Collection        *mongo.Collection
+	AttemptCollection *mongo.Collection
+}
+
+// NewQuizRepository creates a new instance of QuizRepository.
+func NewQuizRepository(client *mongo.Client, databaseName string) *QuizRepository {
+	db := client.Database(databaseName)
+	return &QuizRepository{
+		Collection:        db.Collection(Collection),
+		AttemptCollection: db.Collection(AttemptCollection),
+	}
+}
+
+// EnsureIndexes creates the indexes used to list quizzes and the attempt history of a user.
+func (r *QuizRepository) EnsureIndexes(ctx context.Context) error {
+	if _, err := r.Collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "exhibitionID", Value: 1}},
+	}); err != nil {
+		return err
+	}
+
+	_, err := r.AttemptCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "quizID", Value: 1}, {Key: "userID", Value: 1}, {Key: "submittedAt", Value: -1}}},
+		{Keys: bson.D{{Key: "exhibitionID", Value: 1}}},
+	})
+	return err
+}
+
+func (r *QuizRepository) CreateQuiz(ctx context.Context, quiz *model.Quiz) (*primitive.ObjectID, error) {
+	result, err := r.Collection.InsertOne(ctx, quiz)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted quiz ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetQuizzes retrieves the quizzes of an exhibition in the order they were created.
+func (r *QuizRepository) GetQuizzes(ctx context.Context, query model.QuizQuery) ([]model.Quiz, error) {
+	filter := bson.M{"exhibitionID": query.ExhibitionID}
+	if query.SectionID != nil {
+		filter["sectionId"] = *query.SectionID
+	}
+	if query.RoomID != nil {
+		filter["roomId"] = *query.RoomID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := r.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	quizzes := []model.Quiz{}
+	if err := cursor.All(ctx, &quizzes); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return quizzes, nil
+}
+
+func (r *QuizRepository) GetQuizByID(ctx context.Context, quizID string) (*model.Quiz, error) {
+	objectID, err := primitive.ObjectIDFromHex(quizID)
+	if err != nil {
+		return nil, cerr.ErrQuizNotFound
+	}
+
+	var quiz model.Quiz
+	if err := r.Collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&quiz); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrQuizNotFound
+		}
+		return nil, err
+	}
+
+	return &quiz, nil
+}
+
+// UpdateQuiz replaces the content of a quiz, keeping the exhibition it belongs to.
+func (r *QuizRepository) UpdateQuiz(ctx context.Context, quiz *model.Quiz) error {
+	set := bson.M{
+		"title":       quiz.Title,
+		"description": quiz.Description,
+		"questions":   quiz.Questions,
+		"updatedAt":   quiz.UpdatedAt,
+	}
+
+	// A quiz moves between the exhibition, a section and a room
+	unset := bson.M{}
+	if quiz.SectionID != nil {
+		set["sectionId"] = *quiz.SectionID
+	} else {
+		unset["sectionId"] = ""
+	}
+	if quiz.RoomID != nil {
+		set["roomId"] = *quiz.RoomID
+	} else {
+		unset["roomId"] = ""
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	result, err := r.Collection.UpdateOne(ctx, bson.M{"_id": quiz.ID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrQuizNotFound
+	}
+
+	return nil
+}
+
+// DeleteQuiz deletes a quiz together with its attempts.
+func (r *QuizRepository) DeleteQuiz(ctx context.Context, quizID string) error {
+	objectID, err := primitive.ObjectIDFromHex(quizID)
+	if err != nil {
+		return cerr.ErrQuizNotFound
+	}
+
+	result, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return cerr.ErrQuizNotFound
+	}
+
+	_, err = r.AttemptCollection.DeleteMany(ctx, bson.M{"quizID": objectID})
+	return err
+}
+
+func (r *QuizRepository) CreateAttempt(ctx context.Context, attempt *model.QuizAttempt) (*primitive.ObjectID, error) {
+	result, err := r.AttemptCollection.InsertOne(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted attempt ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetAttempts retrieves the attempts of a user at a quiz, newest first.
+func (r *QuizRepository) GetAttempts(ctx context.Context, quizID, userID primitive.ObjectID) ([]model.QuizAttempt, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "submittedAt", Value: -1}})
+	cursor, err := r.AttemptCollection.Find(ctx, bson.M{"quizID": quizID, "userID": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	attempts := []model.QuizAttempt{}
+	if err := cursor.All(ctx, &attempts); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return attempts, nil
+}
+
+// GetQuizTally counts the attempts of a quiz, their answers and their correct answers per
+// question, and how often each option was picked.
+func (r *QuizRepository) GetQuizTally(ctx context.Context, quizID primitive.ObjectID) (*model.QuizTally, error) {
+	answers := []bson.M{
+		{"$unwind": "$answers"},
+		{"$match": bson.M{"answers.options.0": bson.M{"$exists": true}}},
+	}
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"quizID": quizID}},
+		{"$facet": bson.M{
+			"totals": []bson.M{
+				{"$group": bson.M{
+					"_id":          nil,
+					"attempts":     bson.M{"$sum": 1},
+					"averageScore": bson.M{"$avg": "$score"},
+				}},
+			},
+			"participants": []bson.M{
+				{"$group": bson.M{"_id": "$userID"}},
+				{"$count": "count"},
+			},
+			"answered": append(answers,
+				bson.M{"$group": bson.M{"_id": "$answers.question", "count": bson.M{"$sum": 1}}},
+			),
+			"correct": []bson.M{
+				{"$unwind": "$results"},
+				{"$match": bson.M{"results.correct": true}},
+				{"$group": bson.M{"_id": "$results.question", "count": bson.M{"$sum": 1}}},
+			},
+			"options": []bson.M{
+				{"$unwind": "$answers"},
+				{"$unwind": "$answers.options"},
+				{"$group": bson.M{
+					"_id":   bson.M{"question": "$answers.question", "option": "$answers.options"},
+					"count": bson.M{"$sum": 1},
+				}},
+				{"$project": bson.M{"_id": 0, "question": "$_id.question", "option": "$_id.option", "count": 1}},
+			},
+		}},
+	}
+
+	cursor, err := r.AttemptCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregation error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var facets []struct {
+		Totals []struct {
+			Attempts     int     `bson:"attempts"`
+			AverageScore float64 `bson:"averageScore"`
+		} `bson:"totals"`
+		Participants []struct {
+			Count int `bson:"count"`
+		} `bson:"participants"`
+		Answered []model.QuestionCount `bson:"answered"`
+		Correct  []model.QuestionCount `bson:"correct"`
+		Options  []model.OptionTally   `bson:"options"`
+	}
+	if err := cursor.All(ctx, &facets); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	tally := &model.QuizTally{}
+	if len(facets) == 0 {
+		return tally, nil
+	}
+
+	facet := facets[0]
+	tally.Answered, tally.Correct, tally.Options = facet.Answered, facet.Correct, facet.Options
+	if len(facet.Totals) > 0 {
+		tally.Attempts = facet.Totals[0].Attempts
+		tally.AverageScore = facet.Totals[0].AverageScore
+	}
+	if len(facet.Participants) > 0 {
+		tally.Participants = facet.Participants[0].Count
+	}
+
+	return tally, nil
+}
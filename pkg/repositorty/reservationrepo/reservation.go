@@ -0,0 +1,288 @@
+package reservationrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections holding time slots and reservations.
+const (
+	SlotCollection        = "exhibitionTimeSlots"
+	ReservationCollection = "reservations"
+)
+
+type IReservationRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreateTimeSlot(ctx context.Context, slot *model.TimeSlot) (*primitive.ObjectID, error)
+	GetTimeSlots(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.TimeSlot, error)
+	GetTimeSlotByID(ctx context.Context, slotID string) (*model.TimeSlot, error)
+	UpdateTimeSlot(ctx context.Context, slot *model.TimeSlot) error
+	DeleteTimeSlot(ctx context.Context, slotID primitive.ObjectID) error
+	ReserveSeats(ctx context.Context, exhibitionID, slotID primitive.ObjectID, seats int) error
+	ReleaseSeats(ctx context.Context, slotID primitive.ObjectID, seats int) error
+	CreateReservation(ctx context.Context, reservation *model.Reservation) (*primitive.ObjectID, error)
+	GetReservationByID(ctx context.Context, reservationID string) (*model.Reservation, error)
+	GetReservationsByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Reservation, error)
+	UpdateReservationStatus(ctx context.Context, reservationID primitive.ObjectID, from, to string, at time.Time) error
+}
+
+// ReservationRepository is the MongoDB implementation of the Repository interface.
+// Each time slot counts its booked seats, so a booking is a single conditional update.
+type ReservationRepository struct {
+	SlotCollection        *mongo.Collection
+	ReservationCollection *mongo.Collection
+}
+
+// NewReservationRepository creates a new instance of ReservationRepository.
+func NewReservationRepository(client *mongo.Client, databaseName string) *ReservationRepository {
+	db := client.Database(databaseName)
+	return &ReservationRepository{
+		SlotCollection:        db.Collection(SlotCollection),
+		ReservationCollection: db.Collection(ReservationCollection),
+	}
+}
+
+// EnsureIndexes creates the indexes used to list slots and reservations, and the index that
+// allows a user one confirmed reservation per slot.
+func (r *ReservationRepository) EnsureIndexes(ctx context.Context) error {
+	if _, err := r.SlotCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "exhibitionID", Value: 1}, {Key: "startsAt", Value: 1}},
+	}); err != nil {
+		return err
+	}
+
+	_, err := r.ReservationCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "userID", Value: 1}, {Key: "createdAt", Value: -1}}},
+		{Keys: bson.D{{Key: "exhibitionID", Value: 1}}},
+		{
+			Keys: bson.D{{Key: "slotID", Value: 1}, {Key: "userID", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": model.ReservationConfirmed}),
+		},
+	})
+	return err
+}
+
+func (r *ReservationRepository) CreateTimeSlot(ctx context.Context, slot *model.TimeSlot) (*primitive.ObjectID, error) {
+	result, err := r.SlotCollection.InsertOne(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted time slot ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetTimeSlots retrieves the time slots of an exhibition in chronological order.
+func (r *ReservationRepository) GetTimeSlots(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.TimeSlot, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "startsAt", Value: 1}})
+	cursor, err := r.SlotCollection.Find(ctx, bson.M{"exhibitionID": exhibitionID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	slots := []model.TimeSlot{}
+	if err := cursor.All(ctx, &slots); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return slots, nil
+}
+
+func (r *ReservationRepository) GetTimeSlotByID(ctx context.Context, slotID string) (*model.TimeSlot, error) {
+	objectID, err := primitive.ObjectIDFromHex(slotID)
+	if err != nil {
+		return nil, cerr.ErrTimeSlotNotFound
+	}
+
+	var slot model.TimeSlot
+	if err := r.SlotCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&slot); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrTimeSlotNotFound
+		}
+		return nil, err
+	}
+
+	return &slot, nil
+}
+
+// UpdateTimeSlot changes the window and capacity of a slot. The capacity is only lowered
+// while it still holds the seats booked at the time of the update.
+func (r *ReservationRepository) UpdateTimeSlot(ctx context.Context, slot *model.TimeSlot) error {
+	filter := bson.M{"_id": slot.ID, "booked": bson.M{"$lte": slot.Capacity}}
+	update := bson.M{"$set": bson.M{
+		"startsAt": slot.StartsAt,
+		"endsAt":   slot.EndsAt,
+		"capacity": slot.Capacity,
+	}}
+
+	result, err := r.SlotCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return r.slotError(ctx, slot.ID, cerr.ErrCapacityBelowBookings)
+	}
+
+	return nil
+}
+
+// DeleteTimeSlot deletes a slot nobody has booked.
+func (r *ReservationRepository) DeleteTimeSlot(ctx context.Context, slotID primitive.ObjectID) error {
+	result, err := r.SlotCollection.DeleteOne(ctx, bson.M{"_id": slotID, "booked": 0})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return r.slotError(ctx, slotID, cerr.ErrTimeSlotHasReservations)
+	}
+
+	return nil
+}
+
+// ReserveSeats books seats in a slot that has not ended. The check and the increment are one
+// update, so concurrent bookings never exceed the capacity.
+func (r *ReservationRepository) ReserveSeats(ctx context.Context, exhibitionID, slotID primitive.ObjectID, seats int) error {
+	filter := bson.M{
+		"_id":          slotID,
+		"exhibitionID": exhibitionID,
+		"endsAt":       bson.M{"$gt": time.Now()},
+		"$expr": bson.M{"$lte": bson.A{
+			bson.M{"$add": bson.A{"$booked", seats}},
+			"$capacity",
+		}},
+	}
+
+	result, err := r.SlotCollection.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"booked": seats}})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		slot, err := r.GetTimeSlotByID(ctx, slotID.Hex())
+		switch {
+		case err != nil:
+			return err
+		case slot.ExhibitionID != exhibitionID:
+			return cerr.ErrTimeSlotNotFound
+		case !slot.EndsAt.After(time.Now()):
+			return cerr.ErrTimeSlotClosed
+		default:
+			return cerr.ErrTimeSlotFull
+		}
+	}
+
+	return nil
+}
+
+// ReleaseSeats gives booked seats of a slot back.
+func (r *ReservationRepository) ReleaseSeats(ctx context.Context, slotID primitive.ObjectID, seats int) error {
+	_, err := r.SlotCollection.UpdateOne(ctx, bson.M{"_id": slotID}, bson.M{"$inc": bson.M{"booked": -seats}})
+	return err
+}
+
+// CreateReservation stores a reservation. It fails with ErrAlreadyReserved when the user
+// already holds a confirmed reservation for the slot.
+func (r *ReservationRepository) CreateReservation(ctx context.Context, reservation *model.Reservation) (*primitive.ObjectID, error) {
+	result, err := r.ReservationCollection.InsertOne(ctx, reservation)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, cerr.ErrAlreadyReserved
+		}
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted reservation ID")
+	}
+
+	return &objectID, nil
+}
+
+func (r *ReservationRepository) GetReservationByID(ctx context.Context, reservationID string) (*model.Reservation, error) {
+	objectID, err := primitive.ObjectIDFromHex(reservationID)
+	if err != nil {
+		return nil, cerr.ErrReservationNotFound
+	}
+
+	var reservation model.Reservation
+	if err := r.ReservationCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&reservation); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrReservationNotFound
+		}
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+// GetReservationsByUserID retrieves the reservations of a user, newest first.
+func (r *ReservationRepository) GetReservationsByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Reservation, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	cursor, err := r.ReservationCollection.Find(ctx, bson.M{"userID": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	reservations := []model.Reservation{}
+	if err := cursor.All(ctx, &reservations); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return reservations, nil
+}
+
+// UpdateReservationStatus moves a reservation from one status to another and records when.
+// Only one of several concurrent updates from the same status succeeds; the others get
+// ErrReservationNotActive.
+func (r *ReservationRepository) UpdateReservationStatus(ctx context.Context, reservationID primitive.ObjectID, from, to string, at time.Time) error {
+	set := bson.M{"status": to}
+	switch to {
+	case model.ReservationCancelled:
+		set["cancelledAt"] = at
+	case model.ReservationCheckedIn:
+		set["checkedInAt"] = at
+	}
+
+	result, err := r.ReservationCollection.UpdateOne(ctx, bson.M{"_id": reservationID, "status": from}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		if _, err := r.GetReservationByID(ctx, reservationID.Hex()); err != nil {
+			return err
+		}
+		return cerr.ErrReservationNotActive
+	}
+
+	return nil
+}
+
+// slotError explains why a conditional update of a slot matched nothing.
+func (r *ReservationRepository) slotError(ctx context.Context, slotID primitive.ObjectID, conflict error) error {
+	if _, err := r.GetTimeSlotByID(ctx, slotID.Hex()); err != nil {
+		return err
+	}
+	return conflict
+}
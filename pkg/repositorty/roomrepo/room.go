@@ -1,13 +1,12 @@
 package roomrepo
 
 import (
+	"atommuse/backend/exhibition-service/pkg/event"
 	"atommuse/backend/exhibition-service/pkg/model"
-	"atommuse/backend/exhibition-service/pkg/utils"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
 	"context"
 	"errors"
 	"fmt"
-	"log"
-	"os"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -23,20 +22,31 @@ type IRoomRepository interface {
 	UpdateExhibitionRoom(ctx context.Context, RoomID string, updatedRoom *model.RequestUpdateExhibitionRoom) (*primitive.ObjectID, error)
 }
 
-// RoomRepository is the MongoDB implementation of the Repository interface.
+// RoomRepository is the MongoDB implementation of the Repository interface. Writes record
+// their events in the outbox in the same transaction.
 type RoomRepository struct {
 	Collection *mongo.Collection
+	Outbox     outboxrepo.IOutboxRepository
 }
 
 func (r *RoomRepository) CreateExhibitionRoom(ctx context.Context, Room *model.RequestCreateExhibitionRoom) (*primitive.ObjectID, error) {
-	result, err := r.Collection.InsertOne(ctx, Room)
-	if err != nil {
-		return nil, err
-	}
+	var objectID primitive.ObjectID
+	err := r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		result, err := r.Collection.InsertOne(tx, Room)
+		if err != nil {
+			return err
+		}
 
-	// Extract the generated ObjectID from the result
-	objectID, ok := result.InsertedID.(primitive.ObjectID)
-	if !ok {
+		// Extract the generated ObjectID from the result
+		id, ok := result.InsertedID.(primitive.ObjectID)
+		if !ok {
+			return errors.New("invalid inserted exhibitionRoom ID")
+		}
+		objectID = id
+		tx.Inserted(event.RoomCollection, objectID)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -50,85 +60,69 @@ func (r *RoomRepository) DeleteExhibitionRoomByID(ctx context.Context, RoomID st
 		return fmt.Errorf("invalid exhibition ID format: %v", err)
 	}
 
-	// Define the match stage for the aggregation pipeline
-	matchStage := bson.M{"$match": bson.M{"_id": objectID}}
-
-	// Aggregate pipeline
-	pipeline := []bson.M{matchStage}
-
-	// Execute the aggregation
-	cursor, err := r.Collection.Aggregate(ctx, pipeline)
-	if err != nil {
-		return err
-	}
-	defer cursor.Close(ctx)
-
-	// Check if any result is found
-	if !cursor.Next(ctx) {
-		return fmt.Errorf("exhibitionRoom not found for ID %s", RoomID)
-	}
+	// Specify the collection names
+	exhibitionCollection := r.Collection.Database().Collection("exhibitions")
 
-	// Decode the main document (assuming ResponseExhibitionRoom is the type of your MongoDB documents)
-	Room := model.Room{}
-	if err := cursor.Decode(&Room); err != nil {
-		return err
-	}
+	return r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		// Define the match stage for the aggregation pipeline
+		matchStage := bson.M{"$match": bson.M{"_id": objectID}}
 
-	mongoURI := os.Getenv("MONGO_URI")
-	if mongoURI == "" {
-		log.Fatal("MONGO_URI environment variable not set.")
-	}
-	log.Println("MongoURI:", mongoURI)
+		// Aggregate pipeline
+		pipeline := []bson.M{matchStage}
 
-	client, err := utils.ConnectToMongoDB(mongoURI)
-	if err != nil {
-		log.Fatal("Error connecting to MongoDB:", err)
-	}
-	defer func() {
-		if err := client.Disconnect(context.Background()); err != nil {
-			log.Println("Error disconnecting from MongoDB:", err)
+		// Execute the aggregation
+		cursor, err := r.Collection.Aggregate(tx, pipeline)
+		if err != nil {
+			return err
 		}
-	}()
+		defer cursor.Close(tx)
 
-	// Specify the collection names
-	exhibitionCollection := client.Database("atommuse").Collection("exhibitions")
+		// Check if any result is found
+		if !cursor.Next(tx) {
+			return fmt.Errorf("exhibitionRoom not found for ID %s", RoomID)
+		}
 
-	// Define the filter to match the main exhibition document
-	mainExhibitionFilter := bson.M{"_id": Room.ExhibitionID}
+		// Decode the main document
+		Room := model.Room{}
+		if err := cursor.Decode(&Room); err != nil {
+			return err
+		}
 
-	// Define the update to pull the RoomID from the array
-	update := bson.M{"$pull": bson.M{"roomsID": RoomID}}
+		if err := tx.Track(event.ExhibitionCollection, Room.ExhibitionID); err != nil {
+			return err
+		}
+		if err := tx.Track(event.RoomCollection, objectID); err != nil {
+			return err
+		}
 
-	// Perform the update operation on the main exhibition document
-	updateResult, err := exhibitionCollection.UpdateMany(ctx, mainExhibitionFilter, update)
-	if err != nil {
-		return err
-	}
+		// Define the filter to match the main exhibition document
+		mainExhibitionFilter := bson.M{"_id": Room.ExhibitionID}
 
-	// Check if any document was modified
-	if updateResult.ModifiedCount == 0 {
-		return fmt.Errorf("exhibitionRoom not found for ID %s", RoomID)
-	}
+		// Define the update to pull the RoomID from the array
+		update := bson.M{"$pull": bson.M{"roomsID": RoomID}}
 
-	// Perform the deletion
-	deleteResult, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
-	if err != nil {
-		return err
-	}
+		// Perform the update operation on the main exhibition document
+		updateResult, err := exhibitionCollection.UpdateMany(tx, mainExhibitionFilter, update)
+		if err != nil {
+			return err
+		}
 
-	if deleteResult.DeletedCount == 0 {
-		return fmt.Errorf("exhibitionRoom not deleted for ID %s", RoomID)
-	}
+		// Check if any document was modified
+		if updateResult.ModifiedCount == 0 {
+			return fmt.Errorf("exhibitionRoom not found for ID %s", RoomID)
+		}
 
-	// Check if exactly one document was deleted
-	if deleteResult.DeletedCount == 1 {
-		// Assuming UpdateExhibition takes a context, an ExhibitionID, and an update as arguments
+		// Perform the deletion
+		deleteResult, err := r.Collection.DeleteOne(tx, bson.M{"_id": objectID})
 		if err != nil {
 			return err
 		}
-	}
 
-	return nil
+		if deleteResult.DeletedCount == 0 {
+			return fmt.Errorf("exhibitionRoom not deleted for ID %s", RoomID)
+		}
+		return nil
+	})
 }
 
 func (r *RoomRepository) GetExhibitionRoomByID(ctx context.Context, RoomID string) (*model.ResponseExhibitionRoom, error) {
@@ -237,18 +231,26 @@ func (r *RoomRepository) UpdateExhibitionRoom(ctx context.Context, roomID string
 		"left":         updatedRoom.Left,
 		"center":       updatedRoom.Center,
 		"right":        updatedRoom.Right,
-		"exhibitionID": updatedRoom.ExhibitionID,
 	}
 
 	// Perform update operation
-	result, err := r.Collection.UpdateOne(ctx, filter, updateDoc)
+	err = r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		if err := tx.Track(event.RoomCollection, objectID); err != nil {
+			return err
+		}
+		result, err := r.Collection.UpdateOne(tx, filter, updateDoc)
+		if err != nil {
+			return err
+		}
+
+		if result.ModifiedCount == 0 {
+			return errors.New("no exhibition room updated")
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if result.ModifiedCount == 0 {
-		return nil, errors.New("no exhibition room updated")
-	}
-
 	return &objectID, nil
 }
@@ -0,0 +1,234 @@
+package screeningrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
+	"atommuse/backend/exhibition-service/pkg/repositorty/moderationrepo"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RuleCollection holds the screening rules.
+const RuleCollection = "screeningRules"
+
+type IScreeningRepository interface {
+	CreateRule(ctx context.Context, rule *model.ScreeningRule) (*primitive.ObjectID, error)
+	GetRules(ctx context.Context, enabledOnly bool) ([]model.ScreeningRule, error)
+	GetRuleByID(ctx context.Context, ruleID string) (*model.ScreeningRule, error)
+	UpdateRule(ctx context.Context, rule *model.ScreeningRule) error
+	DeleteRule(ctx context.Context, ruleID string) error
+	HoldForReview(ctx context.Context, exhibitionID primitive.ObjectID, report *model.Report) (*model.ScreeningHold, error)
+	ReleaseHold(ctx context.Context, exhibitionID primitive.ObjectID, hold *model.ScreeningHold) error
+}
+
+// ScreeningRepository is the MongoDB implementation of the Repository interface.
+type ScreeningRepository struct {
+	RuleCollection       *mongo.Collection
+	ReportCollection     *mongo.Collection
+	ExhibitionCollection *mongo.Collection
+	Outbox               outboxrepo.IOutboxRepository
+}
+
+// NewScreeningRepository creates a new instance of ScreeningRepository.
+func NewScreeningRepository(client *mongo.Client, databaseName string) *ScreeningRepository {
+	db := client.Database(databaseName)
+	return &ScreeningRepository{
+		RuleCollection:       db.Collection(RuleCollection),
+		ReportCollection:     db.Collection(moderationrepo.ReportCollection),
+		ExhibitionCollection: db.Collection(event.ExhibitionCollection),
+		Outbox:               outboxrepo.NewOutboxRepository(client, databaseName),
+	}
+}
+
+func (r *ScreeningRepository) CreateRule(ctx context.Context, rule *model.ScreeningRule) (*primitive.ObjectID, error) {
+	result, err := r.RuleCollection.InsertOne(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted screening rule ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetRules retrieves the screening rules in the order they were created, or only the enabled
+// ones.
+func (r *ScreeningRepository) GetRules(ctx context.Context, enabledOnly bool) ([]model.ScreeningRule, error) {
+	filter := bson.M{}
+	if enabledOnly {
+		filter["enabled"] = true
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	cursor, err := r.RuleCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	rules := []model.ScreeningRule{}
+	if err := cursor.All(ctx, &rules); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return rules, nil
+}
+
+func (r *ScreeningRepository) GetRuleByID(ctx context.Context, ruleID string) (*model.ScreeningRule, error) {
+	objectID, err := primitive.ObjectIDFromHex(ruleID)
+	if err != nil {
+		return nil, cerr.ErrScreeningRuleNotFound
+	}
+
+	var rule model.ScreeningRule
+	if err := r.RuleCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&rule); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrScreeningRuleNotFound
+		}
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// UpdateRule replaces the words or pattern, severity and state of a rule.
+func (r *ScreeningRepository) UpdateRule(ctx context.Context, rule *model.ScreeningRule) error {
+	result, err := r.RuleCollection.UpdateOne(ctx, bson.M{"_id": rule.ID}, bson.M{"$set": bson.M{
+		"name":      rule.Name,
+		"type":      rule.Type,
+		"words":     rule.Words,
+		"pattern":   rule.Pattern,
+		"severity":  rule.Severity,
+		"enabled":   rule.Enabled,
+		"updatedAt": rule.UpdatedAt,
+	}})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrScreeningRuleNotFound
+	}
+
+	return nil
+}
+
+func (r *ScreeningRepository) DeleteRule(ctx context.Context, ruleID string) error {
+	objectID, err := primitive.ObjectIDFromHex(ruleID)
+	if err != nil {
+		return cerr.ErrScreeningRuleNotFound
+	}
+
+	result, err := r.RuleCollection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return cerr.ErrScreeningRuleNotFound
+	}
+
+	return nil
+}
+
+// HoldForReview withdraws an exhibition from publication until a moderator approves it, and
+// files the report putting it in the moderation queue. The matches are added to the open
+// screening report of the exhibition when there is one already. Banned exhibitions are left
+// as they are, and nil is returned for them.
+func (r *ScreeningRepository) HoldForReview(ctx context.Context, exhibitionID primitive.ObjectID, report *model.Report) (*model.ScreeningHold, error) {
+	var hold *model.ScreeningHold
+	err := r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		hold = nil
+		if err := tx.Track(event.ExhibitionCollection, exhibitionID); err != nil {
+			return err
+		}
+
+		var previous struct {
+			Status string `bson:"status"`
+		}
+		err := r.ExhibitionCollection.FindOneAndUpdate(tx,
+			bson.M{"_id": exhibitionID, "status": bson.M{"$ne": moderation.Banned}},
+			bson.M{"$set": bson.M{"status": moderation.Review}},
+			options.FindOneAndUpdate().SetProjection(bson.M{"status": 1}),
+		).Decode(&previous)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			count, err := r.ExhibitionCollection.CountDocuments(tx, bson.M{"_id": exhibitionID})
+			if err != nil {
+				return err
+			}
+			if count == 0 {
+				return cerr.ErrExhibitionNotFound
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		result, err := r.ReportCollection.UpdateOne(tx,
+			bson.M{"exhibitionID": exhibitionID, "reporterID": primitive.NilObjectID, "status": model.ReportOpen},
+			bson.M{
+				"$setOnInsert": bson.M{"category": model.ReportScreening, "createdAt": report.CreatedAt},
+				"$set":         bson.M{"details": report.Details},
+				"$addToSet":    bson.M{"matches": bson.M{"$each": report.Matches}},
+			},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return err
+		}
+
+		hold = &model.ScreeningHold{Status: previous.Status}
+		if reportID, ok := result.UpsertedID.(primitive.ObjectID); ok {
+			hold.ReportID = &reportID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// ReleaseHold lifts a hold, restoring the status the exhibition had and withdrawing the report
+// the hold filed. Exhibitions a moderator has acted on in the meantime are left as they are.
+func (r *ScreeningRepository) ReleaseHold(ctx context.Context, exhibitionID primitive.ObjectID, hold *model.ScreeningHold) error {
+	if hold == nil {
+		return nil
+	}
+
+	return r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		if hold.Status != moderation.Review {
+			if err := tx.Track(event.ExhibitionCollection, exhibitionID); err != nil {
+				return err
+			}
+			_, err := r.ExhibitionCollection.UpdateOne(tx,
+				bson.M{"_id": exhibitionID, "status": moderation.Review},
+				bson.M{"$set": bson.M{"status": hold.Status}},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		if hold.ReportID != nil {
+			_, err := r.ReportCollection.DeleteOne(tx, bson.M{"_id": *hold.ReportID, "status": model.ReportOpen})
+			return err
+		}
+		return nil
+	})
+}
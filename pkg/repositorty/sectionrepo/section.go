@@ -1,13 +1,12 @@
 package sectionrepo
 
 import (
+	"atommuse/backend/exhibition-service/pkg/event"
 	"atommuse/backend/exhibition-service/pkg/model"
-	"atommuse/backend/exhibition-service/pkg/utils"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
 	"context"
 	"errors"
 	"fmt"
-	"log"
-	"os"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -23,20 +22,31 @@ type ISectionRepository interface {
 	UpdateExhibitionSection(ctx context.Context, sectionID string, updatedSection *model.RequestUpdateExhibitionSection) (*primitive.ObjectID, error)
 }
 
-// SectionRepository is the MongoDB implementation of the Repository interface.
+// SectionRepository is the MongoDB implementation of the Repository interface. Writes record
+// their events in the outbox in the same transaction.
 type SectionRepository struct {
 	Collection *mongo.Collection
+	Outbox     outboxrepo.IOutboxRepository
 }
 
 func (r *SectionRepository) CreateExhibitionSection(ctx context.Context, section *model.RequestCreateExhibitionSection) (*primitive.ObjectID, error) {
-	result, err := r.Collection.InsertOne(ctx, section)
-	if err != nil {
-		return nil, err
-	}
+	var objectID primitive.ObjectID
+	err := r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		result, err := r.Collection.InsertOne(tx, section)
+		if err != nil {
+			return err
+		}
 
-	// Extract the generated ObjectID from the result
-	objectID, ok := result.InsertedID.(primitive.ObjectID)
-	if !ok {
+		// Extract the generated ObjectID from the result
+		id, ok := result.InsertedID.(primitive.ObjectID)
+		if !ok {
+			return errors.New("invalid inserted exhibitionSection ID")
+		}
+		objectID = id
+		tx.Inserted(event.SectionCollection, objectID)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -50,85 +60,69 @@ func (r *SectionRepository) DeleteExhibitionSectionByID(ctx context.Context, sec
 		return fmt.Errorf("invalid exhibition ID format: %v", err)
 	}
 
-	// Define the match stage for the aggregation pipeline
-	matchStage := bson.M{"$match": bson.M{"_id": objectID}}
-
-	// Aggregate pipeline
-	pipeline := []bson.M{matchStage}
-
-	// Execute the aggregation
-	cursor, err := r.Collection.Aggregate(ctx, pipeline)
-	if err != nil {
-		return err
-	}
-	defer cursor.Close(ctx)
+	// Specify the collection names
+	exhibitionCollection := r.Collection.Database().Collection("exhibitions")
 
-	// Check if any result is found
-	if !cursor.Next(ctx) {
-		return fmt.Errorf("exhibitionSection not found for ID %s", sectionID)
-	}
+	return r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		// Define the match stage for the aggregation pipeline
+		matchStage := bson.M{"$match": bson.M{"_id": objectID}}
 
-	// Decode the main document (assuming ResponseExhibitionSection is the type of your MongoDB documents)
-	section := model.ExhibitionSection{}
-	if err := cursor.Decode(&section); err != nil {
-		return err
-	}
+		// Aggregate pipeline
+		pipeline := []bson.M{matchStage}
 
-	mongoURI := os.Getenv("MONGO_URI")
-	if mongoURI == "" {
-		log.Fatal("MONGO_URI environment variable not set.")
-	}
-	log.Println("MongoURI:", mongoURI)
-
-	client, err := utils.ConnectToMongoDB(mongoURI)
-	if err != nil {
-		log.Fatal("Error connecting to MongoDB:", err)
-	}
-	defer func() {
-		if err := client.Disconnect(context.Background()); err != nil {
-			log.Println("Error disconnecting from MongoDB:", err)
+		// Execute the aggregation
+		cursor, err := r.Collection.Aggregate(tx, pipeline)
+		if err != nil {
+			return err
 		}
-	}()
+		defer cursor.Close(tx)
 
-	// Specify the collection names
-	exhibitionCollection := client.Database("atommuse").Collection("exhibitions")
+		// Check if any result is found
+		if !cursor.Next(tx) {
+			return fmt.Errorf("exhibitionSection not found for ID %s", sectionID)
+		}
 
-	// Define the filter to match the main exhibition document
-	mainExhibitionFilter := bson.M{"_id": section.ExhibitionID}
+		// Decode the main document
+		section := model.ExhibitionSection{}
+		if err := cursor.Decode(&section); err != nil {
+			return err
+		}
 
-	// Define the update to pull the sectionID from the array
-	update := bson.M{"$pull": bson.M{"exhibitionSectionsID": sectionID}}
+		if err := tx.Track(event.ExhibitionCollection, section.ExhibitionID); err != nil {
+			return err
+		}
+		if err := tx.Track(event.SectionCollection, objectID); err != nil {
+			return err
+		}
 
-	// Perform the update operation on the main exhibition document
-	updateResult, err := exhibitionCollection.UpdateMany(ctx, mainExhibitionFilter, update)
-	if err != nil {
-		return err
-	}
+		// Define the filter to match the main exhibition document
+		mainExhibitionFilter := bson.M{"_id": section.ExhibitionID}
 
-	// Check if any document was modified
-	if updateResult.ModifiedCount == 0 {
-		return fmt.Errorf("exhibitionSection not found for ID %s", sectionID)
-	}
+		// Define the update to pull the sectionID from the array
+		update := bson.M{"$pull": bson.M{"exhibitionSectionsID": sectionID}}
 
-	// Perform the deletion
-	deleteResult, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
-	if err != nil {
-		return err
-	}
+		// Perform the update operation on the main exhibition document
+		updateResult, err := exhibitionCollection.UpdateMany(tx, mainExhibitionFilter, update)
+		if err != nil {
+			return err
+		}
 
-	if deleteResult.DeletedCount == 0 {
-		return fmt.Errorf("exhibitionSection not deleted for ID %s", sectionID)
-	}
+		// Check if any document was modified
+		if updateResult.ModifiedCount == 0 {
+			return fmt.Errorf("exhibitionSection not found for ID %s", sectionID)
+		}
 
-	// Check if exactly one document was deleted
-	if deleteResult.DeletedCount == 1 {
-		// Assuming UpdateExhibition takes a context, an ExhibitionID, and an update as arguments
+		// Perform the deletion
+		deleteResult, err := r.Collection.DeleteOne(tx, bson.M{"_id": objectID})
 		if err != nil {
 			return err
 		}
-	}
 
-	return nil
+		if deleteResult.DeletedCount == 0 {
+			return fmt.Errorf("exhibitionSection not deleted for ID %s", sectionID)
+		}
+		return nil
+	})
 }
 
 func (r *SectionRepository) GetExhibitionSectionByID(ctx context.Context, sectionID string) (*model.ResponseExhibitionSection, error) {
@@ -232,27 +226,41 @@ func (r *SectionRepository) UpdateExhibitionSection(ctx context.Context, section
 	updateDoc := bson.M{}
 
 	// Update all fields from the updatedSection
-	updateDoc["$set"] = bson.M{
-		"sectionType":  updatedSection.SectionType,
-		"contentType":  updatedSection.ContentType,
-		"background":   updatedSection.Background,
-		"title":        updatedSection.Title,
-		"text":         updatedSection.Text,
-		"leftCol":      updatedSection.LeftCol,
-		"rightCol":     updatedSection.RightCol,
-		"images":       updatedSection.Images,
-		"exhibitionID": updatedSection.ExhibitionID,
+	setDoc := bson.M{
+		"sectionType": updatedSection.SectionType,
+		"contentType": updatedSection.ContentType,
+		"background":  updatedSection.Background,
+		"title":       updatedSection.Title,
+		"text":        updatedSection.Text,
+		"leftCol":     updatedSection.LeftCol,
+		"rightCol":    updatedSection.RightCol,
+		"images":      updatedSection.Images,
 	}
 
+	// Keep the translations when clients do not send them
+	if updatedSection.Translations != nil {
+		setDoc["translations"] = updatedSection.Translations
+	}
+	updateDoc["$set"] = setDoc
+
 	// Perform update operation
-	result, err := r.Collection.UpdateOne(ctx, filter, updateDoc)
+	err = r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		if err := tx.Track(event.SectionCollection, objectID); err != nil {
+			return err
+		}
+		result, err := r.Collection.UpdateOne(tx, filter, updateDoc)
+		if err != nil {
+			return err
+		}
+
+		if result.ModifiedCount == 0 {
+			return errors.New("no exhibition section updated")
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if result.ModifiedCount == 0 {
-		return nil, errors.New("no exhibition section updated")
-	}
-
 	return &objectID, nil
 }
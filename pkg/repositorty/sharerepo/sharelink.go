@@ -0,0 +1,113 @@
+package sharerepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type IShareLinkRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreateShareLink(ctx context.Context, link *model.ShareLink) (*primitive.ObjectID, error)
+	GetShareLinksByExhibitionID(ctx context.Context, exhibitionID string) ([]model.ShareLink, error)
+	GetShareLinkByTokenHash(ctx context.Context, tokenHash string) (*model.ShareLink, error)
+	RevokeShareLink(ctx context.Context, exhibitionID, linkID string) error
+}
+
+// ShareLinkRepository is the MongoDB implementation of the Repository interface.
+type ShareLinkRepository struct {
+	Collection *mongo.Collection
+}
+
+// EnsureIndexes creates the indexes used to look up share links.
+func (r *ShareLinkRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "tokenHash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "exhibitionID", Value: 1}, {Key: "createdAt", Value: -1}}},
+	})
+	return err
+}
+
+func (r *ShareLinkRepository) CreateShareLink(ctx context.Context, link *model.ShareLink) (*primitive.ObjectID, error) {
+	result, err := r.Collection.InsertOne(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted share link ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetShareLinksByExhibitionID retrieves all share links of an exhibition, newest first.
+func (r *ShareLinkRepository) GetShareLinksByExhibitionID(ctx context.Context, exhibitionID string) ([]model.ShareLink, error) {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exhibition ID format: %v", err)
+	}
+
+	opts := options.Find().SetSort(bson.M{"createdAt": -1})
+	cursor, err := r.Collection.Find(ctx, bson.M{"exhibitionID": objectID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	links := []model.ShareLink{}
+	if err := cursor.All(ctx, &links); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return links, nil
+}
+
+func (r *ShareLinkRepository) GetShareLinkByTokenHash(ctx context.Context, tokenHash string) (*model.ShareLink, error) {
+	var link model.ShareLink
+	err := r.Collection.FindOne(ctx, bson.M{"tokenHash": tokenHash}).Decode(&link)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrShareLinkNotFound
+		}
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// RevokeShareLink marks a share link as revoked so it can no longer be used.
+func (r *ShareLinkRepository) RevokeShareLink(ctx context.Context, exhibitionID, linkID string) error {
+	exhibitionObjectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return fmt.Errorf("invalid exhibition ID format: %v", err)
+	}
+	linkObjectID, err := primitive.ObjectIDFromHex(linkID)
+	if err != nil {
+		return cerr.ErrShareLinkNotFound
+	}
+
+	filter := bson.M{"_id": linkObjectID, "exhibitionID": exhibitionObjectID, "revokedAt": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"revokedAt": time.Now()}}
+
+	result, err := r.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrShareLinkNotFound
+	}
+
+	return nil
+}
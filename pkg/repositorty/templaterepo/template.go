@@ -0,0 +1,304 @@
+package templaterepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
+	"atommuse/backend/exhibition-service/pkg/timeline"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ITemplateRepository interface {
+	GetExhibitionTree(ctx context.Context, exhibitionID string) (*model.ResponseExhibition, error)
+	InsertExhibitionTree(ctx context.Context, exhibition *model.ResponseExhibition) (*primitive.ObjectID, error)
+	ExhibitionNameExists(ctx context.Context, userID primitive.ObjectID, name string) (bool, error)
+	CreateTemplate(ctx context.Context, template *model.ExhibitionTemplate) (*primitive.ObjectID, error)
+	GetTemplates(ctx context.Context, userID primitive.ObjectID) ([]model.ExhibitionTemplate, error)
+	GetTemplateByID(ctx context.Context, templateID string) (*model.ExhibitionTemplate, error)
+	DeleteTemplate(ctx context.Context, templateID string) error
+}
+
+// TemplateRepository is the MongoDB implementation of the Repository interface.
+// It reads and writes whole exhibitions together with their sections, rooms, timeline entries
+// and points of interest.
+type TemplateRepository struct {
+	Collection           *mongo.Collection
+	ExhibitionCollection *mongo.Collection
+	SectionCollection    *mongo.Collection
+	RoomCollection       *mongo.Collection
+	TimelineCollection   *mongo.Collection
+	PointCollection      *mongo.Collection
+	Outbox               outboxrepo.IOutboxRepository
+}
+
+// NewTemplateRepository creates a new instance of TemplateRepository.
+func NewTemplateRepository(client *mongo.Client, databaseName string) *TemplateRepository {
+	db := client.Database(databaseName)
+	return &TemplateRepository{
+		Collection:           db.Collection("exhibitionTemplates"),
+		ExhibitionCollection: db.Collection("exhibitions"),
+		SectionCollection:    db.Collection("exhibitionSections"),
+		RoomCollection:       db.Collection("exhibitionRooms"),
+		TimelineCollection:   db.Collection(layout.TimelineCollection),
+		PointCollection:      db.Collection(layout.PointsOfInterestCollection),
+		Outbox:               outboxrepo.NewOutboxRepository(client, databaseName),
+	}
+}
+
+// GetExhibitionTree retrieves an exhibition with its sections and rooms in their stored order
+// and its timeline entries and points of interest in display order.
+func (r *TemplateRepository) GetExhibitionTree(ctx context.Context, exhibitionID string) (*model.ResponseExhibition, error) {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exhibition ID format: %v", err)
+	}
+
+	var exhibition model.ResponseExhibition
+	if err := r.ExhibitionCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&exhibition); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrExhibitionNotFound
+		}
+		return nil, err
+	}
+
+	// Load sections in the order of exhibitionSectionsID
+	for _, sectionID := range exhibition.ExhibitionSectionsID {
+		sectionObjID, err := primitive.ObjectIDFromHex(sectionID)
+		if err != nil {
+			return nil, err
+		}
+
+		var section model.ExhibitionSection
+		if err := r.SectionCollection.FindOne(ctx, bson.M{"_id": sectionObjID, "exhibitionID": objectID}).Decode(&section); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue
+			}
+			return nil, err
+		}
+		exhibition.ExhibitionSections = append(exhibition.ExhibitionSections, section)
+	}
+
+	// Load rooms in the order of roomsID
+	for _, roomID := range exhibition.RoomsID {
+		roomObjID, err := primitive.ObjectIDFromHex(roomID)
+		if err != nil {
+			return nil, err
+		}
+
+		var room model.Room
+		if err := r.RoomCollection.FindOne(ctx, bson.M{"_id": roomObjID, "exhibitionID": objectID}).Decode(&room); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue
+			}
+			return nil, err
+		}
+		exhibition.Room = append(exhibition.Room, room)
+	}
+
+	cursor, err := r.TimelineCollection.Find(ctx, bson.M{"exhibitionID": objectID})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &exhibition.Timeline); err != nil {
+		return nil, err
+	}
+	timeline.Sort(exhibition.Timeline)
+
+	opts := options.Find().SetSort(bson.D{{Key: "order", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err = r.PointCollection.Find(ctx, bson.M{"exhibitionID": objectID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &exhibition.PointsOfInterest); err != nil {
+		return nil, err
+	}
+
+	return &exhibition, nil
+}
+
+// InsertExhibitionTree stores a new exhibition with its sections, rooms, timeline entries and points of interest under fresh IDs
+// and rewires exhibitionSectionsID and roomsID. Everything is inserted in one transaction that
+// records the creation events of the exhibition, its sections and its rooms.
+func (r *TemplateRepository) InsertExhibitionTree(ctx context.Context, exhibition *model.ResponseExhibition) (*primitive.ObjectID, error) {
+	exhibitionID := primitive.NewObjectID()
+	exhibition.ID = exhibitionID
+	exhibition.ExhibitionSectionsID = nil
+	exhibition.RoomsID = nil
+
+	var sections []interface{}
+	var sectionIDs []primitive.ObjectID
+	for _, section := range exhibition.ExhibitionSections {
+		section.ID = primitive.NewObjectID()
+		section.ExhibitionID = exhibitionID
+		sections = append(sections, section)
+		sectionIDs = append(sectionIDs, section.ID)
+		exhibition.ExhibitionSectionsID = append(exhibition.ExhibitionSectionsID, section.ID.Hex())
+	}
+
+	var rooms []interface{}
+	var roomIDs []primitive.ObjectID
+	for _, room := range exhibition.Room {
+		room.ID = primitive.NewObjectID()
+		room.ExhibitionID = exhibitionID
+		rooms = append(rooms, room)
+		roomIDs = append(roomIDs, room.ID)
+		exhibition.RoomsID = append(exhibition.RoomsID, room.ID.Hex())
+	}
+
+	var entries []interface{}
+	for _, entry := range exhibition.Timeline {
+		entry.ID = primitive.NewObjectID()
+		entry.ExhibitionID = exhibitionID
+		entries = append(entries, entry)
+	}
+
+	var points []interface{}
+	for _, point := range exhibition.PointsOfInterest {
+		point.ID = primitive.NewObjectID()
+		point.ExhibitionID = exhibitionID
+		points = append(points, point)
+	}
+
+	// Sections, rooms, timeline entries and points of interest live in their own collections
+	exhibition.ExhibitionSections = nil
+	exhibition.Room = nil
+	exhibition.Timeline = nil
+	exhibition.PointsOfInterest = nil
+
+	err := r.Outbox.Write(ctx, func(tx *outboxrepo.Tx) error {
+		if _, err := r.ExhibitionCollection.InsertOne(tx, exhibition); err != nil {
+			return err
+		}
+		tx.Inserted(event.ExhibitionCollection, exhibitionID)
+
+		if len(sections) > 0 {
+			if _, err := r.SectionCollection.InsertMany(tx, sections); err != nil {
+				return fmt.Errorf("error inserting sections: %w", err)
+			}
+		}
+		for _, id := range sectionIDs {
+			tx.Inserted(event.SectionCollection, id)
+		}
+
+		if len(rooms) > 0 {
+			if _, err := r.RoomCollection.InsertMany(tx, rooms); err != nil {
+				return fmt.Errorf("error inserting rooms: %w", err)
+			}
+		}
+		for _, id := range roomIDs {
+			tx.Inserted(event.RoomCollection, id)
+		}
+
+		if len(entries) > 0 {
+			if _, err := r.TimelineCollection.InsertMany(tx, entries); err != nil {
+				return fmt.Errorf("error inserting timeline entries: %w", err)
+			}
+		}
+
+		if len(points) > 0 {
+			if _, err := r.PointCollection.InsertMany(tx, points); err != nil {
+				return fmt.Errorf("error inserting points of interest: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &exhibitionID, nil
+}
+
+// ExhibitionNameExists reports whether the user already owns an exhibition with the given name.
+func (r *TemplateRepository) ExhibitionNameExists(ctx context.Context, userID primitive.ObjectID, name string) (bool, error) {
+	count, err := r.ExhibitionCollection.CountDocuments(ctx, bson.M{"userId.userId": userID, "exhibitionName": name}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *TemplateRepository) CreateTemplate(ctx context.Context, template *model.ExhibitionTemplate) (*primitive.ObjectID, error) {
+	result, err := r.Collection.InsertOne(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted template ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetTemplates retrieves the system templates and the templates saved by the user.
+// Sections and rooms are left out of the listing.
+func (r *TemplateRepository) GetTemplates(ctx context.Context, userID primitive.ObjectID) ([]model.ExhibitionTemplate, error) {
+	filter := bson.M{"$or": []bson.M{
+		{"scope": model.TemplateScopeSystem},
+		{"scope": model.TemplateScopeUser, "ownerId": userID},
+	}}
+	opts := options.Find().
+		SetProjection(bson.M{"sections": 0, "rooms": 0}).
+		SetSort(bson.D{{Key: "scope", Value: 1}, {Key: "createdAt", Value: -1}})
+
+	cursor, err := r.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	templates := []model.ExhibitionTemplate{}
+	if err := cursor.All(ctx, &templates); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return templates, nil
+}
+
+func (r *TemplateRepository) GetTemplateByID(ctx context.Context, templateID string) (*model.ExhibitionTemplate, error) {
+	objectID, err := primitive.ObjectIDFromHex(templateID)
+	if err != nil {
+		return nil, cerr.ErrTemplateNotFound
+	}
+
+	var template model.ExhibitionTemplate
+	if err := r.Collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&template); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (r *TemplateRepository) DeleteTemplate(ctx context.Context, templateID string) error {
+	objectID, err := primitive.ObjectIDFromHex(templateID)
+	if err != nil {
+		return cerr.ErrTemplateNotFound
+	}
+
+	result, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return cerr.ErrTemplateNotFound
+	}
+
+	return nil
+}
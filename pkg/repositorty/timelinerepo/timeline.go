@@ -0,0 +1,144 @@
+package timelinerepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ITimelineRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreateTimelineEntry(ctx context.Context, entry *model.TimelineEntry) (*primitive.ObjectID, error)
+	GetTimelineEntries(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.TimelineEntry, error)
+	GetTimelineEntryByID(ctx context.Context, entryID string) (*model.TimelineEntry, error)
+	UpdateTimelineEntry(ctx context.Context, entry *model.TimelineEntry) error
+	DeleteTimelineEntry(ctx context.Context, entryID string) error
+	GetExhibitionLayout(ctx context.Context, exhibitionID primitive.ObjectID) (string, error)
+}
+
+// TimelineRepository is the MongoDB implementation of the Repository interface.
+// It also reads exhibitions to check which layout they use.
+type TimelineRepository struct {
+	Collection           *mongo.Collection
+	ExhibitionCollection *mongo.Collection
+}
+
+// NewTimelineRepository creates a new instance of TimelineRepository.
+func NewTimelineRepository(client *mongo.Client, databaseName string) *TimelineRepository {
+	db := client.Database(databaseName)
+	return &TimelineRepository{
+		Collection:           db.Collection(layout.TimelineCollection),
+		ExhibitionCollection: db.Collection("exhibitions"),
+	}
+}
+
+// EnsureIndexes creates the index used to load the timeline of an exhibition.
+func (r *TimelineRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "exhibitionID", Value: 1}, {Key: "startDate", Value: 1}},
+	})
+	return err
+}
+
+func (r *TimelineRepository) CreateTimelineEntry(ctx context.Context, entry *model.TimelineEntry) (*primitive.ObjectID, error) {
+	result, err := r.Collection.InsertOne(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted timeline entry ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetTimelineEntries retrieves the entries of an exhibition in no particular order. Dates of
+// different precision do not sort as strings, so callers sort with timeline.Sort.
+func (r *TimelineRepository) GetTimelineEntries(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.TimelineEntry, error) {
+	cursor, err := r.Collection.Find(ctx, bson.M{"exhibitionID": exhibitionID})
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := []model.TimelineEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return entries, nil
+}
+
+func (r *TimelineRepository) GetTimelineEntryByID(ctx context.Context, entryID string) (*model.TimelineEntry, error) {
+	objectID, err := primitive.ObjectIDFromHex(entryID)
+	if err != nil {
+		return nil, cerr.ErrTimelineEntryNotFound
+	}
+
+	var entry model.TimelineEntry
+	if err := r.Collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&entry); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrTimelineEntryNotFound
+		}
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// UpdateTimelineEntry replaces the content of an entry, keeping the exhibition it belongs to.
+func (r *TimelineRepository) UpdateTimelineEntry(ctx context.Context, entry *model.TimelineEntry) error {
+	update := bson.M{"$set": bson.M{
+		"startDate":    entry.StartDate,
+		"endDate":      entry.EndDate,
+		"era":          entry.Era,
+		"title":        entry.Title,
+		"text":         entry.Text,
+		"media":        entry.Media,
+		"translations": entry.Translations,
+	}}
+
+	result, err := r.Collection.UpdateOne(ctx, bson.M{"_id": entry.ID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrTimelineEntryNotFound
+	}
+
+	return nil
+}
+
+func (r *TimelineRepository) DeleteTimelineEntry(ctx context.Context, entryID string) error {
+	objectID, err := primitive.ObjectIDFromHex(entryID)
+	if err != nil {
+		return cerr.ErrTimelineEntryNotFound
+	}
+
+	result, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return cerr.ErrTimelineEntryNotFound
+	}
+
+	return nil
+}
+
+// GetExhibitionLayout returns the layout an exhibition uses.
+func (r *TimelineRepository) GetExhibitionLayout(ctx context.Context, exhibitionID primitive.ObjectID) (string, error) {
+	return layout.Of(ctx, r.ExhibitionCollection, exhibitionID)
+}
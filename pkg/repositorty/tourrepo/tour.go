@@ -0,0 +1,152 @@
+package tourrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection holds the tours of all exhibitions.
+const Collection = "exhibitionTours"
+
+type ITourRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreateTour(ctx context.Context, tour *model.Tour) (*primitive.ObjectID, error)
+	GetToursByExhibitionID(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.Tour, error)
+	GetTourByID(ctx context.Context, tourID string) (*model.Tour, error)
+	UpdateTour(ctx context.Context, tour *model.Tour) error
+	SetStopAudio(ctx context.Context, tourID primitive.ObjectID, field string, audio string) error
+	DeleteTour(ctx context.Context, tourID string) error
+}
+
+// TourRepository is the MongoDB implementation of the Repository interface.
+type TourRepository struct {
+	Collection *mongo.Collection
+}
+
+// NewTourRepository creates a new instance of TourRepository.
+func NewTourRepository(client *mongo.Client, databaseName string) *TourRepository {
+	return &TourRepository{
+		Collection: client.Database(databaseName).Collection(Collection),
+	}
+}
+
+// EnsureIndexes creates the index used to list the tours of an exhibition.
+func (r *TourRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "exhibitionID", Value: 1}, {Key: "name", Value: 1}},
+	})
+	return err
+}
+
+func (r *TourRepository) CreateTour(ctx context.Context, tour *model.Tour) (*primitive.ObjectID, error) {
+	result, err := r.Collection.InsertOne(ctx, tour)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted tour ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetToursByExhibitionID retrieves the tours of an exhibition sorted by name.
+func (r *TourRepository) GetToursByExhibitionID(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.Tour, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cursor, err := r.Collection.Find(ctx, bson.M{"exhibitionID": exhibitionID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	tours := []model.Tour{}
+	if err := cursor.All(ctx, &tours); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return tours, nil
+}
+
+func (r *TourRepository) GetTourByID(ctx context.Context, tourID string) (*model.Tour, error) {
+	objectID, err := primitive.ObjectIDFromHex(tourID)
+	if err != nil {
+		return nil, cerr.ErrTourNotFound
+	}
+
+	var tour model.Tour
+	if err := r.Collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&tour); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrTourNotFound
+		}
+		return nil, err
+	}
+
+	return &tour, nil
+}
+
+// UpdateTour replaces the content of a tour, keeping the exhibition it belongs to.
+func (r *TourRepository) UpdateTour(ctx context.Context, tour *model.Tour) error {
+	update := bson.M{"$set": bson.M{
+		"name":         tour.Name,
+		"description":  tour.Description,
+		"stops":        tour.Stops,
+		"translations": tour.Translations,
+		"updatedAt":    tour.UpdatedAt,
+	}}
+
+	result, err := r.Collection.UpdateOne(ctx, bson.M{"_id": tour.ID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrTourNotFound
+	}
+
+	return nil
+}
+
+// SetStopAudio sets one audio field of a stop, such as "stops.2.audio" or
+// "stops.2.translations.th.audio", without touching the rest of the tour.
+func (r *TourRepository) SetStopAudio(ctx context.Context, tourID primitive.ObjectID, field string, audio string) error {
+	result, err := r.Collection.UpdateOne(ctx, bson.M{"_id": tourID}, bson.M{"$set": bson.M{field: audio, "updatedAt": time.Now()}})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrTourNotFound
+	}
+
+	return nil
+}
+
+func (r *TourRepository) DeleteTour(ctx context.Context, tourID string) error {
+	objectID, err := primitive.ObjectIDFromHex(tourID)
+	if err != nil {
+		return cerr.ErrTourNotFound
+	}
+
+	result, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return cerr.ErrTourNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,390 @@
+package webhookrepo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections holding webhooks, their deliveries and the positions event consumers resume from.
+const (
+	Collection           = "webhooks"
+	DeliveryCollection   = "webhookDeliveries"
+	CheckpointCollection = "eventCheckpoints"
+)
+
+const (
+	// DeliveryRetention is how long deliveries are kept, including dead ones.
+	DeliveryRetention = 30 * 24 * time.Hour
+	// MaxLoggedAttempts is how many of the latest attempts a delivery keeps in its log.
+	MaxLoggedAttempts = 50
+)
+
+type IWebhookRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	CreateWebhook(ctx context.Context, hook *model.Webhook) (*primitive.ObjectID, error)
+	GetWebhooks(ctx context.Context) ([]model.Webhook, error)
+	GetWebhookByID(ctx context.Context, webhookID string) (*model.Webhook, error)
+	GetSubscribedWebhooks(ctx context.Context, eventType string) ([]model.Webhook, error)
+	UpdateWebhook(ctx context.Context, hook *model.Webhook) error
+	DeleteWebhook(ctx context.Context, webhookID string) error
+	CreateDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error
+	ClaimDueDelivery(ctx context.Context, now time.Time, lease time.Duration) (*model.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, delivery *model.WebhookDelivery, attempt model.DeliveryAttempt) error
+	GetDeliveries(ctx context.Context, query model.DeliveryQuery) ([]model.WebhookDelivery, error)
+	GetDeliveryByID(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error)
+	Redeliver(ctx context.Context, deliveryID string, now time.Time) error
+	GetCheckpoint(ctx context.Context, consumer string) (string, error)
+	SaveCheckpoint(ctx context.Context, consumer, token string) error
+}
+
+// WebhookRepository is the MongoDB implementation of the Repository interface.
+type WebhookRepository struct {
+	Collection           *mongo.Collection
+	DeliveryCollection   *mongo.Collection
+	CheckpointCollection *mongo.Collection
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository.
+func NewWebhookRepository(client *mongo.Client, databaseName string) *WebhookRepository {
+	db := client.Database(databaseName)
+	return &WebhookRepository{
+		Collection:           db.Collection(Collection),
+		DeliveryCollection:   db.Collection(DeliveryCollection),
+		CheckpointCollection: db.Collection(CheckpointCollection),
+	}
+}
+
+// EnsureIndexes creates the indexes used to find due deliveries and list them, the unique
+// index that keeps an event from being queued twice for a webhook, and the index expiring
+// old deliveries.
+func (r *WebhookRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.DeliveryCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "webhookID", Value: 1}, {Key: "event.id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptAt", Value: 1}}},
+		{Keys: bson.D{{Key: "webhookID", Value: 1}, {Key: "createdAt", Value: -1}}},
+		{
+			Keys:    bson.D{{Key: "createdAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(DeliveryRetention.Seconds())),
+		},
+	})
+	return err
+}
+
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, hook *model.Webhook) (*primitive.ObjectID, error) {
+	result, err := r.Collection.InsertOne(ctx, hook)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the generated ObjectID from the result
+	objectID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("invalid inserted webhook ID")
+	}
+
+	return &objectID, nil
+}
+
+// GetWebhooks retrieves every webhook in the order they were registered.
+func (r *WebhookRepository) GetWebhooks(ctx context.Context) ([]model.Webhook, error) {
+	return r.findWebhooks(ctx, bson.M{})
+}
+
+func (r *WebhookRepository) GetWebhookByID(ctx context.Context, webhookID string) (*model.Webhook, error) {
+	objectID, err := primitive.ObjectIDFromHex(webhookID)
+	if err != nil {
+		return nil, cerr.ErrWebhookNotFound
+	}
+
+	var hook model.Webhook
+	if err := r.Collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&hook); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return &hook, nil
+}
+
+// GetSubscribedWebhooks retrieves the active webhooks receiving events of the given type.
+func (r *WebhookRepository) GetSubscribedWebhooks(ctx context.Context, eventType string) ([]model.Webhook, error) {
+	return r.findWebhooks(ctx, bson.M{
+		"active": true,
+		"$or": bson.A{
+			bson.M{"events": bson.M{"$exists": false}},
+			bson.M{"events": eventType},
+		},
+	})
+}
+
+func (r *WebhookRepository) findWebhooks(ctx context.Context, filter bson.M) ([]model.Webhook, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	cursor, err := r.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	hooks := []model.Webhook{}
+	if err := cursor.All(ctx, &hooks); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return hooks, nil
+}
+
+// UpdateWebhook changes the target and subscriptions of a webhook, keeping its secret.
+func (r *WebhookRepository) UpdateWebhook(ctx context.Context, hook *model.Webhook) error {
+	set := bson.M{
+		"url":         hook.URL,
+		"description": hook.Description,
+		"active":      hook.Active,
+		"updatedAt":   hook.UpdatedAt,
+	}
+	update := bson.M{"$set": set}
+	if len(hook.Events) > 0 {
+		set["events"] = hook.Events
+	} else {
+		update["$unset"] = bson.M{"events": ""}
+	}
+
+	result, err := r.Collection.UpdateOne(ctx, bson.M{"_id": hook.ID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// DeleteWebhook deletes a webhook together with its deliveries.
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, webhookID string) error {
+	objectID, err := primitive.ObjectIDFromHex(webhookID)
+	if err != nil {
+		return cerr.ErrWebhookNotFound
+	}
+
+	result, err := r.Collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return cerr.ErrWebhookNotFound
+	}
+
+	_, err = r.DeliveryCollection.DeleteMany(ctx, bson.M{"webhookID": objectID})
+	return err
+}
+
+// CreateDeliveries queues deliveries. Deliveries of an event a webhook already has, queued by
+// another instance or before a restart, are skipped.
+func (r *WebhookRepository) CreateDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, len(deliveries))
+	for i := range deliveries {
+		documents[i] = deliveries[i]
+	}
+
+	_, err := r.DeliveryCollection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	if err != nil && !onlyDuplicateKeys(err) {
+		return err
+	}
+	return nil
+}
+
+// ClaimDueDelivery takes the pending delivery that is due the longest and postpones it by
+// the lease, so that no other worker attempts it meanwhile. It returns nil when no delivery is
+// due.
+func (r *WebhookRepository) ClaimDueDelivery(ctx context.Context, now time.Time, lease time.Duration) (*model.WebhookDelivery, error) {
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var delivery model.WebhookDelivery
+	err := r.DeliveryCollection.FindOneAndUpdate(ctx,
+		bson.M{"status": model.DeliveryPending, "nextAttemptAt": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"nextAttemptAt": now.Add(lease)}},
+		opts,
+	).Decode(&delivery)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// RecordAttempt logs an attempt of a delivery and stores the status, failures and next
+// attempt the delivery has after it.
+func (r *WebhookRepository) RecordAttempt(ctx context.Context, delivery *model.WebhookDelivery, attempt model.DeliveryAttempt) error {
+	set := bson.M{
+		"status":   delivery.Status,
+		"failures": delivery.Failures,
+	}
+	unset := bson.M{}
+	if delivery.NextAttemptAt != nil {
+		set["nextAttemptAt"] = *delivery.NextAttemptAt
+	} else {
+		unset["nextAttemptAt"] = ""
+	}
+	if delivery.DeliveredAt != nil {
+		set["deliveredAt"] = *delivery.DeliveredAt
+	}
+
+	update := bson.M{
+		"$set": set,
+		"$push": bson.M{"attempts": bson.M{
+			"$each":  bson.A{attempt},
+			"$slice": -MaxLoggedAttempts,
+		}},
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	result, err := r.DeliveryCollection.UpdateOne(ctx, bson.M{"_id": delivery.ID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrDeliveryNotFound
+	}
+
+	return nil
+}
+
+// GetDeliveries retrieves the deliveries selected by the query, most recent first, without
+// their attempts.
+func (r *WebhookRepository) GetDeliveries(ctx context.Context, query model.DeliveryQuery) ([]model.WebhookDelivery, error) {
+	filter := bson.M{}
+	if query.WebhookID != nil {
+		filter["webhookID"] = *query.WebhookID
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+		SetLimit(query.Limit).
+		SetProjection(bson.M{"attempts": 0})
+	cursor, err := r.DeliveryCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find error: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	deliveries := []model.WebhookDelivery{}
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, fmt.Errorf("decoding error: %v", err)
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) GetDeliveryByID(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	objectID, err := primitive.ObjectIDFromHex(deliveryID)
+	if err != nil {
+		return nil, cerr.ErrDeliveryNotFound
+	}
+
+	var delivery model.WebhookDelivery
+	if err := r.DeliveryCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&delivery); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, cerr.ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// Redeliver queues a delivery again with a fresh count of failures, whatever its status.
+func (r *WebhookRepository) Redeliver(ctx context.Context, deliveryID string, now time.Time) error {
+	objectID, err := primitive.ObjectIDFromHex(deliveryID)
+	if err != nil {
+		return cerr.ErrDeliveryNotFound
+	}
+
+	result, err := r.DeliveryCollection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{
+		"$set": bson.M{
+			"status":        model.DeliveryPending,
+			"failures":      0,
+			"nextAttemptAt": now,
+		},
+		"$unset": bson.M{"deliveredAt": ""},
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return cerr.ErrDeliveryNotFound
+	}
+
+	return nil
+}
+
+// GetCheckpoint retrieves the event ID a consumer resumes after, or an empty string when the
+// consumer has not seen any event yet.
+func (r *WebhookRepository) GetCheckpoint(ctx context.Context, consumer string) (string, error) {
+	var checkpoint struct {
+		Token string `bson:"token"`
+	}
+	if err := r.CheckpointCollection.FindOne(ctx, bson.M{"_id": consumer}).Decode(&checkpoint); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return checkpoint.Token, nil
+}
+
+// SaveCheckpoint stores the event ID a consumer resumes after.
+func (r *WebhookRepository) SaveCheckpoint(ctx context.Context, consumer, token string) error {
+	_, err := r.CheckpointCollection.UpdateOne(ctx,
+		bson.M{"_id": consumer},
+		bson.M{"$set": bson.M{"token": token, "updatedAt": time.Now()}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// onlyDuplicateKeys reports whether every write of a failed insert was rejected as a
+// duplicate.
+func onlyDuplicateKeys(err error) bool {
+	var bulkError mongo.BulkWriteException
+	if !errors.As(err, &bulkError) || bulkError.WriteConcernError != nil {
+		return false
+	}
+	for _, writeError := range bulkError.WriteErrors {
+		if writeError.Code != 11000 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package reservation
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// QRCodeSize is the width and height of booking QR codes in pixels.
+const QRCodeSize = 256
+
+// SlotError describes why a time slot is invalid.
+type SlotError struct {
+	Problem string
+}
+
+func (e *SlotError) Error() string {
+	return "invalid time slot: " + e.Problem
+}
+
+func (e *SlotError) Unwrap() error {
+	return cerr.ErrInvalidTimeSlot
+}
+
+// ValidateSlot checks that a time slot ends after it starts.
+func ValidateSlot(request *model.RequestTimeSlot) error {
+	if !request.EndsAt.After(request.StartsAt) {
+		return &SlotError{Problem: "endsAt must be after startsAt"}
+	}
+	return nil
+}
+
+// Secret returns the key booking tokens are signed with, configured with RESERVATION_SECRET.
+// It falls back to the key of the authentication tokens, and fails when neither is set, as
+// anyone could sign tokens with an empty key.
+func Secret() ([]byte, error) {
+	if secret := os.Getenv("RESERVATION_SECRET"); secret != "" {
+		return []byte(secret), nil
+	}
+	if secret := os.Getenv("secret_key"); secret != "" {
+		return []byte(secret), nil
+	}
+	return nil, errors.New("RESERVATION_SECRET or secret_key must be set to sign booking tokens")
+}
+
+// Token returns the booking token of a reservation: its ID and a signature of the ID. Tokens
+// are not stored, so the same token can be shown again at any time.
+func Token(reservationID primitive.ObjectID, secret []byte) string {
+	return base64.RawURLEncoding.EncodeToString(reservationID[:]) + "." +
+		base64.RawURLEncoding.EncodeToString(sign(reservationID, secret))
+}
+
+// Verify checks the signature of a booking token and returns the reservation ID it holds.
+func Verify(token string, secret []byte) (primitive.ObjectID, error) {
+	var reservationID primitive.ObjectID
+
+	encodedID, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return reservationID, cerr.ErrInvalidBookingToken
+	}
+	id, err := base64.RawURLEncoding.DecodeString(encodedID)
+	if err != nil || len(id) != len(reservationID) {
+		return reservationID, cerr.ErrInvalidBookingToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return reservationID, cerr.ErrInvalidBookingToken
+	}
+
+	copy(reservationID[:], id)
+	if !hmac.Equal(signature, sign(reservationID, secret)) {
+		return primitive.ObjectID{}, cerr.ErrInvalidBookingToken
+	}
+	return reservationID, nil
+}
+
+// QRCode renders a booking token as a PNG QR code.
+func QRCode(token string) ([]byte, error) {
+	png, err := qrcode.Encode(token, qrcode.Medium, QRCodeSize)
+	if err != nil {
+		return nil, fmt.Errorf("encoding QR code: %w", err)
+	}
+	return png, nil
+}
+
+// Remaining returns the number of seats of a time slot that can still be booked.
+func Remaining(slot *model.TimeSlot) int {
+	if slot.Booked >= slot.Capacity {
+		return 0
+	}
+	return slot.Capacity - slot.Booked
+}
+
+func sign(reservationID primitive.ObjectID, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("reservation:"))
+	mac.Write(reservationID[:])
+	return mac.Sum(nil)
+}
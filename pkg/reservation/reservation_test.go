@@ -0,0 +1,73 @@
+package reservation_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/reservation"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestValidateSlot(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	assert.NoError(t, reservation.ValidateSlot(&model.RequestTimeSlot{StartsAt: start, EndsAt: start.Add(time.Hour), Capacity: 20}))
+	assert.ErrorIs(t, reservation.ValidateSlot(&model.RequestTimeSlot{StartsAt: start, EndsAt: start, Capacity: 20}), cerr.ErrInvalidTimeSlot)
+}
+
+func TestToken(t *testing.T) {
+	secret := []byte("secret")
+	reservationID := primitive.NewObjectID()
+
+	token := reservation.Token(reservationID, secret)
+	verified, err := reservation.Verify(token, secret)
+	require.NoError(t, err)
+	assert.Equal(t, reservationID, verified)
+
+	_, err = reservation.Verify(token, []byte("other secret"))
+	assert.ErrorIs(t, err, cerr.ErrInvalidBookingToken)
+
+	// Swapping the ID keeps the signature of the original reservation
+	_, signature, _ := strings.Cut(token, ".")
+	forged := strings.SplitN(reservation.Token(primitive.NewObjectID(), secret), ".", 2)[0] + "." + signature
+	_, err = reservation.Verify(forged, secret)
+	assert.ErrorIs(t, err, cerr.ErrInvalidBookingToken)
+
+	for _, malformed := range []string{"", "no-dot", "!!!.!!!", "YWJj.YWJj"} {
+		_, err = reservation.Verify(malformed, secret)
+		assert.ErrorIs(t, err, cerr.ErrInvalidBookingToken, malformed)
+	}
+}
+
+func TestSecret(t *testing.T) {
+	t.Setenv("RESERVATION_SECRET", "")
+	t.Setenv("secret_key", "")
+	_, err := reservation.Secret()
+	assert.Error(t, err)
+
+	t.Setenv("secret_key", "auth secret")
+	secret, err := reservation.Secret()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("auth secret"), secret)
+
+	t.Setenv("RESERVATION_SECRET", "booking secret")
+	secret, err = reservation.Secret()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("booking secret"), secret)
+}
+
+func TestQRCode(t *testing.T) {
+	png, err := reservation.QRCode(reservation.Token(primitive.NewObjectID(), []byte("secret")))
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(png, []byte("\x89PNG")))
+}
+
+func TestRemaining(t *testing.T) {
+	assert.Equal(t, 5, reservation.Remaining(&model.TimeSlot{Capacity: 20, Booked: 15}))
+	assert.Equal(t, 0, reservation.Remaining(&model.TimeSlot{Capacity: 10, Booked: 12}))
+}
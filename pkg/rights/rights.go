@@ -0,0 +1,150 @@
+package rights
+
+import (
+	"atommuse/backend/exhibition-service/pkg/media"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// License describes a licence media can be published under. URL is empty for licences
+// without a canonical URI.
+type License struct {
+	Name string
+	URL  string
+}
+
+// Licenses lists the supported licences by code.
+var Licenses = map[string]License{
+	model.LicenseAllRightsReserved: {Name: "All rights reserved"},
+	model.LicensePublicDomain:      {Name: "Public domain", URL: "https://creativecommons.org/publicdomain/mark/1.0/"},
+	model.LicenseCC0:               {Name: "CC0 1.0", URL: "https://creativecommons.org/publicdomain/zero/1.0/"},
+	model.LicenseCCBY:              {Name: "CC BY 4.0", URL: "https://creativecommons.org/licenses/by/4.0/"},
+	model.LicenseCCBYSA:            {Name: "CC BY-SA 4.0", URL: "https://creativecommons.org/licenses/by-sa/4.0/"},
+	model.LicenseCCBYND:            {Name: "CC BY-ND 4.0", URL: "https://creativecommons.org/licenses/by-nd/4.0/"},
+	model.LicenseCCBYNC:            {Name: "CC BY-NC 4.0", URL: "https://creativecommons.org/licenses/by-nc/4.0/"},
+	model.LicenseCCBYNCSA:          {Name: "CC BY-NC-SA 4.0", URL: "https://creativecommons.org/licenses/by-nc-sa/4.0/"},
+	model.LicenseCCBYNCND:          {Name: "CC BY-NC-ND 4.0", URL: "https://creativecommons.org/licenses/by-nc-nd/4.0/"},
+}
+
+// EmbargoError lists the embargoed media that prevent an exhibition from being published.
+type EmbargoError struct {
+	Refs []string
+}
+
+func (e *EmbargoError) Error() string {
+	return "Media Is Under Embargo: " + strings.Join(e.Refs, ", ")
+}
+
+// Validate returns the problems of a list of media rights, such as unknown licences or
+// references declared twice.
+func Validate(rights []model.MediaRights) []string {
+	var problems []string
+	seen := map[string]bool{}
+	for _, r := range rights {
+		if seen[r.Ref] {
+			problems = append(problems, fmt.Sprintf("rights of %s are declared more than once", r.Ref))
+		}
+		seen[r.Ref] = true
+		if _, ok := Licenses[r.License]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown license %q for %s", r.License, r.Ref))
+		}
+	}
+	return problems
+}
+
+// Index returns the rights of an exhibition by media reference.
+func Index(exhibition *model.ResponseExhibition) map[string]*model.MediaRights {
+	index := make(map[string]*model.MediaRights, len(exhibition.MediaRights))
+	for i := range exhibition.MediaRights {
+		index[exhibition.MediaRights[i].Ref] = &exhibition.MediaRights[i]
+	}
+	return index
+}
+
+// IsEmbargoed reports whether the media may not be shown publicly at the given time.
+func IsEmbargoed(r *model.MediaRights, now time.Time) bool {
+	return r != nil && r.EmbargoUntil != nil && now.Before(*r.EmbargoUntil)
+}
+
+// Embargoed returns the media references of the exhibition that are under embargo.
+func Embargoed(exhibition *model.ResponseExhibition, now time.Time) []string {
+	index := Index(exhibition)
+	var refs []string
+	for _, ref := range media.References(exhibition) {
+		if IsEmbargoed(index[ref], now) {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// CheckPublishable returns an EmbargoError when the exhibition uses embargoed media.
+func CheckPublishable(exhibition *model.ResponseExhibition, now time.Time) error {
+	if refs := Embargoed(exhibition, now); len(refs) > 0 {
+		return &EmbargoError{Refs: refs}
+	}
+	return nil
+}
+
+// Redact removes embargoed media from an exhibition shown to the public, together with
+// the rights of the removed media.
+func Redact(exhibition *model.ResponseExhibition, now time.Time) {
+	index := Index(exhibition)
+	media.Walk(exhibition, func(ref *string) {
+		if IsEmbargoed(index[*ref], now) {
+			*ref = ""
+		}
+	})
+
+	kept := exhibition.MediaRights[:0]
+	for _, r := range exhibition.MediaRights {
+		if !IsEmbargoed(&r, now) {
+			kept = append(kept, r)
+		}
+	}
+	exhibition.MediaRights = kept
+}
+
+// Credit returns the credit line of media. The attribution text is used as is when given,
+// otherwise the line is built from the rights holder and the licence.
+func Credit(r *model.MediaRights) string {
+	if r == nil {
+		return ""
+	}
+	if r.Attribution != "" {
+		return r.Attribution
+	}
+
+	var parts []string
+	if r.RightsHolder != "" {
+		parts = append(parts, "© "+r.RightsHolder)
+	}
+	if license, ok := Licenses[r.License]; ok {
+		parts = append(parts, license.Name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// AddCredits sets the credit line of every media rights entry of the exhibition.
+func AddCredits(exhibition *model.ResponseExhibition) {
+	for i := range exhibition.MediaRights {
+		exhibition.MediaRights[i].Credit = Credit(&exhibition.MediaRights[i])
+	}
+}
+
+// Credits returns the distinct credit lines of the media an exhibition uses, in the order the
+// media appears.
+func Credits(exhibition *model.ResponseExhibition) []string {
+	index := Index(exhibition)
+	var credits []string
+	seen := map[string]bool{}
+	for _, ref := range media.References(exhibition) {
+		if credit := Credit(index[ref]); credit != "" && !seen[credit] {
+			seen[credit] = true
+			credits = append(credits, credit)
+		}
+	}
+	return credits
+}
@@ -0,0 +1,83 @@
+package rights_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/rights"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func exhibition() *model.ResponseExhibition {
+	later := now.Add(24 * time.Hour)
+	earlier := now.Add(-24 * time.Hour)
+
+	return &model.ResponseExhibition{
+		ThumbnailImg: "/uploads/cover.jpg",
+		ExhibitionSections: []model.ExhibitionSection{{
+			LeftCol: model.LeftColumn{Image: "/uploads/loan.jpg"},
+			Images:  []string{"/uploads/old-loan.jpg"},
+		}},
+		Room: []model.Room{{
+			Center: []model.CenterItem{{Src: "/uploads/loan.jpg"}},
+		}},
+		MediaRights: []model.MediaRights{
+			{Ref: "/uploads/cover.jpg", RightsHolder: "City Museum", License: model.LicenseCCBY},
+			{Ref: "/uploads/loan.jpg", RightsHolder: "Private lender", License: model.LicenseAllRightsReserved, EmbargoUntil: &later},
+			{Ref: "/uploads/old-loan.jpg", Attribution: "Courtesy of the Chan family", License: model.LicenseAllRightsReserved, EmbargoUntil: &earlier},
+			{Ref: "/uploads/unused.jpg", License: model.LicenseCC0, EmbargoUntil: &later},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	problems := rights.Validate([]model.MediaRights{
+		{Ref: "/a.jpg", License: model.LicenseCCBYNCSA},
+		{Ref: "/a.jpg", License: model.LicenseCC0},
+		{Ref: "/b.jpg", License: "GPL"},
+	})
+	assert.Len(t, problems, 2)
+	assert.Empty(t, rights.Validate(exhibition().MediaRights))
+}
+
+func TestCheckPublishable(t *testing.T) {
+	err := rights.CheckPublishable(exhibition(), now)
+
+	var embargo *rights.EmbargoError
+	require.ErrorAs(t, err, &embargo)
+	// Expired embargoes and media the exhibition does not use are ignored
+	assert.Equal(t, []string{"/uploads/loan.jpg"}, embargo.Refs)
+
+	assert.NoError(t, rights.CheckPublishable(exhibition(), now.Add(48*time.Hour)))
+}
+
+func TestRedact(t *testing.T) {
+	e := exhibition()
+	rights.Redact(e, now)
+
+	assert.Empty(t, e.ExhibitionSections[0].LeftCol.Image)
+	assert.Empty(t, e.Room[0].Center[0].Src)
+	assert.Equal(t, "/uploads/cover.jpg", e.ThumbnailImg)
+	assert.Equal(t, []string{"/uploads/old-loan.jpg"}, e.ExhibitionSections[0].Images)
+	require.Len(t, e.MediaRights, 2)
+	assert.Equal(t, "/uploads/cover.jpg", e.MediaRights[0].Ref)
+	assert.Equal(t, "/uploads/old-loan.jpg", e.MediaRights[1].Ref)
+}
+
+func TestCredits(t *testing.T) {
+	e := exhibition()
+
+	assert.Equal(t, []string{
+		"© City Museum, CC BY 4.0",
+		"© Private lender, All rights reserved",
+		"Courtesy of the Chan family",
+	}, rights.Credits(e))
+
+	rights.AddCredits(e)
+	assert.Equal(t, "© City Museum, CC BY 4.0", e.MediaRights[0].Credit)
+	assert.Equal(t, "CC0 1.0", e.MediaRights[3].Credit)
+}
@@ -0,0 +1,119 @@
+package screening
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ExhibitionFields returns the texts of an exhibition, in every locale.
+func ExhibitionFields(exhibition *model.ResponseExhibition) []Field {
+	fields := []Field{
+		{Name: "exhibitionName", Text: exhibition.ExhibitionName},
+		{Name: "exhibitionDescription", Text: exhibition.ExhibitionDescription},
+	}
+	for i, tag := range exhibition.ExhibitionTags {
+		fields = append(fields, Field{Name: fmt.Sprintf("exhibitionTags[%d]", i), Text: tag})
+	}
+	locales := make([]string, 0, len(exhibition.Translations))
+	for locale := range exhibition.Translations {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	for _, locale := range locales {
+		translation := exhibition.Translations[locale]
+		fields = append(fields,
+			Field{Name: "translations." + locale + ".exhibitionName", Text: translation.ExhibitionName},
+			Field{Name: "translations." + locale + ".exhibitionDescription", Text: translation.ExhibitionDescription},
+		)
+	}
+	return fields
+}
+
+// SectionFields returns the texts of a section, in every locale.
+func SectionFields(section *model.ExhibitionSection) []Field {
+	fields := []Field{
+		{Name: "title", Text: section.Title},
+		{Name: "text", Text: section.Text},
+		{Name: "leftCol.title", Text: section.LeftCol.Title},
+		{Name: "leftCol.text", Text: section.LeftCol.Text},
+		{Name: "leftCol.imageDescription", Text: section.LeftCol.ImageDescription},
+		{Name: "rightCol.title", Text: section.RightCol.Title},
+		{Name: "rightCol.text", Text: section.RightCol.Text},
+		{Name: "rightCol.imageDescription", Text: section.RightCol.ImageDescription},
+	}
+	locales := make([]string, 0, len(section.Translations))
+	for locale := range section.Translations {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	for _, locale := range locales {
+		translation := section.Translations[locale]
+		fields = append(fields,
+			Field{Name: "translations." + locale + ".title", Text: translation.Title},
+			Field{Name: "translations." + locale + ".text", Text: translation.Text},
+		)
+	}
+	return fields
+}
+
+// RoomFields returns the texts of the items of a room, in every locale.
+func RoomFields(room *model.Room) []Field {
+	fields := []Field{}
+	for i, item := range room.Left {
+		fields = append(fields, detailsFields(fmt.Sprintf("left[%d].details", i), item.Details)...)
+	}
+	for i, item := range room.Center {
+		fields = append(fields, detailsFields(fmt.Sprintf("center[%d].details", i), item.Details)...)
+	}
+	for i, item := range room.Right {
+		fields = append(fields, detailsFields(fmt.Sprintf("right[%d].details", i), item.Details)...)
+	}
+	return fields
+}
+
+func detailsFields(prefix string, details model.Details) []Field {
+	fields := contentsFields(prefix+".contents", details.Contents)
+	locales := make([]string, 0, len(details.Translations))
+	for locale := range details.Translations {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	for _, locale := range locales {
+		fields = append(fields, contentsFields(prefix+".translations."+locale, details.Translations[locale])...)
+	}
+	return fields
+}
+
+func contentsFields(prefix string, contents []model.Contents) []Field {
+	fields := []Field{}
+	for i, content := range contents {
+		name := fmt.Sprintf("%s[%d]", prefix, i)
+		fields = append(fields, Field{Name: name + ".title", Text: content.Title})
+		for j, paragraph := range content.Text {
+			fields = append(fields, Field{Name: fmt.Sprintf("%s.text[%d]", name, j), Text: strings.Join(paragraph, " ")})
+		}
+	}
+	return fields
+}
+
+// TreeFields returns the texts of an exhibition with its sections and rooms, in every locale.
+// The texts of sections and rooms are named after their place in the exhibition.
+func TreeFields(exhibition *model.ResponseExhibition) []Field {
+	fields := ExhibitionFields(exhibition)
+	for i := range exhibition.ExhibitionSections {
+		fields = append(fields, prefixFields(fmt.Sprintf("exhibitionSections[%d].", i), SectionFields(&exhibition.ExhibitionSections[i]))...)
+	}
+	for i := range exhibition.Room {
+		fields = append(fields, prefixFields(fmt.Sprintf("rooms[%d].", i), RoomFields(&exhibition.Room[i]))...)
+	}
+	return fields
+}
+
+func prefixFields(prefix string, fields []Field) []Field {
+	for i := range fields {
+		fields[i].Name = prefix + fields[i].Name
+	}
+	return fields
+}
@@ -0,0 +1,161 @@
+package screening
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Field is a text of a submission, named after where it is in the submission.
+type Field struct {
+	Name string
+	Text string
+}
+
+// BlockedError rejects a submission matching a rule that blocks it.
+type BlockedError struct {
+	Matches []model.ScreeningMatch
+}
+
+func (e *BlockedError) Error() string {
+	return cerr.ErrContentBlocked.Error()
+}
+
+func (e *BlockedError) Unwrap() error {
+	return cerr.ErrContentBlocked
+}
+
+// invisible removes the characters that do not show in text, such as the zero-width spaces
+// marking word breaks in Thai, so that they cannot be slipped into a word to hide it.
+var invisible = strings.NewReplacer("\u200b", "", "\u200c", "", "\u200d", "", "\ufeff", "", "\u00ad", "")
+
+// Normalize folds a text for matching: compatibility characters such as full-width letters
+// are replaced by their plain forms, letters are lower-cased and invisible characters removed.
+func Normalize(text string) string {
+	return invisible.Replace(strings.ToLower(norm.NFKC.String(text)))
+}
+
+type rule struct {
+	model.ScreeningRule
+	words   []string
+	pattern *regexp.Regexp
+}
+
+// Screener screens texts against a set of rules.
+type Screener struct {
+	rules []rule
+}
+
+// Compile prepares the enabled rules for screening. It fails with ErrInvalidScreeningRule when
+// a pattern is not a valid regular expression.
+func Compile(rules []model.ScreeningRule) (*Screener, error) {
+	screener := &Screener{}
+	for _, r := range rules {
+		if !r.Enabled {
+			continue
+		}
+
+		compiled := rule{ScreeningRule: r}
+		switch r.Type {
+		case model.ScreeningWords:
+			for _, word := range r.Words {
+				if word = strings.TrimSpace(Normalize(word)); word != "" {
+					compiled.words = append(compiled.words, word)
+				}
+			}
+		case model.ScreeningPattern:
+			pattern, err := regexp.Compile(r.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %v", cerr.ErrInvalidScreeningRule, r.Name, err)
+			}
+			compiled.pattern = pattern
+		}
+		screener.rules = append(screener.rules, compiled)
+	}
+	return screener, nil
+}
+
+// Screen matches the fields against the rules. A field matching a rule is reported once per
+// rule, with the first text it matched.
+func (s *Screener) Screen(fields []Field) model.ScreeningResult {
+	result := model.ScreeningResult{Verdict: model.ScreeningClean, Matches: []model.ScreeningMatch{}}
+	for _, field := range fields {
+		text := Normalize(field.Text)
+		if text == "" {
+			continue
+		}
+
+		for _, r := range s.rules {
+			match, ok := r.match(text)
+			if !ok {
+				continue
+			}
+
+			result.Matches = append(result.Matches, model.ScreeningMatch{
+				RuleID:   r.ID,
+				Rule:     r.Name,
+				Severity: r.Severity,
+				Field:    field.Name,
+				Match:    match,
+			})
+			if r.Severity == model.ScreeningBlock {
+				result.Verdict = model.ScreeningBlocked
+			} else if result.Verdict == model.ScreeningClean {
+				result.Verdict = model.ScreeningFlagged
+			}
+		}
+	}
+	return result
+}
+
+func (r rule) match(text string) (string, bool) {
+	if r.pattern != nil {
+		loc := r.pattern.FindStringIndex(text)
+		if loc == nil {
+			return "", false
+		}
+		return text[loc[0]:loc[1]], true
+	}
+	for _, word := range r.words {
+		if containsWord(text, word) {
+			return word, true
+		}
+	}
+	return "", false
+}
+
+// containsWord reports whether the text contains the word on its own. Thai does not separate
+// words with spaces, so a word starting or ending in Thai matches on that side wherever it is.
+func containsWord(text, word string) bool {
+	first, _ := utf8.DecodeRuneInString(word)
+	last, _ := utf8.DecodeLastRuneInString(word)
+
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(word)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (start == 0 || unicode.Is(unicode.Thai, first) || !isWordRune(before)) &&
+			(end == len(text) || unicode.Is(unicode.Thai, last) || !isWordRune(after)) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
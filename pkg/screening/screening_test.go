@@ -0,0 +1,99 @@
+package screening_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScreen(t *testing.T) {
+	screener, err := screening.Compile([]model.ScreeningRule{
+		{Name: "Slurs", Type: model.ScreeningWords, Words: []string{"Scum", "ขยะสังคม"}, Severity: model.ScreeningBlock, Enabled: true},
+		{Name: "Gambling", Type: model.ScreeningPattern, Pattern: `(casino|บาคาร่า)\s*online`, Severity: model.ScreeningFlag, Enabled: true},
+		{Name: "Retired", Type: model.ScreeningWords, Words: []string{"art"}, Severity: model.ScreeningBlock},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		text    string
+		verdict string
+		match   string
+	}{
+		{name: "clean", text: "Modern art of Bangkok", verdict: model.ScreeningClean},
+		{name: "english word", text: "You are SCUM.", verdict: model.ScreeningBlocked, match: "scum"},
+		{name: "english word within another", text: "Scummy paintings", verdict: model.ScreeningClean},
+		{name: "full width", text: "ｓｃｕｍ", verdict: model.ScreeningBlocked, match: "scum"},
+		{name: "thai word between thai words", text: "พวกเขาเป็นขยะสังคมทั้งนั้น", verdict: model.ScreeningBlocked, match: "ขยะสังคม"},
+		{name: "thai word with zero width space", text: "ขยะ\u200bสังคม", verdict: model.ScreeningBlocked, match: "ขยะสังคม"},
+		{name: "pattern", text: "Play Casino  Online now", verdict: model.ScreeningFlagged, match: "casino  online"},
+		{name: "thai pattern", text: "บาคาร่าonline", verdict: model.ScreeningFlagged, match: "บาคาร่าonline"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := screener.Screen([]screening.Field{{Name: "exhibitionName", Text: tt.text}})
+			assert.Equal(t, tt.verdict, result.Verdict)
+			if tt.match == "" {
+				assert.Empty(t, result.Matches)
+				return
+			}
+			require.Len(t, result.Matches, 1)
+			assert.Equal(t, tt.match, result.Matches[0].Match)
+			assert.Equal(t, "exhibitionName", result.Matches[0].Field)
+		})
+	}
+}
+
+func TestScreenVerdict(t *testing.T) {
+	screener, err := screening.Compile([]model.ScreeningRule{
+		{Name: "Spam", Type: model.ScreeningWords, Words: []string{"viagra"}, Severity: model.ScreeningFlag, Enabled: true},
+		{Name: "Slurs", Type: model.ScreeningWords, Words: []string{"scum"}, Severity: model.ScreeningBlock, Enabled: true},
+	})
+	require.NoError(t, err)
+
+	result := screener.Screen([]screening.Field{
+		{Name: "exhibitionName", Text: "Scum"},
+		{Name: "translations.th.exhibitionDescription", Text: "Cheap viagra"},
+	})
+	assert.Equal(t, model.ScreeningBlocked, result.Verdict)
+	require.Len(t, result.Matches, 2)
+	assert.Equal(t, "translations.th.exhibitionDescription", result.Matches[1].Field)
+}
+
+func TestCompile(t *testing.T) {
+	_, err := screening.Compile([]model.ScreeningRule{{Name: "Broken", Type: model.ScreeningPattern, Pattern: "(", Enabled: true}})
+	assert.ErrorIs(t, err, cerr.ErrInvalidScreeningRule)
+}
+
+func TestRoomFields(t *testing.T) {
+	room := model.Room{Center: []model.CenterItem{{Details: model.Details{
+		Contents:     []model.Contents{{Title: "Title", Text: [][]string{{"first", "line"}}}},
+		Translations: map[string][]model.Contents{"th": {{Title: "ชื่อ"}}},
+	}}}}
+
+	assert.Equal(t, []screening.Field{
+		{Name: "center[0].details.contents[0].title", Text: "Title"},
+		{Name: "center[0].details.contents[0].text[0]", Text: "first line"},
+		{Name: "center[0].details.translations.th[0].title", Text: "ชื่อ"},
+	}, screening.RoomFields(&room))
+}
+
+func TestTreeFields(t *testing.T) {
+	exhibition := model.ResponseExhibition{
+		ExhibitionName:     "Name",
+		ExhibitionSections: []model.ExhibitionSection{{Title: "Section"}},
+		Room: []model.Room{{Left: []model.LeftRightItem{{Details: model.Details{
+			Contents: []model.Contents{{Title: "Room"}},
+		}}}}},
+	}
+
+	fields := screening.TreeFields(&exhibition)
+	assert.Contains(t, fields, screening.Field{Name: "exhibitionName", Text: "Name"})
+	assert.Contains(t, fields, screening.Field{Name: "exhibitionSections[0].title", Text: "Section"})
+	assert.Contains(t, fields, screening.Field{Name: "rooms[0].left[0].details.contents[0].title", Text: "Room"})
+}
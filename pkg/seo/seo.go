@@ -0,0 +1,201 @@
+package seo
+
+import (
+	"atommuse/backend/exhibition-service/pkg/media"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"bytes"
+	"html/template"
+	"strings"
+)
+
+// SiteName is the og:site_name of every page.
+const SiteName = "AtomMuse"
+
+// maxDescription is the length descriptions are cut to in meta tags.
+const maxDescription = 300
+
+// Event is a schema.org ExhibitionEvent.
+type Event struct {
+	Context             string   `json:"@context"`
+	Type                string   `json:"@type"`
+	Name                string   `json:"name"`
+	Description         string   `json:"description,omitempty"`
+	StartDate           string   `json:"startDate,omitempty"`
+	EndDate             string   `json:"endDate,omitempty"`
+	URL                 string   `json:"url"`
+	Image               []string `json:"image,omitempty"`
+	Keywords            string   `json:"keywords,omitempty"`
+	Organizer           *Person  `json:"organizer,omitempty"`
+	EventStatus         string   `json:"eventStatus"`
+	EventAttendanceMode string   `json:"eventAttendanceMode"`
+	// Location holds the VirtualLocation of the page, preceded by the Place of the venue when
+	// the exhibition is also shown at one.
+	Location []interface{} `json:"location"`
+}
+
+// Person is a schema.org Person.
+type Person struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name"`
+	Image string `json:"image,omitempty"`
+}
+
+// VirtualLocation is the schema.org location of an online event.
+type VirtualLocation struct {
+	Type string `json:"@type"`
+	URL  string `json:"url"`
+}
+
+// Place is the schema.org location of the venue of an exhibition.
+type Place struct {
+	Type    string          `json:"@type"`
+	Name    string          `json:"name,omitempty"`
+	Address *PostalAddress  `json:"address,omitempty"`
+	Geo     *GeoCoordinates `json:"geo,omitempty"`
+}
+
+// PostalAddress is a schema.org PostalAddress. Venue addresses are free text, so only the
+// street address is set.
+type PostalAddress struct {
+	Type          string `json:"@type"`
+	StreetAddress string `json:"streetAddress"`
+}
+
+// GeoCoordinates is a schema.org GeoCoordinates.
+type GeoCoordinates struct {
+	Type      string  `json:"@type"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// MetaTag is an HTML meta tag. OpenGraph tags use property, Twitter card tags use name.
+type MetaTag struct {
+	Property string `json:"property,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Content  string `json:"content"`
+}
+
+// Metadata holds the JSON-LD and meta tags describing an exhibition page.
+type Metadata struct {
+	JSONLD Event     `json:"jsonLd"`
+	Tags   []MetaTag `json:"tags"`
+}
+
+var snippet = template.Must(template.New("snippet").Parse(`<script type="application/ld+json">{{.JSONLD}}</script>
+{{range .Tags}}{{if .Property}}<meta property="{{.Property}}" content="{{.Content}}">{{else}}<meta name="{{.Name}}" content="{{.Content}}">{{end}}
+{{end}}`))
+
+// Build describes an exhibition whose page lives at pageURL. Relative media references are
+// resolved against mediaURL. Exhibitions with a venue are described as mixed events, shown
+// both at the venue and online, and the others as online events.
+func Build(exhibition *model.ResponseExhibition, pageURL, mediaURL string) *Metadata {
+	description := truncate(exhibition.ExhibitionDescription, maxDescription)
+
+	var image string
+	if exhibition.ThumbnailImg != "" {
+		image = exhibition.ThumbnailImg
+		if !media.IsRemote(image) {
+			image = strings.TrimSuffix(mediaURL, "/") + "/" + strings.TrimPrefix(image, "/")
+		}
+	}
+
+	event := Event{
+		Context:             "https://schema.org",
+		Type:                "ExhibitionEvent",
+		Name:                exhibition.ExhibitionName,
+		Description:         exhibition.ExhibitionDescription,
+		StartDate:           exhibition.StartDate,
+		EndDate:             exhibition.EndDate,
+		URL:                 pageURL,
+		Keywords:            strings.Join(append(append([]string(nil), exhibition.ExhibitionTags...), exhibition.ExhibitionCategories...), ", "),
+		EventStatus:         "https://schema.org/EventScheduled",
+		EventAttendanceMode: "https://schema.org/OnlineEventAttendanceMode",
+		Location:            []interface{}{VirtualLocation{Type: "VirtualLocation", URL: pageURL}},
+	}
+	if place := venuePlace(exhibition.Venue); place != nil {
+		event.EventAttendanceMode = "https://schema.org/MixedEventAttendanceMode"
+		event.Location = append([]interface{}{*place}, event.Location...)
+	}
+	if image != "" {
+		event.Image = []string{image}
+	}
+	if name := organizerName(exhibition.UserID); name != "" {
+		event.Organizer = &Person{Type: "Person", Name: name, Image: exhibition.UserID.ProfileImage}
+	}
+
+	tags := []MetaTag{
+		{Property: "og:type", Content: "website"},
+		{Property: "og:site_name", Content: SiteName},
+		{Property: "og:title", Content: exhibition.ExhibitionName},
+		{Property: "og:url", Content: pageURL},
+	}
+	if description != "" {
+		tags = append(tags, MetaTag{Property: "og:description", Content: description})
+	}
+	if image != "" {
+		tags = append(tags, MetaTag{Property: "og:image", Content: image})
+	}
+
+	card := "summary"
+	if image != "" {
+		card = "summary_large_image"
+	}
+	tags = append(tags,
+		MetaTag{Name: "twitter:card", Content: card},
+		MetaTag{Name: "twitter:title", Content: exhibition.ExhibitionName},
+	)
+	if description != "" {
+		tags = append(tags, MetaTag{Name: "twitter:description", Content: description})
+	}
+	if image != "" {
+		tags = append(tags, MetaTag{Name: "twitter:image", Content: image})
+	}
+
+	return &Metadata{JSONLD: event, Tags: tags}
+}
+
+// HTML renders the metadata as a snippet for the head of a page.
+func (m *Metadata) HTML() (string, error) {
+	var buf bytes.Buffer
+	if err := snippet.Execute(&buf, m); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// venuePlace describes a venue, or returns nil when there is none.
+func venuePlace(venue *model.Venue) *Place {
+	if venue == nil {
+		return nil
+	}
+
+	place := &Place{Type: "Place", Name: venue.Name}
+	if venue.Address != "" {
+		place.Address = &PostalAddress{Type: "PostalAddress", StreetAddress: venue.Address}
+	}
+	// GeoJSON coordinates are longitude then latitude
+	if len(venue.Location.Coordinates) == 2 {
+		place.Geo = &GeoCoordinates{
+			Type:      "GeoCoordinates",
+			Latitude:  venue.Location.Coordinates[1],
+			Longitude: venue.Location.Coordinates[0],
+		}
+	}
+	return place
+}
+
+func organizerName(user model.UserID) string {
+	if name := strings.TrimSpace(user.FirstName + " " + user.LastName); name != "" {
+		return name
+	}
+	return user.Username
+}
+
+// truncate shortens s to at most max runes, ending with an ellipsis when cut.
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:max-1])) + "…"
+}
@@ -0,0 +1,111 @@
+package seo_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/seo"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pageURL = "https://atommuse.example/exhibitions/abc"
+
+func exhibition() *model.ResponseExhibition {
+	return &model.ResponseExhibition{
+		ExhibitionName:        "Siam Ceramics",
+		ExhibitionDescription: `Stoneware "celadon" </script><b>`,
+		ThumbnailImg:          "/uploads/cover.jpg",
+		StartDate:             "2026-11-01",
+		EndDate:               "2026-12-31",
+		ExhibitionTags:        []string{"pottery"},
+		ExhibitionCategories:  []string{"Art"},
+		UserID:                model.UserID{FirstName: "Nok", LastName: "Chai", Username: "nok"},
+		IsPublic:              true,
+		Status:                "created",
+	}
+}
+
+func tag(metadata *seo.Metadata, key string) string {
+	for _, t := range metadata.Tags {
+		if t.Property == key || t.Name == key {
+			return t.Content
+		}
+	}
+	return ""
+}
+
+func TestBuild(t *testing.T) {
+	metadata := seo.Build(exhibition(), pageURL, "https://media.example/")
+
+	event := metadata.JSONLD
+	assert.Equal(t, "ExhibitionEvent", event.Type)
+	assert.Equal(t, "Siam Ceramics", event.Name)
+	assert.Equal(t, "2026-11-01", event.StartDate)
+	assert.Equal(t, []string{"https://media.example/uploads/cover.jpg"}, event.Image)
+	assert.Equal(t, "pottery, Art", event.Keywords)
+	require.NotNil(t, event.Organizer)
+	assert.Equal(t, "Nok Chai", event.Organizer.Name)
+	assert.Equal(t, "https://schema.org/OnlineEventAttendanceMode", event.EventAttendanceMode)
+	assert.Equal(t, []interface{}{seo.VirtualLocation{Type: "VirtualLocation", URL: pageURL}}, event.Location)
+
+	assert.Equal(t, "Siam Ceramics", tag(metadata, "og:title"))
+	assert.Equal(t, "https://media.example/uploads/cover.jpg", tag(metadata, "og:image"))
+	assert.Equal(t, "summary_large_image", tag(metadata, "twitter:card"))
+}
+
+func TestBuildWithoutImage(t *testing.T) {
+	source := exhibition()
+	source.ThumbnailImg = ""
+	source.UserID = model.UserID{Username: "nok"}
+	source.ExhibitionDescription = strings.Repeat("a", 400)
+
+	metadata := seo.Build(source, pageURL, "")
+
+	assert.Empty(t, metadata.JSONLD.Image)
+	assert.Equal(t, "nok", metadata.JSONLD.Organizer.Name)
+	assert.Equal(t, "summary", tag(metadata, "twitter:card"))
+	assert.Equal(t, "", tag(metadata, "og:image"))
+	assert.Len(t, []rune(tag(metadata, "og:description")), 300)
+}
+
+func TestBuildWithVenue(t *testing.T) {
+	source := exhibition()
+	source.Venue = &model.Venue{
+		Name:     "River City",
+		Address:  "23 Trok Rongnamkaeng, Bangkok",
+		Location: model.GeoPoint{Type: model.GeoPointType, Coordinates: []float64{100.5131, 13.7297}},
+	}
+
+	event := seo.Build(source, pageURL, "").JSONLD
+
+	assert.Equal(t, "https://schema.org/MixedEventAttendanceMode", event.EventAttendanceMode)
+	require.Len(t, event.Location, 2)
+	assert.Equal(t, seo.Place{
+		Type:    "Place",
+		Name:    "River City",
+		Address: &seo.PostalAddress{Type: "PostalAddress", StreetAddress: "23 Trok Rongnamkaeng, Bangkok"},
+		Geo:     &seo.GeoCoordinates{Type: "GeoCoordinates", Latitude: 13.7297, Longitude: 100.5131},
+	}, event.Location[0])
+	assert.Equal(t, seo.VirtualLocation{Type: "VirtualLocation", URL: pageURL}, event.Location[1])
+}
+
+func TestHTML(t *testing.T) {
+	snippet, err := seo.Build(exhibition(), pageURL, "https://media.example").HTML()
+	require.NoError(t, err)
+
+	// The description must not be able to close the script element or a meta attribute
+	assert.Equal(t, 1, strings.Count(snippet, "</script>"))
+	assert.NotContains(t, snippet, "<b>")
+	assert.Contains(t, snippet, `<meta property="og:title" content="Siam Ceramics">`)
+	assert.Contains(t, snippet, `<meta name="twitter:card" content="summary_large_image">`)
+
+	start := strings.Index(snippet, ">") + 1
+	end := strings.Index(snippet, "</script>")
+	var event map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(snippet[start:end]), &event))
+	assert.Equal(t, "https://schema.org", event["@context"])
+	assert.Equal(t, "ExhibitionEvent", event["@type"])
+}
@@ -0,0 +1,270 @@
+package artworksvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/artworkrepo"
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IArtworkServices defines the interface for the artwork catalogue.
+type IArtworkServices interface {
+	CreateArtwork(ctx context.Context, actor model.Actor, request *model.RequestArtwork) (*primitive.ObjectID, error)
+	GetArtworks(ctx context.Context, actor model.Actor, ownerID, search string) ([]model.Artwork, error)
+	GetArtworkByID(ctx context.Context, artworkID string, actor model.Actor) (*model.Artwork, error)
+	UpdateArtwork(ctx context.Context, artworkID string, actor model.Actor, request *model.RequestArtwork) (*model.Artwork, error)
+	DeleteArtwork(ctx context.Context, artworkID string, actor model.Actor) error
+	EmbedArtworks(ctx context.Context, exhibition *model.ResponseExhibition) error
+	CheckReferences(ctx context.Context, artworkIDs []primitive.ObjectID, ownerIDs ...primitive.ObjectID) error
+	DropForeignReferences(ctx context.Context, exhibition *model.ResponseExhibition, ownerIDs ...primitive.ObjectID) error
+}
+
+// ArtworkServices is the implementation of the IArtworkServices interface.
+type ArtworkServices struct {
+	Repository artworkrepo.IArtworkRepository
+}
+
+func (service ArtworkServices) CreateArtwork(ctx context.Context, actor model.Actor, request *model.RequestArtwork) (*primitive.ObjectID, error) {
+	now := time.Now().UTC()
+	artwork := fromRequest(request)
+	artwork.OwnerID = actor.UserID.UserID
+	artwork.CreatedAt = now
+	artwork.UpdatedAt = now
+
+	return service.Repository.CreateArtwork(ctx, &artwork)
+}
+
+// GetArtworks lists the catalogue of the actor. Admins may list the catalogue of another owner.
+func (service ArtworkServices) GetArtworks(ctx context.Context, actor model.Actor, ownerID, search string) ([]model.Artwork, error) {
+	owner := actor.UserID.UserID
+	if ownerID != "" && ownerID != owner.Hex() {
+		if actor.Role != "admin" {
+			return nil, cerr.ErrForbidden
+		}
+		id, err := primitive.ObjectIDFromHex(ownerID)
+		if err != nil {
+			return []model.Artwork{}, nil
+		}
+		owner = id
+	}
+
+	return service.Repository.GetArtworks(ctx, owner, search)
+}
+
+// GetArtworkByID retrieves an artwork of the actor's catalogue.
+func (service ArtworkServices) GetArtworkByID(ctx context.Context, artworkID string, actor model.Actor) (*model.Artwork, error) {
+	artwork, err := service.Repository.GetArtworkByID(ctx, artworkID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canManage(artwork, actor) {
+		return nil, cerr.ErrArtworkNotFound
+	}
+
+	return artwork, nil
+}
+
+// UpdateArtwork replaces the catalogue fields of an artwork. Exhibitions referencing it show the
+// new metadata the next time they are loaded.
+func (service ArtworkServices) UpdateArtwork(ctx context.Context, artworkID string, actor model.Actor, request *model.RequestArtwork) (*model.Artwork, error) {
+	existing, err := service.GetArtworkByID(ctx, artworkID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	artwork := fromRequest(request)
+	artwork.ID = existing.ID
+	artwork.OwnerID = existing.OwnerID
+	artwork.CreatedAt = existing.CreatedAt
+	artwork.UpdatedAt = time.Now().UTC()
+
+	if err := service.Repository.UpdateArtwork(ctx, &artwork); err != nil {
+		return nil, err
+	}
+
+	return &artwork, nil
+}
+
+// DeleteArtwork removes an artwork that no section or room item references anymore.
+func (service ArtworkServices) DeleteArtwork(ctx context.Context, artworkID string, actor model.Actor) error {
+	if _, err := service.GetArtworkByID(ctx, artworkID, actor); err != nil {
+		return err
+	}
+
+	referenced, err := service.Repository.IsArtworkReferenced(ctx, artworkID)
+	if err != nil {
+		return err
+	}
+	if referenced {
+		return cerr.ErrArtworkInUse
+	}
+
+	return service.Repository.DeleteArtwork(ctx, artworkID)
+}
+
+// EmbedArtworks loads the artworks referenced by the sections and rooms of an exhibition and
+// embeds their metadata. References to deleted artworks are left without metadata.
+func (service ArtworkServices) EmbedArtworks(ctx context.Context, exhibition *model.ResponseExhibition) error {
+	ids := ReferencedIDs(exhibition)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	artworks, err := service.Repository.GetArtworksByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[primitive.ObjectID]*model.Artwork, len(artworks))
+	for i := range artworks {
+		byID[artworks[i].ID] = &artworks[i]
+	}
+	Embed(exhibition, byID)
+
+	return nil
+}
+
+// CheckReferences checks that the artworks exist and belong to the catalogue of one of the
+// owners, so that exhibitions only show artworks from the catalogues of the people editing them.
+func (service ArtworkServices) CheckReferences(ctx context.Context, artworkIDs []primitive.ObjectID, ownerIDs ...primitive.ObjectID) error {
+	if len(artworkIDs) == 0 {
+		return nil
+	}
+
+	allowed, err := service.allowed(ctx, artworkIDs, ownerIDs)
+	if err != nil {
+		return err
+	}
+	for _, id := range artworkIDs {
+		if !allowed[id] {
+			return fmt.Errorf("%w: %s", cerr.ErrArtworkNotAllowed, id.Hex())
+		}
+	}
+
+	return nil
+}
+
+// DropForeignReferences removes the references of an exhibition to artworks that are missing or
+// not in the catalogue of one of the owners, so that a copy of an exhibition made for someone
+// else does not show the artworks of the catalogue it was copied from.
+func (service ArtworkServices) DropForeignReferences(ctx context.Context, exhibition *model.ResponseExhibition, ownerIDs ...primitive.ObjectID) error {
+	ids := ReferencedIDs(exhibition)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	allowed, err := service.allowed(ctx, ids, ownerIDs)
+	if err != nil {
+		return err
+	}
+	Drop(exhibition, func(id primitive.ObjectID) bool {
+		return !allowed[id]
+	})
+
+	return nil
+}
+
+// allowed returns which of the artworks exist and belong to the catalogue of one of the owners.
+func (service ArtworkServices) allowed(ctx context.Context, artworkIDs []primitive.ObjectID, ownerIDs []primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	artworks, err := service.Repository.GetArtworksByIDs(ctx, artworkIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := map[primitive.ObjectID]bool{}
+	for _, artwork := range artworks {
+		for _, ownerID := range ownerIDs {
+			if artwork.OwnerID == ownerID {
+				allowed[artwork.ID] = true
+			}
+		}
+	}
+	return allowed, nil
+}
+
+// ReferencedIDs returns the distinct artwork IDs referenced by the sections and rooms of an exhibition.
+func ReferencedIDs(exhibition *model.ResponseExhibition) []primitive.ObjectID {
+	seen := map[primitive.ObjectID]bool{}
+	ids := []primitive.ObjectID{}
+	walk(exhibition, func(id **primitive.ObjectID, _ **model.Artwork) {
+		if !seen[**id] {
+			seen[**id] = true
+			ids = append(ids, **id)
+		}
+	})
+	return ids
+}
+
+// Embed sets the artwork of every reference found in the map.
+func Embed(exhibition *model.ResponseExhibition, artworks map[primitive.ObjectID]*model.Artwork) {
+	walk(exhibition, func(id **primitive.ObjectID, artwork **model.Artwork) {
+		*artwork = artworks[**id]
+	})
+}
+
+// Drop removes the artwork references for which drop is true.
+func Drop(exhibition *model.ResponseExhibition, drop func(id primitive.ObjectID) bool) {
+	walk(exhibition, func(id **primitive.ObjectID, artwork **model.Artwork) {
+		if drop(**id) {
+			*id = nil
+			*artwork = nil
+		}
+	})
+}
+
+// walk calls fn for every artwork reference of an exhibition.
+func walk(exhibition *model.ResponseExhibition, fn func(id **primitive.ObjectID, artwork **model.Artwork)) {
+	for i := range exhibition.ExhibitionSections {
+		section := &exhibition.ExhibitionSections[i]
+		if section.LeftCol.ArtworkID != nil {
+			fn(&section.LeftCol.ArtworkID, &section.LeftCol.Artwork)
+		}
+		if section.RightCol.ArtworkID != nil {
+			fn(&section.RightCol.ArtworkID, &section.RightCol.Artwork)
+		}
+	}
+
+	for i := range exhibition.Room {
+		room := &exhibition.Room[i]
+		for j := range room.Left {
+			if room.Left[j].ArtworkID != nil {
+				fn(&room.Left[j].ArtworkID, &room.Left[j].Artwork)
+			}
+		}
+		for j := range room.Center {
+			if room.Center[j].ArtworkID != nil {
+				fn(&room.Center[j].ArtworkID, &room.Center[j].Artwork)
+			}
+		}
+		for j := range room.Right {
+			if room.Right[j].ArtworkID != nil {
+				fn(&room.Right[j].ArtworkID, &room.Right[j].Artwork)
+			}
+		}
+	}
+}
+
+func canManage(artwork *model.Artwork, actor model.Actor) bool {
+	return actor.Role == "admin" || artwork.OwnerID == actor.UserID.UserID
+}
+
+func fromRequest(request *model.RequestArtwork) model.Artwork {
+	return model.Artwork{
+		AccessionNumber: request.AccessionNumber,
+		Title:           request.Title,
+		Creator:         request.Creator,
+		Date:            request.Date,
+		Medium:          request.Medium,
+		Dimensions:      request.Dimensions,
+		CreditLine:      request.CreditLine,
+		Rights:          request.Rights,
+		License:         request.License,
+		Description:     request.Description,
+		Images:          request.Images,
+	}
+}
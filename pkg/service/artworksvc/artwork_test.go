@@ -0,0 +1,116 @@
+package artworksvc_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/artworkrepo"
+	"atommuse/backend/exhibition-service/pkg/service/artworksvc"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestReferencedIDsAndEmbed(t *testing.T) {
+	vase, lamp, missing := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+
+	exhibition := &model.ResponseExhibition{
+		ExhibitionSections: []model.ExhibitionSection{
+			{LeftCol: model.LeftColumn{ArtworkID: &vase}, RightCol: model.RightColumn{ArtworkID: &missing}},
+			{Title: "No references"},
+		},
+		Room: []model.Room{{
+			Left:   []model.LeftRightItem{{ArtworkID: &lamp}},
+			Center: []model.CenterItem{{ArtworkID: &vase}},
+		}},
+	}
+
+	assert.Equal(t, []primitive.ObjectID{vase, missing, lamp}, artworksvc.ReferencedIDs(exhibition))
+
+	artworksvc.Embed(exhibition, map[primitive.ObjectID]*model.Artwork{
+		vase: {ID: vase, Title: "Celadon vase"},
+		lamp: {ID: lamp, Title: "Oil lamp"},
+	})
+
+	require.NotNil(t, exhibition.ExhibitionSections[0].LeftCol.Artwork)
+	assert.Equal(t, "Celadon vase", exhibition.ExhibitionSections[0].LeftCol.Artwork.Title)
+	assert.Nil(t, exhibition.ExhibitionSections[0].RightCol.Artwork)
+	assert.Equal(t, "Oil lamp", exhibition.Room[0].Left[0].Artwork.Title)
+	assert.Equal(t, "Celadon vase", exhibition.Room[0].Center[0].Artwork.Title)
+}
+
+func TestReferencedIDsWithoutReferences(t *testing.T) {
+	assert.Empty(t, artworksvc.ReferencedIDs(&model.ResponseExhibition{}))
+}
+
+// catalogueRepository serves a fixed set of artworks.
+type catalogueRepository struct {
+	artworkrepo.IArtworkRepository
+	artworks []model.Artwork
+}
+
+func (r *catalogueRepository) GetArtworksByIDs(ctx context.Context, artworkIDs []primitive.ObjectID) ([]model.Artwork, error) {
+	found := []model.Artwork{}
+	for _, artwork := range r.artworks {
+		for _, id := range artworkIDs {
+			if artwork.ID == id {
+				found = append(found, artwork)
+			}
+		}
+	}
+	return found, nil
+}
+
+func TestCheckReferences(t *testing.T) {
+	owner, editor, stranger := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	vase := model.Artwork{ID: primitive.NewObjectID(), OwnerID: owner, Title: "Celadon vase"}
+	lamp := model.Artwork{ID: primitive.NewObjectID(), OwnerID: editor, Title: "Oil lamp"}
+	mask := model.Artwork{ID: primitive.NewObjectID(), OwnerID: stranger, Title: "Khon mask"}
+	service := artworksvc.ArtworkServices{Repository: &catalogueRepository{artworks: []model.Artwork{vase, lamp, mask}}}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		ids  []primitive.ObjectID
+		err  error
+	}{
+		{"no references", nil, nil},
+		{"owner's and editor's artworks", []primitive.ObjectID{vase.ID, lamp.ID}, nil},
+		{"another catalogue", []primitive.ObjectID{vase.ID, mask.ID}, cerr.ErrArtworkNotAllowed},
+		{"missing artwork", []primitive.ObjectID{primitive.NewObjectID()}, cerr.ErrArtworkNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.CheckReferences(ctx, tt.ids, owner, editor)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDropForeignReferences(t *testing.T) {
+	owner, stranger := primitive.NewObjectID(), primitive.NewObjectID()
+	vase := model.Artwork{ID: primitive.NewObjectID(), OwnerID: owner, Title: "Celadon vase"}
+	mask := model.Artwork{ID: primitive.NewObjectID(), OwnerID: stranger, Title: "Khon mask"}
+	missing := primitive.NewObjectID()
+	service := artworksvc.ArtworkServices{Repository: &catalogueRepository{artworks: []model.Artwork{vase, mask}}}
+
+	exhibition := &model.ResponseExhibition{
+		ExhibitionSections: []model.ExhibitionSection{
+			{LeftCol: model.LeftColumn{ArtworkID: &vase.ID}, RightCol: model.RightColumn{ArtworkID: &mask.ID, Artwork: &mask}},
+		},
+		Room: []model.Room{{Center: []model.CenterItem{{ArtworkID: &missing}}}},
+	}
+
+	require.NoError(t, service.DropForeignReferences(context.Background(), exhibition, owner))
+	assert.Equal(t, &vase.ID, exhibition.ExhibitionSections[0].LeftCol.ArtworkID)
+	assert.Nil(t, exhibition.ExhibitionSections[0].RightCol.ArtworkID)
+	assert.Nil(t, exhibition.ExhibitionSections[0].RightCol.Artwork)
+	assert.Nil(t, exhibition.Room[0].Center[0].ArtworkID)
+}
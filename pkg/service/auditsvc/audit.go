@@ -0,0 +1,49 @@
+package auditsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/audit"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/auditrepo"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IAuditServices defines the interface for recording and querying the audit log.
+type IAuditServices interface {
+	Snapshot(ctx context.Context, kind audit.Kind, id string) (string, error)
+	Record(ctx context.Context, entry *model.AuditEntry) error
+	GetEntries(ctx context.Context, query model.AuditQuery) ([]model.AuditEntry, error)
+}
+
+// AuditServices is the implementation of the IAuditServices interface.
+type AuditServices struct {
+	Repository auditrepo.IAuditRepository
+}
+
+// Snapshot hashes the current document of a resource, or returns an empty string when it
+// does not exist.
+func (service AuditServices) Snapshot(ctx context.Context, kind audit.Kind, id string) (string, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return "", nil
+	}
+
+	document, err := service.Repository.FindDocument(ctx, kind.Collection, objectID)
+	if err != nil {
+		return "", err
+	}
+	return audit.Hash(document), nil
+}
+
+// Record appends an entry to the audit log, stamped with the time it is recorded at.
+func (service AuditServices) Record(ctx context.Context, entry *model.AuditEntry) error {
+	entry.ID = primitive.NewObjectID()
+	entry.At = time.Now()
+	return service.Repository.AppendEntry(ctx, entry)
+}
+
+func (service AuditServices) GetEntries(ctx context.Context, query model.AuditQuery) ([]model.AuditEntry, error) {
+	return service.Repository.GetEntries(ctx, query)
+}
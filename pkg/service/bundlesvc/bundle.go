@@ -0,0 +1,279 @@
+package bundlesvc
+
+import (
+	"archive/zip"
+	"atommuse/backend/exhibition-service/pkg/bundle"
+	"atommuse/backend/exhibition-service/pkg/media"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/templaterepo"
+	"atommuse/backend/exhibition-service/pkg/service/artworksvc"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
+	"atommuse/backend/exhibition-service/pkg/service/templatesvc"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IBundleServices defines the interface for exporting and importing exhibition bundles.
+type IBundleServices interface {
+	ExportExhibition(ctx context.Context, exhibitionID string, actor model.Actor) (*model.BundleManifest, map[string]string, error)
+	ImportExhibition(ctx context.Context, actor model.Actor, r io.ReaderAt, size int64, dryRun bool) (*model.ResponseImportExhibition, error)
+	LoadExhibition(ctx context.Context, exhibitionID string, actor model.Actor) (*model.ResponseExhibition, error)
+}
+
+// BundleServices is the implementation of the IBundleServices interface.
+type BundleServices struct {
+	Repository          templaterepo.ITemplateRepository
+	CollaboratorService collabsvc.ICollaboratorServices
+	// ScreeningService screens the texts of imported exhibitions before they are stored. Texts
+	// are not screened when it is nil.
+	ScreeningService screeningsvc.IScreeningServices
+	// ArtworkService drops the references of imported exhibitions to artworks outside the
+	// catalogue of the actor. References are kept when it is nil.
+	ArtworkService artworksvc.IArtworkServices
+}
+
+// ExportExhibition builds the manifest of an exhibition and collects the locally stored media
+// it references. The returned map links bundle paths to local files for bundle.Write.
+func (service BundleServices) ExportExhibition(ctx context.Context, exhibitionID string, actor model.Actor) (*model.BundleManifest, map[string]string, error) {
+	if _, err := service.CollaboratorService.Authorize(ctx, exhibitionID, actor, model.RoleEditor); err != nil {
+		return nil, nil, err
+	}
+
+	tree, err := service.Repository.GetExhibitionTree(ctx, exhibitionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	manifest := &model.BundleManifest{
+		Format:        bundle.Format,
+		SchemaVersion: bundle.SchemaVersion,
+		ExportedAt:    time.Now().UTC(),
+		Exhibition:    *tree,
+		Sections:      tree.ExhibitionSections,
+		Rooms:         tree.Room,
+		Media:         []model.BundleMedia{},
+	}
+
+	// Engagement data and collaborators belong to this environment
+	manifest.Exhibition.ExhibitionSections = nil
+	manifest.Exhibition.Room = nil
+	manifest.Exhibition.LikeList = nil
+	manifest.Exhibition.IsLike = false
+	manifest.Exhibition.Collaborators = nil
+
+	files := map[string]string{}
+	for _, ref := range media.References(tree) {
+		localPath, ok := media.LocalPath(ref)
+		if !ok {
+			continue
+		}
+
+		info, err := os.Stat(localPath)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+
+		sum, err := fileSHA256(localPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		bundlePath := fmt.Sprintf("%s%03d-%s", bundle.MediaDir, len(manifest.Media)+1, path.Base(ref))
+		manifest.Media = append(manifest.Media, model.BundleMedia{Ref: ref, Path: bundlePath, Size: info.Size(), SHA256: sum})
+		files[bundlePath] = localPath
+	}
+
+	return manifest, files, nil
+}
+
+// LoadExhibition retrieves an exhibition with its sections and rooms for an export that is
+// rendered by the caller, such as a static site.
+func (service BundleServices) LoadExhibition(ctx context.Context, exhibitionID string, actor model.Actor) (*model.ResponseExhibition, error) {
+	if _, err := service.CollaboratorService.Authorize(ctx, exhibitionID, actor, model.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return service.Repository.GetExhibitionTree(ctx, exhibitionID)
+}
+
+// ImportExhibition validates a bundle and stores its exhibition as a new private exhibition
+// owned by the actor. All IDs are reassigned. Media files are copied into a directory of the
+// import under MEDIA_ROOT and their references are rewritten to it. With dryRun nothing is
+// written and only the conflicts are reported.
+// The texts are screened first: a blocked bundle is rejected and a flagged one is held for review.
+// References to artworks outside the catalogue of the actor are dropped.
+func (service BundleServices) ImportExhibition(ctx context.Context, actor model.Actor, r io.ReaderAt, size int64, dryRun bool) (*model.ResponseImportExhibition, error) {
+	manifest, files, err := bundle.Read(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if problems := bundle.Validate(manifest); len(problems) > 0 {
+		return nil, &bundle.ValidationError{Problems: problems}
+	}
+
+	tree, conflicts := bundle.Tree(manifest)
+	exhibition := templatesvc.CloneExhibition(tree, actor.UserID)
+
+	exists, err := service.Repository.ExhibitionNameExists(ctx, actor.UserID.UserID, exhibition.ExhibitionName)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		conflicts = append(conflicts, model.ImportConflict{
+			Type:    model.ConflictDuplicateName,
+			Ref:     exhibition.ExhibitionName,
+			Message: "You already own an exhibition with this name",
+		})
+	}
+
+	screened, err := templatesvc.ScreenExhibition(ctx, service.ScreeningService, exhibition)
+	if err != nil {
+		return nil, err
+	}
+
+	// Artwork IDs of the bundle may name the catalogue of someone else in this environment
+	if service.ArtworkService != nil {
+		if err := service.ArtworkService.DropForeignReferences(ctx, exhibition, actor.UserID.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	importID := primitive.NewObjectID().Hex()
+	renamed := map[string]string{}
+	for _, item := range manifest.Media {
+		newRef, conflict, err := importMedia(item, files[item.Path], importID, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		if conflict != nil {
+			conflicts = append(conflicts, *conflict)
+		}
+		if newRef != item.Ref {
+			renamed[item.Ref] = newRef
+		}
+	}
+
+	media.Walk(exhibition, func(ref *string) {
+		if newRef, ok := renamed[*ref]; ok {
+			*ref = newRef
+		}
+	})
+	for i := range exhibition.MediaRights {
+		if newRef, ok := renamed[exhibition.MediaRights[i].Ref]; ok {
+			exhibition.MediaRights[i].Ref = newRef
+		}
+	}
+
+	response := &model.ResponseImportExhibition{DryRun: dryRun, Conflicts: conflicts}
+	if response.Conflicts == nil {
+		response.Conflicts = []model.ImportConflict{}
+	}
+	if dryRun {
+		return response, nil
+	}
+
+	response.ID, err = service.Repository.InsertExhibitionTree(ctx, exhibition)
+	if err != nil {
+		return nil, err
+	}
+	templatesvc.QueueForReview(ctx, service.ScreeningService, *response.ID, screened)
+
+	return response, nil
+}
+
+// importMedia stores one media file of a bundle in the directory of the import and returns
+// the reference to use for it. Files are never stored where the bundle references them, so an
+// import cannot place files among the media of other exhibitions.
+func importMedia(item model.BundleMedia, file *zip.File, importID string, dryRun bool) (string, *model.ImportConflict, error) {
+	if file == nil {
+		return item.Ref, &model.ImportConflict{Type: model.ConflictMediaMissing, Ref: item.Ref, Message: "Media file is missing from the bundle"}, nil
+	}
+
+	// Bundle paths are unique and validated to stay inside the media directory of the bundle
+	newRef := path.Join("/imports", importID, strings.TrimPrefix(item.Path, bundle.MediaDir))
+	target, ok := media.LocalPath(newRef)
+	if !ok {
+		return item.Ref, &model.ImportConflict{Type: model.ConflictMediaNotStored, Ref: item.Ref, Message: "No local media storage is configured; the reference was kept"}, nil
+	}
+
+	if dryRun {
+		return newRef, nil, nil
+	}
+
+	if err := extractFile(file, target, item.SHA256); err != nil {
+		if err == errChecksumMismatch {
+			return item.Ref, &model.ImportConflict{Type: model.ConflictMediaCorrupt, Ref: item.Ref, Message: "Media file does not match its checksum and was skipped"}, nil
+		}
+		return "", nil, err
+	}
+
+	return newRef, nil, nil
+}
+
+var errChecksumMismatch = errors.New("checksum mismatch")
+
+// extractFile copies an archive entry to target through a temporary file, so a file that fails
+// its checksum or is too large never replaces anything.
+func extractFile(file *zip.File, target, checksum string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".import-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(reader, bundle.MaxFileSize+1))
+	if err == nil && n > bundle.MaxFileSize {
+		err = fmt.Errorf("%s is larger than %d bytes", file.Name, bundle.MaxFileSize)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != checksum {
+		return errChecksumMismatch
+	}
+
+	return os.Rename(tmp.Name(), target)
+}
+
+func fileSHA256(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,80 @@
+package bundlesvc_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/bundle"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/templaterepo"
+	"atommuse/backend/exhibition-service/pkg/service/bundlesvc"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// treeRepository records the exhibition it is asked to insert.
+type treeRepository struct {
+	templaterepo.ITemplateRepository
+	inserted *model.ResponseExhibition
+}
+
+func (r *treeRepository) ExhibitionNameExists(ctx context.Context, userID primitive.ObjectID, name string) (bool, error) {
+	return false, nil
+}
+
+func (r *treeRepository) InsertExhibitionTree(ctx context.Context, exhibition *model.ResponseExhibition) (*primitive.ObjectID, error) {
+	r.inserted = exhibition
+	id := primitive.NewObjectID()
+	return &id, nil
+}
+
+func TestImportExhibitionStoresMediaUnderImport(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("MEDIA_ROOT", root)
+
+	local := filepath.Join(t.TempDir(), "map.png")
+	require.NoError(t, os.WriteFile(local, []byte("png"), 0o644))
+	sum := sha256.Sum256([]byte("png"))
+
+	room := model.Room{ID: primitive.NewObjectID(), MapThumbnail: "/uploads/other/map.png"}
+	manifest := &model.BundleManifest{
+		Format:        bundle.Format,
+		SchemaVersion: bundle.SchemaVersion,
+		ExportedAt:    time.Now().UTC(),
+		Exhibition: model.ResponseExhibition{
+			ExhibitionName: "Siam Ceramics",
+			LayoutUsed:     "blogLayout",
+			RoomsID:        []string{room.ID.Hex()},
+		},
+		Rooms: []model.Room{room},
+		Media: []model.BundleMedia{{Ref: room.MapThumbnail, Path: "media/001-map.png", Size: 3, SHA256: hex.EncodeToString(sum[:])}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, bundle.Write(&buf, manifest, map[string]string{"media/001-map.png": local}))
+
+	repository := &treeRepository{}
+	service := bundlesvc.BundleServices{Repository: repository}
+	actor := model.Actor{UserID: model.UserID{UserID: primitive.NewObjectID()}}
+	result, err := service.ImportExhibition(context.Background(), actor, bytes.NewReader(buf.Bytes()), int64(buf.Len()), false)
+	require.NoError(t, err)
+	assert.Empty(t, result.Conflicts)
+
+	ref := repository.inserted.Room[0].MapThumbnail
+	assert.True(t, strings.HasPrefix(ref, "/imports/"), ref)
+	assert.True(t, strings.HasSuffix(ref, "/001-map.png"), ref)
+	content, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(ref)))
+	require.NoError(t, err)
+	assert.Equal(t, "png", string(content))
+
+	// Nothing is written where the bundle referenced the file
+	assert.NoFileExists(t, filepath.Join(root, "uploads", "other", "map.png"))
+}
@@ -0,0 +1,170 @@
+package collabsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/collabrepo"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ICollaboratorServices defines the interface for collaborator services.
+type ICollaboratorServices interface {
+	Authorize(ctx context.Context, exhibitionID string, actor model.Actor, required string) (*model.ExhibitionAccess, error)
+	GetCollaborators(ctx context.Context, exhibitionID string, actor model.Actor) ([]model.Collaborator, error)
+	InviteCollaborator(ctx context.Context, exhibitionID string, actor model.Actor, request *model.RequestInviteCollaborator) error
+	UpdateCollaboratorRole(ctx context.Context, exhibitionID, userID string, actor model.Actor, role string) error
+	RemoveCollaborator(ctx context.Context, exhibitionID, userID string, actor model.Actor) error
+	AcceptInvitation(ctx context.Context, exhibitionID string, actor model.Actor) error
+	TransferOwnership(ctx context.Context, exhibitionID string, actor model.Actor, request *model.RequestTransferOwnership) error
+}
+
+// CollaboratorServices is the implementation of the ICollaboratorServices interface.
+type CollaboratorServices struct {
+	Repository collabrepo.ICollaboratorRepository
+}
+
+// roleRank orders the collaborator roles so that a higher role includes the lower ones.
+var roleRank = map[string]int{
+	model.RoleViewer: 1,
+	model.RoleEditor: 2,
+	model.RoleOwner:  3,
+}
+
+// RoleOf returns the role the user holds on the exhibition, or an empty string when none.
+// Pending invitations do not grant a role.
+func RoleOf(access *model.ExhibitionAccess, userID primitive.ObjectID) string {
+	if access.UserID.UserID == userID {
+		return model.RoleOwner
+	}
+	for _, collaborator := range access.Collaborators {
+		if collaborator.UserID == userID && collaborator.Status == model.InvitationAccepted {
+			return collaborator.Role
+		}
+	}
+	return ""
+}
+
+// Authorize checks that the actor holds at least the required role on the exhibition
+// and returns the exhibition access data. Admins are always allowed.
+func (service CollaboratorServices) Authorize(ctx context.Context, exhibitionID string, actor model.Actor, required string) (*model.ExhibitionAccess, error) {
+	access, err := service.Repository.GetExhibitionAccess(ctx, exhibitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if actor.Role == "admin" {
+		return access, nil
+	}
+
+	if roleRank[RoleOf(access, actor.UserID.UserID)] < roleRank[required] {
+		return nil, cerr.ErrForbidden
+	}
+
+	return access, nil
+}
+
+func (service CollaboratorServices) GetCollaborators(ctx context.Context, exhibitionID string, actor model.Actor) ([]model.Collaborator, error) {
+	access, err := service.Authorize(ctx, exhibitionID, actor, model.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := model.Collaborator{
+		UserID:       access.UserID.UserID,
+		FirstName:    access.UserID.FirstName,
+		LastName:     access.UserID.LastName,
+		Username:     access.UserID.Username,
+		ProfileImage: access.UserID.ProfileImage,
+		Role:         model.RoleOwner,
+		Status:       model.InvitationAccepted,
+	}
+
+	return append([]model.Collaborator{owner}, access.Collaborators...), nil
+}
+
+func (service CollaboratorServices) InviteCollaborator(ctx context.Context, exhibitionID string, actor model.Actor, request *model.RequestInviteCollaborator) error {
+	if err := validateRole(request.Role); err != nil {
+		return err
+	}
+
+	if _, err := service.Authorize(ctx, exhibitionID, actor, model.RoleOwner); err != nil {
+		return err
+	}
+
+	collaborator := &model.Collaborator{
+		UserID:       request.UserID,
+		FirstName:    request.FirstName,
+		LastName:     request.LastName,
+		Username:     request.Username,
+		ProfileImage: request.ProfileImage,
+		Role:         request.Role,
+		Status:       model.InvitationPending,
+		InvitedBy:    actor.UserID.UserID,
+		InvitedAt:    time.Now(),
+	}
+
+	return service.Repository.AddCollaborator(ctx, exhibitionID, collaborator)
+}
+
+func (service CollaboratorServices) UpdateCollaboratorRole(ctx context.Context, exhibitionID, userID string, actor model.Actor, role string) error {
+	if err := validateRole(role); err != nil {
+		return err
+	}
+
+	collaboratorID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return cerr.ErrCollaboratorNotFound
+	}
+
+	if _, err := service.Authorize(ctx, exhibitionID, actor, model.RoleOwner); err != nil {
+		return err
+	}
+
+	return service.Repository.UpdateCollaboratorRole(ctx, exhibitionID, collaboratorID, role)
+}
+
+// RemoveCollaborator removes a collaborator. Owners may remove anyone, collaborators may remove themselves.
+func (service CollaboratorServices) RemoveCollaborator(ctx context.Context, exhibitionID, userID string, actor model.Actor) error {
+	collaboratorID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return cerr.ErrCollaboratorNotFound
+	}
+
+	if collaboratorID != actor.UserID.UserID {
+		if _, err := service.Authorize(ctx, exhibitionID, actor, model.RoleOwner); err != nil {
+			return err
+		}
+	}
+
+	return service.Repository.RemoveCollaborator(ctx, exhibitionID, collaboratorID)
+}
+
+func (service CollaboratorServices) AcceptInvitation(ctx context.Context, exhibitionID string, actor model.Actor) error {
+	return service.Repository.AcceptInvitation(ctx, exhibitionID, actor.UserID)
+}
+
+// TransferOwnership hands the exhibition over to an accepted collaborator.
+func (service CollaboratorServices) TransferOwnership(ctx context.Context, exhibitionID string, actor model.Actor, request *model.RequestTransferOwnership) error {
+	access, err := service.Authorize(ctx, exhibitionID, actor, model.RoleOwner)
+	if err != nil {
+		return err
+	}
+
+	for _, collaborator := range access.Collaborators {
+		if collaborator.UserID == request.UserID && collaborator.Status == model.InvitationAccepted {
+			return service.Repository.TransferOwnership(ctx, exhibitionID, access.UserID, collaborator)
+		}
+	}
+
+	return cerr.ErrCollaboratorNotFound
+}
+
+func validateRole(role string) error {
+	if role != model.RoleEditor && role != model.RoleViewer {
+		return cerr.ErrInvalidRole
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package collabsvc_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// stubRepository serves a fixed exhibition access document.
+type stubRepository struct {
+	access      *model.ExhibitionAccess
+	transferred *model.Collaborator
+}
+
+func (r *stubRepository) GetExhibitionAccess(ctx context.Context, exhibitionID string) (*model.ExhibitionAccess, error) {
+	if r.access == nil {
+		return nil, cerr.ErrExhibitionNotFound
+	}
+	return r.access, nil
+}
+
+func (r *stubRepository) AddCollaborator(ctx context.Context, exhibitionID string, collaborator *model.Collaborator) error {
+	return nil
+}
+
+func (r *stubRepository) UpdateCollaboratorRole(ctx context.Context, exhibitionID string, userID primitive.ObjectID, role string) error {
+	return nil
+}
+
+func (r *stubRepository) RemoveCollaborator(ctx context.Context, exhibitionID string, userID primitive.ObjectID) error {
+	return nil
+}
+
+func (r *stubRepository) AcceptInvitation(ctx context.Context, exhibitionID string, user model.UserID) error {
+	return nil
+}
+
+func (r *stubRepository) TransferOwnership(ctx context.Context, exhibitionID string, previousOwner model.UserID, newOwner model.Collaborator) error {
+	r.transferred = &newOwner
+	return nil
+}
+
+func actorFor(userID primitive.ObjectID, role string) model.Actor {
+	return model.Actor{UserID: model.UserID{UserID: userID}, Role: role}
+}
+
+func TestAuthorize(t *testing.T) {
+	owner := primitive.NewObjectID()
+	editor := primitive.NewObjectID()
+	viewer := primitive.NewObjectID()
+	invited := primitive.NewObjectID()
+	stranger := primitive.NewObjectID()
+
+	repo := &stubRepository{access: &model.ExhibitionAccess{
+		UserID: model.UserID{UserID: owner},
+		Collaborators: []model.Collaborator{
+			{UserID: editor, Role: model.RoleEditor, Status: model.InvitationAccepted},
+			{UserID: viewer, Role: model.RoleViewer, Status: model.InvitationAccepted},
+			{UserID: invited, Role: model.RoleEditor, Status: model.InvitationPending},
+		},
+	}}
+	service := collabsvc.CollaboratorServices{Repository: repo}
+
+	tests := []struct {
+		name     string
+		actor    model.Actor
+		required string
+		err      error
+	}{
+		{"owner can delete", actorFor(owner, "exhibitor"), model.RoleOwner, nil},
+		{"editor can edit", actorFor(editor, "exhibitor"), model.RoleEditor, nil},
+		{"editor cannot delete", actorFor(editor, "exhibitor"), model.RoleOwner, cerr.ErrForbidden},
+		{"viewer can view", actorFor(viewer, "exhibitor"), model.RoleViewer, nil},
+		{"viewer cannot edit", actorFor(viewer, "exhibitor"), model.RoleEditor, cerr.ErrForbidden},
+		{"pending invitation grants nothing", actorFor(invited, "exhibitor"), model.RoleViewer, cerr.ErrForbidden},
+		{"stranger cannot view", actorFor(stranger, "exhibitor"), model.RoleViewer, cerr.ErrForbidden},
+		{"admin can do anything", actorFor(stranger, "admin"), model.RoleOwner, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Authorize(context.Background(), owner.Hex(), tt.actor, tt.required)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestTransferOwnership(t *testing.T) {
+	owner := primitive.NewObjectID()
+	editor := primitive.NewObjectID()
+	invited := primitive.NewObjectID()
+
+	repo := &stubRepository{access: &model.ExhibitionAccess{
+		UserID: model.UserID{UserID: owner},
+		Collaborators: []model.Collaborator{
+			{UserID: editor, Role: model.RoleEditor, Status: model.InvitationAccepted},
+			{UserID: invited, Role: model.RoleEditor, Status: model.InvitationPending},
+		},
+	}}
+	service := collabsvc.CollaboratorServices{Repository: repo}
+
+	// Ownership cannot go to a pending invitee
+	err := service.TransferOwnership(context.Background(), owner.Hex(), actorFor(owner, "exhibitor"), &model.RequestTransferOwnership{UserID: invited})
+	assert.Equal(t, cerr.ErrCollaboratorNotFound, err)
+
+	// Editors cannot transfer ownership
+	err = service.TransferOwnership(context.Background(), owner.Hex(), actorFor(editor, "exhibitor"), &model.RequestTransferOwnership{UserID: editor})
+	assert.Equal(t, cerr.ErrForbidden, err)
+
+	err = service.TransferOwnership(context.Background(), owner.Hex(), actorFor(owner, "exhibitor"), &model.RequestTransferOwnership{UserID: editor})
+	assert.Nil(t, err)
+	assert.Equal(t, editor, repo.transferred.UserID)
+}
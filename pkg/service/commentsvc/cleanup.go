@@ -0,0 +1,62 @@
+package commentsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/comment"
+	"atommuse/backend/exhibition-service/pkg/repositorty/commentrepo"
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	// PollInterval is how long the worker waits when no cleanup is due.
+	PollInterval = 30 * time.Second
+	// lease is how long a claimed cleanup is hidden from other workers.
+	lease = 2 * comment.Attempts * comment.Timeout
+)
+
+// CleanupWorker retries the comment cleanups queued while the comment service was down,
+// waiting longer after every failure. Several instances may run side by side: every cleanup
+// is claimed by one worker at a time.
+type CleanupWorker struct {
+	Repository commentrepo.ICleanupRepository
+	Cleaner    comment.CommentCleaner
+}
+
+// Run retries queued cleanups until the context is cancelled.
+func (w *CleanupWorker) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		cleanup, err := w.Repository.ClaimDueCleanup(ctx, time.Now(), lease)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Error claiming comment cleanup: %v", err)
+			}
+			sleep(ctx, PollInterval)
+			continue
+		}
+		if cleanup == nil {
+			sleep(ctx, PollInterval)
+			continue
+		}
+
+		if err := w.Cleaner.DeleteExhibitionComments(ctx, cleanup.ExhibitionID); err != nil {
+			log.Printf("Error cleaning up comments of exhibition %s: %v", cleanup.ExhibitionID.Hex(), err)
+			next := time.Now().Add(comment.Backoff(cleanup.Failures + 1))
+			err = w.Repository.RescheduleCleanup(ctx, cleanup.ID, next, err.Error())
+		} else {
+			err = w.Repository.CompleteCleanup(ctx, cleanup.ID)
+		}
+		if err != nil {
+			log.Printf("Error updating comment cleanup %s: %v", cleanup.ID.Hex(), err)
+		}
+	}
+}
+
+func sleep(ctx context.Context, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
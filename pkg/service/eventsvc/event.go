@@ -0,0 +1,54 @@
+package eventsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/eventrepo"
+	"context"
+)
+
+// EventHandler receives the events of a stream. It is called with a nil event and the
+// position to resume from once the stream is open and whenever it has been idle.
+type EventHandler func(event *model.Event, token string) error
+
+// IEventServices defines the interface for the streams of domain events.
+type IEventServices interface {
+	StreamEvents(ctx context.Context, actor model.Actor, query model.EventQuery, handle EventHandler) error
+	FollowEvents(ctx context.Context, query model.EventQuery, handle EventHandler) error
+}
+
+// EventServices is the implementation of the IEventServices interface.
+type EventServices struct {
+	Repository eventrepo.IEventRepository
+}
+
+// StreamEvents follows the events selected by the query until the context is cancelled.
+// Streams that are not limited to one exhibition are limited to the exhibitions of the actor,
+// unless the actor is an admin, who may follow every exhibition or those of another owner.
+func (service EventServices) StreamEvents(ctx context.Context, actor model.Actor, query model.EventQuery, handle EventHandler) error {
+	if query.ExhibitionID == nil && actor.Role != "admin" {
+		if query.OwnerID != nil && *query.OwnerID != actor.UserID.UserID {
+			return cerr.ErrForbidden
+		}
+		query.OwnerID = &actor.UserID.UserID
+	}
+
+	return service.FollowEvents(ctx, query, handle)
+}
+
+// FollowEvents follows the events selected by the query until the context is cancelled,
+// without limiting them to an actor.
+func (service EventServices) FollowEvents(ctx context.Context, query model.EventQuery, handle EventHandler) error {
+	return service.Repository.WatchChanges(ctx, query, func(change *event.Change, token string) error {
+		if change == nil {
+			return handle(nil, token)
+		}
+
+		domainEvent, ok := event.FromChange(change)
+		if !ok || !event.Matches(domainEvent, query.Types) {
+			return nil
+		}
+		return handle(domainEvent, domainEvent.ID)
+	})
+}
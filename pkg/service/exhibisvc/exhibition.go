@@ -1,10 +1,23 @@
 package exhibisvc
 
 import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/comment"
+	"atommuse/backend/exhibition-service/pkg/geo"
+	"atommuse/backend/exhibition-service/pkg/i18n"
+	"atommuse/backend/exhibition-service/pkg/layout"
 	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
 	"atommuse/backend/exhibition-service/pkg/repositorty/exhibirepo"
+	"atommuse/backend/exhibition-service/pkg/rights"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"atommuse/backend/exhibition-service/pkg/service/artworksvc"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
+	"atommuse/backend/exhibition-service/pkg/service/sharesvc"
 	"context"
 	"errors"
+	"log"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -13,9 +26,10 @@ import (
 // IExhibitionServices defines the interface for exhibition services.
 type IExhibitionServices interface {
 	GetAllExhibitions(ctx context.Context) ([]model.ResponseExhibition, error)
-	GetExhibitionByID(ctx *gin.Context, exhibitionID string, userID string) (*model.ResponseExhibition, error)
+	GetExhibitionByID(ctx *gin.Context, exhibitionID string, viewer model.ExhibitionViewer) (*model.ResponseExhibition, error)
+	PrepareContent(ctx *gin.Context, exhibitionID string, viewer model.ExhibitionViewer, sections []model.ExhibitionSection, rooms []model.Room) (*model.ResponseExhibition, error)
 	GetExhibitionsIsPublic(context.Context) ([]model.ResponseExhibition, error)
-	GetExhibitionByUserID(ctx context.Context, userID string) ([]*model.ResponseExhibition, error)
+	GetExhibitionByUserID(ctx context.Context, userID string, viewer model.ExhibitionViewer) ([]*model.ResponseExhibition, error)
 	CreateExhibition(ctx context.Context, exhibition *model.RequestCreateExhibition) (*primitive.ObjectID, error)
 	DeleteExhibition(ctx context.Context, exhibitionID string) error
 	UpdateExhibition(ctx context.Context, exhibitionID string, update *model.RequestUpdateExhibition) (*primitive.ObjectID, error)
@@ -23,40 +37,297 @@ type IExhibitionServices interface {
 	LikeExhibition(ctx *gin.Context, exhibitionID, userID string) error
 	UnlikeExhibition(ctx *gin.Context, exhibitionID, userID string) error
 	GetExhibitionsByCategory(ctx context.Context, category string) ([]model.ResponseExhibition, error)
+	GetPublishedExhibitions(ctx context.Context, query model.PublishedQuery) ([]model.ResponseExhibition, error)
+	CountPublishedExhibitions(ctx context.Context) (int64, error)
 	GetCurrentlyExhibitions(ctx context.Context) ([]model.ResponseExhibition, error)
 	GetPreviouslyExhibitions(ctx context.Context) ([]model.ResponseExhibition, error)
 	GetUpcomingExhibitions(ctx context.Context) ([]model.ResponseExhibition, error)
 	GetExhibitionsByFilter(ctx context.Context, category, status, sortOrder string) ([]model.ResponseExhibition, error)
-	BanExhibition(ctx context.Context, exhibitionID string) error
+	UpdateMediaRights(ctx context.Context, exhibitionID string, mediaRights []model.MediaRights) error
+	SearchExhibitions(ctx context.Context, text, locale string, limit int) ([]model.ResponseExhibition, error)
+	GetNearbyExhibitions(ctx context.Context, query model.NearbyQuery) ([]model.ResponseNearbyExhibition, error)
+}
+
+// ITreeRepository loads an exhibition together with its sections and rooms.
+type ITreeRepository interface {
+	GetExhibitionTree(ctx context.Context, exhibitionID string) (*model.ResponseExhibition, error)
 }
 
 // ExhibitionServices is the implementation of the IExhibitionServices interface.
 type ExhibitionServices struct {
-	Repository exhibirepo.IExhibitionRepository
+	Repository       exhibirepo.IExhibitionRepository
+	ShareLinkService sharesvc.IShareLinkServices
+	// ArtworkService embeds the catalogue metadata of referenced artworks. It is optional.
+	ArtworkService artworksvc.IArtworkServices
+	// TreeRepository loads the media of an exhibition to check embargoes before it is published.
+	// Embargoes are not checked when it is nil.
+	TreeRepository ITreeRepository
+	// CommentCleaner removes the comments of deleted exhibitions. Comments are kept when it is nil.
+	CommentCleaner comment.CommentCleaner
+	// ScreeningService screens the texts of exhibitions before they are written. Texts are not
+	// screened when it is nil.
+	ScreeningService screeningsvc.IScreeningServices
 }
 
 func (service ExhibitionServices) GetAllExhibitions(ctx context.Context) ([]model.ResponseExhibition, error) {
 	return service.Repository.GetAllExhibitions(ctx)
 }
 
-func (service ExhibitionServices) GetExhibitionByID(ctx *gin.Context, exhibitionID string, userID string) (*model.ResponseExhibition, error) {
-	return service.Repository.GetExhibitionByID(ctx, exhibitionID, userID)
+// GetExhibitionByID retrieves an exhibition the viewer is allowed to see.
+// Private exhibitions are only returned to admins, the owner, collaborators and holders of a valid share link.
+// Banned exhibitions and those held for review are only returned to admins, the owner and collaborators.
+func (service ExhibitionServices) GetExhibitionByID(ctx *gin.Context, exhibitionID string, viewer model.ExhibitionViewer) (*model.ResponseExhibition, error) {
+	exhibition, err := service.visible(ctx, exhibitionID, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.prepare(ctx, exhibition, viewer)
+}
+
+// PrepareContent checks that the viewer may see an exhibition and prepares sections and rooms
+// of it read on their own as GetExhibitionByID prepares the content it returns. The prepared
+// sections and rooms are returned in an exhibition holding nothing else but its locales, for
+// i18n.Localize to negotiate the locale of the content as for the whole exhibition.
+func (service ExhibitionServices) PrepareContent(ctx *gin.Context, exhibitionID string, viewer model.ExhibitionViewer, sections []model.ExhibitionSection, rooms []model.Room) (*model.ResponseExhibition, error) {
+	exhibition, err := service.visible(ctx, exhibitionID, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &model.ResponseExhibition{
+		ID:                 exhibition.ID,
+		UserID:             exhibition.UserID,
+		Collaborators:      exhibition.Collaborators,
+		MediaRights:        exhibition.MediaRights,
+		DefaultLocale:      exhibition.DefaultLocale,
+		Translations:       exhibition.Translations,
+		ExhibitionSections: sections,
+		Room:               rooms,
+	}
+	if _, err := service.prepare(ctx, content, viewer); err != nil {
+		return nil, err
+	}
+
+	content.Collaborators = nil
+	content.MediaRights = nil
+	return content, nil
+}
+
+// visible reads an exhibition the viewer may see.
+func (service ExhibitionServices) visible(ctx *gin.Context, exhibitionID string, viewer model.ExhibitionViewer) (*model.ResponseExhibition, error) {
+	exhibition, err := service.Repository.GetExhibitionByID(ctx, exhibitionID, sharesvc.ViewerUserID(viewer))
+	if err != nil {
+		return nil, err
+	}
+
+	if IsPublished(exhibition) || sharesvc.CanView(exhibition, viewer) {
+		return exhibition, nil
+	}
+
+	if viewer.ShareToken == "" || exhibition.Status != "created" {
+		return nil, cerr.ErrExhibitionNotFound
+	}
+
+	link, err := service.ShareLinkService.ResolveShareLink(ctx, viewer.ShareToken, viewer.SharePassword)
+	if err != nil {
+		return nil, err
+	}
+	if link.ExhibitionID != exhibition.ID {
+		return nil, cerr.ErrShareLinkNotFound
+	}
+
+	return exhibition, nil
+}
+
+// prepare adds the metadata of referenced artworks and the credit lines of media to an
+// exhibition. Embargoed media is removed unless the viewer may edit the exhibition, and the
+// collaborators and pending invitations are only listed to the owner, editors and admins.
+func (service ExhibitionServices) prepare(ctx context.Context, exhibition *model.ResponseExhibition, viewer model.ExhibitionViewer) (*model.ResponseExhibition, error) {
+	if !sharesvc.CanEdit(exhibition, viewer) {
+		exhibition.Collaborators = nil
+	}
+
+	if service.ArtworkService != nil {
+		if err := service.ArtworkService.EmbedArtworks(ctx, exhibition); err != nil {
+			return nil, err
+		}
+	}
+
+	if !sharesvc.CanView(exhibition, viewer) {
+		rights.Redact(exhibition, time.Now())
+	}
+	rights.AddCredits(exhibition)
+
+	return exhibition, nil
+}
+
+// IsPublished reports whether an exhibition is public, and neither banned nor held for review.
+func IsPublished(exhibition *model.ResponseExhibition) bool {
+	return exhibition.IsPublic && exhibition.Status == "created"
 }
 
 func (service ExhibitionServices) GetExhibitionsIsPublic(ctx context.Context) ([]model.ResponseExhibition, error) {
-	return service.Repository.GetExhibitionsIsPublic(ctx)
+	return hideCollaborators(service.Repository.GetExhibitionsIsPublic(ctx))
 }
 
+// CreateExhibition creates an exhibition and indexes its texts for search. Exhibitions with
+// flagged texts are created held for review, and blocked texts are rejected.
 func (service ExhibitionServices) CreateExhibition(ctx context.Context, exhibition *model.RequestCreateExhibition) (*primitive.ObjectID, error) {
-	return service.Repository.CreateExhibition(ctx, exhibition)
+	if _, ok := layout.Lookup(exhibition.LayoutUsed); !ok {
+		return nil, cerr.ErrUnsupportedLayout
+	}
+	if err := geo.ValidateVenue(exhibition.Venue); err != nil {
+		return nil, err
+	}
+
+	texts := &model.ResponseExhibition{
+		ExhibitionName:        exhibition.ExhibitionName,
+		ExhibitionDescription: exhibition.ExhibitionDescription,
+		ExhibitionTags:        exhibition.ExhibitionTags,
+		DefaultLocale:         exhibition.DefaultLocale,
+		Translations:          exhibition.Translations,
+	}
+	exhibition.SearchIndex = i18n.SearchIndex(texts)
+
+	var screened *model.ScreeningResult
+	if service.ScreeningService != nil {
+		result, err := service.ScreeningService.Screen(ctx, screening.ExhibitionFields(texts))
+		if err != nil {
+			return nil, err
+		}
+		if result.Verdict == model.ScreeningFlagged {
+			exhibition.Status = moderation.Review
+			screened = result
+		}
+	}
+
+	objectID, err := service.Repository.CreateExhibition(ctx, exhibition)
+	if err != nil || screened == nil {
+		return objectID, err
+	}
+
+	// The exhibition is already held, only its place in the moderation queue is missing
+	if err := service.ScreeningService.HoldForReview(ctx, *objectID, screened); err != nil {
+		log.Printf("Error queueing exhibition %s for review: %v", objectID.Hex(), err)
+	}
+	return objectID, nil
 }
 
+// DeleteExhibition deletes an exhibition with its content and then its comments. The
+// exhibition stays deleted when its comments cannot be removed.
 func (service ExhibitionServices) DeleteExhibition(ctx context.Context, exhibitionID string) error {
-	return service.Repository.DeleteExhibition(ctx, exhibitionID)
+	if err := service.Repository.DeleteExhibition(ctx, exhibitionID); err != nil {
+		return err
+	}
+	if service.CommentCleaner == nil {
+		return nil
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return err
+	}
+	if err := service.CommentCleaner.DeleteExhibitionComments(ctx, objectID); err != nil {
+		log.Printf("Error cleaning up comments of exhibition %s: %v", exhibitionID, err)
+	}
+	return nil
 }
 
+// UpdateExhibition updates an exhibition and refreshes the search index of its texts, including
+// those of its sections. Public exhibitions may not use embargoed media. Flagged texts hold the
+// exhibition for review before they are written, and blocked texts are rejected.
 func (service ExhibitionServices) UpdateExhibition(ctx context.Context, exhibitionID string, update *model.RequestUpdateExhibition) (*primitive.ObjectID, error) {
-	return service.Repository.UpdateExhibition(ctx, exhibitionID, update)
+	if _, ok := layout.Lookup(update.LayoutUsed); !ok {
+		return nil, cerr.ErrUnsupportedLayout
+	}
+	if err := geo.ValidateVenue(update.Venue); err != nil {
+		return nil, err
+	}
+
+	if service.TreeRepository != nil {
+		exhibition, err := service.TreeRepository.GetExhibitionTree(ctx, exhibitionID)
+		if err != nil {
+			return nil, err
+		}
+
+		exhibition.ExhibitionName = update.ExhibitionName
+		exhibition.ExhibitionDescription = update.ExhibitionDescription
+		exhibition.ThumbnailImg = update.ThumbnailImg
+		if update.DefaultLocale != "" {
+			exhibition.DefaultLocale = update.DefaultLocale
+		}
+		if update.Translations != nil {
+			exhibition.Translations = update.Translations
+		}
+
+		if update.IsPublic {
+			if err := rights.CheckPublishable(exhibition, time.Now()); err != nil {
+				return nil, err
+			}
+		}
+		update.SearchIndex = i18n.SearchIndex(exhibition)
+	}
+
+	if service.ScreeningService == nil {
+		return service.Repository.UpdateExhibition(ctx, exhibitionID, update)
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := screening.ExhibitionFields(&model.ResponseExhibition{
+		ExhibitionName:        update.ExhibitionName,
+		ExhibitionDescription: update.ExhibitionDescription,
+		ExhibitionTags:        update.ExhibitionTags,
+		Translations:          update.Translations,
+	})
+	var updatedID *primitive.ObjectID
+	err = service.ScreeningService.ScreenContent(ctx, objectID, fields, func() error {
+		var err error
+		updatedID, err = service.Repository.UpdateExhibition(ctx, exhibitionID, update)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedID, nil
+}
+
+// SearchExhibitions finds published exhibitions by the words of their texts in any locale. The
+// search terms are analysed in the given locale.
+func (service ExhibitionServices) SearchExhibitions(ctx context.Context, text, locale string, limit int) ([]model.ResponseExhibition, error) {
+	return hideCollaborators(service.Repository.SearchExhibitions(ctx, text, i18n.SearchLanguage(locale), limit))
+}
+
+// GetNearbyExhibitions finds public exhibitions with a venue near a place, nearest first.
+func (service ExhibitionServices) GetNearbyExhibitions(ctx context.Context, query model.NearbyQuery) ([]model.ResponseNearbyExhibition, error) {
+	exhibitions, err := service.Repository.GetNearbyExhibitions(ctx, query)
+	for i := range exhibitions {
+		exhibitions[i].Collaborators = nil
+	}
+	return exhibitions, err
+}
+
+// UpdateMediaRights replaces the rights metadata of the media an exhibition uses. Embargoes
+// are checked against the media of public exhibitions.
+func (service ExhibitionServices) UpdateMediaRights(ctx context.Context, exhibitionID string, mediaRights []model.MediaRights) error {
+	if service.TreeRepository != nil {
+		exhibition, err := service.TreeRepository.GetExhibitionTree(ctx, exhibitionID)
+		if err != nil {
+			return err
+		}
+		exhibition.MediaRights = mediaRights
+		if exhibition.IsPublic {
+			if err := rights.CheckPublishable(exhibition, time.Now()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return service.Repository.UpdateMediaRights(ctx, exhibitionID, mediaRights)
 }
 
 func (service ExhibitionServices) UpdateVisitedNumber(ctx context.Context, exhibitionID string, visitedNumber int) error {
@@ -78,28 +349,61 @@ func (service ExhibitionServices) UnlikeExhibition(ctx *gin.Context, exhibitionI
 	return service.Repository.UnlikeExhibition(ctx, exhibitionID, userID)
 }
 
-func (service ExhibitionServices) GetExhibitionByUserID(ctx context.Context, userID string) ([]*model.ResponseExhibition, error) {
-	return service.Repository.GetExhibitionByUserID(ctx, userID)
+// GetExhibitionByUserID lists the exhibitions a user owns or collaborates on. The user and
+// admins get all of them, other viewers only the published ones. The collaborators of an
+// exhibition are only listed to those who may edit it.
+func (service ExhibitionServices) GetExhibitionByUserID(ctx context.Context, userID string, viewer model.ExhibitionViewer) ([]*model.ResponseExhibition, error) {
+	exhibitions, err := service.Repository.GetExhibitionByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	all := viewer.Actor != nil && (viewer.Actor.Role == "admin" || viewer.Actor.UserID.UserID.Hex() == userID)
+	var visible []*model.ResponseExhibition
+	for _, exhibition := range exhibitions {
+		if !all && !IsPublished(exhibition) {
+			continue
+		}
+		if !sharesvc.CanEdit(exhibition, viewer) {
+			exhibition.Collaborators = nil
+		}
+		visible = append(visible, exhibition)
+	}
+	return visible, nil
 }
 func (service ExhibitionServices) GetExhibitionsByCategory(ctx context.Context, category string) ([]model.ResponseExhibition, error) {
-	return service.Repository.GetExhibitionsByCategory(ctx, category)
+	return hideCollaborators(service.Repository.GetExhibitionsByCategory(ctx, category))
+}
+
+func (service ExhibitionServices) GetPublishedExhibitions(ctx context.Context, query model.PublishedQuery) ([]model.ResponseExhibition, error) {
+	return hideCollaborators(service.Repository.GetPublishedExhibitions(ctx, query))
+}
+
+func (service ExhibitionServices) CountPublishedExhibitions(ctx context.Context) (int64, error) {
+	return service.Repository.CountPublishedExhibitions(ctx)
 }
 func (service ExhibitionServices) GetCurrentlyExhibitions(ctx context.Context) ([]model.ResponseExhibition, error) {
-	return service.Repository.GetCurrentlyExhibitions(ctx)
+	return hideCollaborators(service.Repository.GetCurrentlyExhibitions(ctx))
 }
 
 func (service ExhibitionServices) GetPreviouslyExhibitions(ctx context.Context) ([]model.ResponseExhibition, error) {
-	return service.Repository.GetPreviouslyExhibitions(ctx)
+	return hideCollaborators(service.Repository.GetPreviouslyExhibitions(ctx))
 }
 
 func (service ExhibitionServices) GetUpcomingExhibitions(ctx context.Context) ([]model.ResponseExhibition, error) {
-	return service.Repository.GetUpcomingExhibitions(ctx)
+	return hideCollaborators(service.Repository.GetUpcomingExhibitions(ctx))
 }
 func (service ExhibitionServices) GetExhibitionsByFilter(ctx context.Context, category, status, sortOrder string) ([]model.ResponseExhibition, error) {
-	return service.Repository.GetExhibitionsByFilter(ctx, category, status, sortOrder)
+	return hideCollaborators(service.Repository.GetExhibitionsByFilter(ctx, category, status, sortOrder))
 }
-func (s *ExhibitionServices) BanExhibition(ctx context.Context, exhibitionID string) error {
-	return s.Repository.BanExhibition(ctx, exhibitionID)
+
+// hideCollaborators removes the collaborators and pending invitations from exhibitions listed
+// to the public.
+func hideCollaborators(exhibitions []model.ResponseExhibition, err error) ([]model.ResponseExhibition, error) {
+	for i := range exhibitions {
+		exhibitions[i].Collaborators = nil
+	}
+	return exhibitions, err
 }
 
 func validateExhibitionID(exhibitionID string) error {
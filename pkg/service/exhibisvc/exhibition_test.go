@@ -2,10 +2,17 @@ package exhibisvc_test
 
 import (
 	"atommuse/backend/exhibition-service/internal/fake"
+	"atommuse/backend/exhibition-service/pkg/cerr"
 	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
+	"atommuse/backend/exhibition-service/pkg/repositorty/exhibirepo"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
 	"context"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -148,3 +155,204 @@ func TestDeleteExhibition(t *testing.T) {
 	// Verify that the mock method was called
 	mockRepo.AssertExpectations(t)
 }
+
+// exhibitionRepository serves a single exhibition.
+type exhibitionRepository struct {
+	exhibirepo.IExhibitionRepository
+	exhibition model.ResponseExhibition
+}
+
+func (r *exhibitionRepository) GetExhibitionByID(ctx *gin.Context, exhibitionID string, userID string) (*model.ResponseExhibition, error) {
+	exhibition := r.exhibition
+	return &exhibition, nil
+}
+
+// userExhibitionRepository serves the exhibitions of a user.
+type userExhibitionRepository struct {
+	exhibirepo.IExhibitionRepository
+	exhibitions []model.ResponseExhibition
+}
+
+func (r *userExhibitionRepository) GetExhibitionByUserID(ctx context.Context, userID string) ([]*model.ResponseExhibition, error) {
+	exhibitions := []*model.ResponseExhibition{}
+	for _, exhibition := range r.exhibitions {
+		exhibition := exhibition
+		exhibitions = append(exhibitions, &exhibition)
+	}
+	return exhibitions, nil
+}
+
+func TestGetExhibitionByIDVisibility(t *testing.T) {
+	owner := primitive.NewObjectID()
+	ownerActor := &model.Actor{UserID: model.UserID{UserID: owner}}
+	adminActor := &model.Actor{UserID: model.UserID{UserID: primitive.NewObjectID()}, Role: "admin"}
+	visitor := &model.Actor{UserID: model.UserID{UserID: primitive.NewObjectID()}}
+
+	tests := []struct {
+		name   string
+		status string
+		viewer model.ExhibitionViewer
+		err    error
+	}{
+		{"published, anonymous", "created", model.ExhibitionViewer{}, nil},
+		{"banned, anonymous", moderation.Banned, model.ExhibitionViewer{}, cerr.ErrExhibitionNotFound},
+		{"banned, visitor", moderation.Banned, model.ExhibitionViewer{Actor: visitor}, cerr.ErrExhibitionNotFound},
+		{"banned, share link", moderation.Banned, model.ExhibitionViewer{ShareToken: "token"}, cerr.ErrExhibitionNotFound},
+		{"banned, owner", moderation.Banned, model.ExhibitionViewer{Actor: ownerActor}, nil},
+		{"banned, admin", moderation.Banned, model.ExhibitionViewer{Actor: adminActor}, nil},
+		{"held for review, anonymous", moderation.Review, model.ExhibitionViewer{}, cerr.ErrExhibitionNotFound},
+		{"held for review, share link", moderation.Review, model.ExhibitionViewer{ShareToken: "token"}, cerr.ErrExhibitionNotFound},
+		{"held for review, owner", moderation.Review, model.ExhibitionViewer{Actor: ownerActor}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			service := exhibisvc.ExhibitionServices{Repository: &exhibitionRepository{exhibition: model.ResponseExhibition{
+				ID:       primitive.NewObjectID(),
+				IsPublic: true,
+				Status:   tt.status,
+				UserID:   model.UserID{UserID: owner},
+			}}}
+
+			exhibition, err := service.GetExhibitionByID(ctx, "", tt.viewer)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.NotNil(t, exhibition)
+			}
+		})
+	}
+}
+
+func TestGetExhibitionByIDHidesCollaborators(t *testing.T) {
+	owner := primitive.NewObjectID()
+	editor := primitive.NewObjectID()
+	viewer := primitive.NewObjectID()
+	collaborators := []model.Collaborator{
+		{UserID: editor, Role: model.RoleEditor, Status: model.InvitationAccepted},
+		{UserID: viewer, Role: model.RoleViewer, Status: model.InvitationAccepted},
+		{UserID: primitive.NewObjectID(), Role: model.RoleEditor, Status: model.InvitationPending},
+	}
+
+	tests := []struct {
+		name   string
+		viewer model.ExhibitionViewer
+		hidden bool
+	}{
+		{"anonymous", model.ExhibitionViewer{}, true},
+		{"visitor", model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: primitive.NewObjectID()}}}, true},
+		{"viewer", model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: viewer}}}, true},
+		{"editor", model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: editor}}}, false},
+		{"owner", model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: owner}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			service := exhibisvc.ExhibitionServices{Repository: &exhibitionRepository{exhibition: model.ResponseExhibition{
+				ID:            primitive.NewObjectID(),
+				IsPublic:      true,
+				Status:        "created",
+				UserID:        model.UserID{UserID: owner},
+				Collaborators: append([]model.Collaborator{}, collaborators...),
+			}}}
+
+			exhibition, err := service.GetExhibitionByID(ctx, "", tt.viewer)
+			assert.NoError(t, err)
+			if tt.hidden {
+				assert.Empty(t, exhibition.Collaborators)
+			} else {
+				assert.Len(t, exhibition.Collaborators, len(collaborators))
+			}
+		})
+	}
+}
+
+func TestGetExhibitionByUserID(t *testing.T) {
+	owner := primitive.NewObjectID()
+	collaborators := []model.Collaborator{{UserID: primitive.NewObjectID(), Role: model.RoleEditor, Status: model.InvitationPending}}
+	repository := &userExhibitionRepository{}
+	for _, status := range []string{"created", moderation.Banned, moderation.Review} {
+		for _, public := range []bool{true, false} {
+			repository.exhibitions = append(repository.exhibitions, model.ResponseExhibition{
+				ID:            primitive.NewObjectID(),
+				IsPublic:      public,
+				Status:        status,
+				UserID:        model.UserID{UserID: owner},
+				Collaborators: collaborators,
+			})
+		}
+	}
+	service := exhibisvc.ExhibitionServices{Repository: repository}
+
+	tests := []struct {
+		name          string
+		viewer        model.ExhibitionViewer
+		count         int
+		collaborators bool
+	}{
+		{"owner", model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: owner}}}, 6, true},
+		{"admin", model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: primitive.NewObjectID()}, Role: "admin"}}, 6, true},
+		{"other exhibitor", model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: primitive.NewObjectID()}}}, 1, false},
+		{"anonymous", model.ExhibitionViewer{}, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exhibitions, err := service.GetExhibitionByUserID(context.Background(), owner.Hex(), tt.viewer)
+			assert.NoError(t, err)
+			assert.Len(t, exhibitions, tt.count)
+			for _, exhibition := range exhibitions {
+				if !tt.collaborators {
+					assert.True(t, exhibisvc.IsPublished(exhibition))
+				}
+				assert.Equal(t, tt.collaborators, len(exhibition.Collaborators) > 0)
+			}
+		})
+	}
+}
+
+func TestPrepareContent(t *testing.T) {
+	owner := primitive.NewObjectID()
+	later := time.Now().Add(24 * time.Hour)
+	exhibition := model.ResponseExhibition{
+		ID:          primitive.NewObjectID(),
+		IsPublic:    true,
+		Status:      "created",
+		UserID:      model.UserID{UserID: owner},
+		MediaRights: []model.MediaRights{{Ref: "/uploads/loan.jpg", License: model.LicenseAllRightsReserved, EmbargoUntil: &later}},
+	}
+	section := func() []model.ExhibitionSection {
+		return []model.ExhibitionSection{{ExhibitionID: exhibition.ID, LeftCol: model.LeftColumn{Image: "/uploads/loan.jpg"}}}
+	}
+
+	t.Run("embargoed media is hidden from visitors", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		service := exhibisvc.ExhibitionServices{Repository: &exhibitionRepository{exhibition: exhibition}}
+
+		content, err := service.PrepareContent(ctx, exhibition.ID.Hex(), model.ExhibitionViewer{}, section(), nil)
+		assert.NoError(t, err)
+		assert.Empty(t, content.ExhibitionSections[0].LeftCol.Image)
+		assert.Empty(t, content.MediaRights)
+	})
+
+	t.Run("embargoed media is shown to the owner", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		service := exhibisvc.ExhibitionServices{Repository: &exhibitionRepository{exhibition: exhibition}}
+
+		owner := model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: owner}}}
+		content, err := service.PrepareContent(ctx, exhibition.ID.Hex(), owner, section(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "/uploads/loan.jpg", content.ExhibitionSections[0].LeftCol.Image)
+	})
+
+	t.Run("content of private exhibitions is not found", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		private := exhibition
+		private.IsPublic = false
+		service := exhibisvc.ExhibitionServices{Repository: &exhibitionRepository{exhibition: private}}
+
+		_, err := service.PrepareContent(ctx, exhibition.ID.Hex(), model.ExhibitionViewer{}, section(), nil)
+		assert.Equal(t, cerr.ErrExhibitionNotFound, err)
+	})
+}
@@ -0,0 +1,174 @@
+package moderationsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
+	"atommuse/backend/exhibition-service/pkg/repositorty/moderationrepo"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IModerationServices defines the interface for reports, moderation actions and appeals.
+type IModerationServices interface {
+	ReportExhibition(ctx context.Context, actor model.Actor, exhibitionID string, request *model.RequestReport) (*primitive.ObjectID, error)
+	GetQueue(ctx context.Context, query model.ModerationQuery) ([]model.ModerationCase, error)
+	GetReports(ctx context.Context, exhibitionID string, status string) ([]model.Report, error)
+	TakeAction(ctx context.Context, actor model.Actor, exhibitionID string, request *model.RequestModerationAction) (*model.ModerationAction, error)
+	GetHistory(ctx context.Context, exhibitionID string) (*model.ModerationHistory, error)
+	AppealAction(ctx context.Context, actor model.Actor, exhibitionID string, request *model.RequestAppeal) (*model.Appeal, error)
+	GetAppeals(ctx context.Context, status string) ([]model.Appeal, error)
+	DecideAppeal(ctx context.Context, actor model.Actor, appealID string, request *model.RequestAppealDecision) (*model.Appeal, error)
+}
+
+// ModerationServices is the implementation of the IModerationServices interface.
+type ModerationServices struct {
+	Repository moderationrepo.IModerationRepository
+}
+
+// ReportExhibition files an open report of an exhibition on behalf of a visitor.
+func (service ModerationServices) ReportExhibition(ctx context.Context, actor model.Actor, exhibitionID string, request *model.RequestReport) (*primitive.ObjectID, error) {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return nil, cerr.ErrExhibitionNotFound
+	}
+
+	report := model.Report{
+		ExhibitionID: objectID,
+		ReporterID:   actor.UserID.UserID,
+		Category:     request.Category,
+		Details:      request.Details,
+		Status:       model.ReportOpen,
+		CreatedAt:    time.Now(),
+	}
+	return service.Repository.CreateReport(ctx, &report)
+}
+
+func (service ModerationServices) GetQueue(ctx context.Context, query model.ModerationQuery) ([]model.ModerationCase, error) {
+	return service.Repository.GetQueue(ctx, query)
+}
+
+func (service ModerationServices) GetReports(ctx context.Context, exhibitionID string, status string) ([]model.Report, error) {
+	return service.Repository.GetReports(ctx, exhibitionID, status)
+}
+
+// TakeAction applies a moderator's action to an exhibition and resolves its open reports.
+func (service ModerationServices) TakeAction(ctx context.Context, actor model.Actor, exhibitionID string, request *model.RequestModerationAction) (*model.ModerationAction, error) {
+	objectID, err := primitive.ObjectIDFromHex(exhibitionID)
+	if err != nil {
+		return nil, cerr.ErrExhibitionNotFound
+	}
+
+	action := model.ModerationAction{
+		ID:           primitive.NewObjectID(),
+		ExhibitionID: objectID,
+		Action:       request.Action,
+		Reason:       request.Reason,
+		ModeratorID:  actor.UserID.UserID,
+		CreatedAt:    time.Now(),
+	}
+	if err := service.Repository.ApplyAction(ctx, &action); err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
+
+// GetHistory lists the actions taken on an exhibition and the appeals against them.
+func (service ModerationServices) GetHistory(ctx context.Context, exhibitionID string) (*model.ModerationHistory, error) {
+	actions, err := service.Repository.GetActions(ctx, exhibitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	objectID, _ := primitive.ObjectIDFromHex(exhibitionID)
+	appeals, err := service.Repository.GetAppeals(ctx, &objectID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ModerationHistory{Actions: actions, Appeals: appeals}, nil
+}
+
+// AppealAction files an appeal against a warning, unpublishing or ban of an exhibition.
+func (service ModerationServices) AppealAction(ctx context.Context, actor model.Actor, exhibitionID string, request *model.RequestAppeal) (*model.Appeal, error) {
+	action, err := service.Repository.GetActionByID(ctx, request.ActionID)
+	if err != nil {
+		return nil, err
+	}
+	if action.ExhibitionID.Hex() != exhibitionID {
+		return nil, cerr.ErrModerationNotFound
+	}
+	if !moderation.Appealable(action.Action) {
+		return nil, cerr.ErrNotAppealable
+	}
+
+	appeal := model.Appeal{
+		ExhibitionID: action.ExhibitionID,
+		ActionID:     action.ID,
+		AppellantID:  actor.UserID.UserID,
+		Message:      request.Message,
+		Status:       model.AppealPending,
+		CreatedAt:    time.Now(),
+	}
+	id, err := service.Repository.CreateAppeal(ctx, &appeal)
+	if err != nil {
+		return nil, err
+	}
+	appeal.ID = *id
+
+	return &appeal, nil
+}
+
+func (service ModerationServices) GetAppeals(ctx context.Context, status string) ([]model.Appeal, error) {
+	return service.Repository.GetAppeals(ctx, nil, status)
+}
+
+// DecideAppeal grants or rejects a pending appeal. Granting the appeal of a ban unbans the
+// exhibition, unless it was unbanned since.
+func (service ModerationServices) DecideAppeal(ctx context.Context, actor model.Actor, appealID string, request *model.RequestAppealDecision) (*model.Appeal, error) {
+	appeal, err := service.Repository.GetAppealByID(ctx, appealID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.Status != model.AppealPending {
+		return nil, cerr.ErrAppealDecided
+	}
+
+	now := time.Now()
+	appeal.Status = request.Status
+	appeal.Decision = request.Reason
+	appeal.DecidedBy = &actor.UserID.UserID
+	appeal.DecidedAt = &now
+	if err := service.Repository.DecideAppeal(ctx, appeal); err != nil {
+		return nil, err
+	}
+	if appeal.Status != model.AppealGranted {
+		return appeal, nil
+	}
+
+	action, err := service.Repository.GetActionByID(ctx, appeal.ActionID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	reversal := moderation.Reversal(action.Action)
+	if reversal == "" {
+		return appeal, nil
+	}
+
+	err = service.Repository.ApplyAction(ctx, &model.ModerationAction{
+		ID:           primitive.NewObjectID(),
+		ExhibitionID: appeal.ExhibitionID,
+		Action:       reversal,
+		Reason:       request.Reason,
+		ModeratorID:  actor.UserID.UserID,
+		AppealID:     &appeal.ID,
+		CreatedAt:    now,
+	})
+	if err != nil && !errors.Is(err, cerr.ErrModerationNotApplicable) {
+		return nil, err
+	}
+	return appeal, nil
+}
@@ -0,0 +1,132 @@
+package moderationsvc_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/moderationsvc"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// stubRepository keeps actions and appeals in memory and records the applied actions.
+type stubRepository struct {
+	actions map[primitive.ObjectID]*model.ModerationAction
+	appeals map[primitive.ObjectID]*model.Appeal
+	applied []model.ModerationAction
+}
+
+func newStubRepository() *stubRepository {
+	return &stubRepository{
+		actions: map[primitive.ObjectID]*model.ModerationAction{},
+		appeals: map[primitive.ObjectID]*model.Appeal{},
+	}
+}
+
+func (r *stubRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *stubRepository) CreateReport(ctx context.Context, report *model.Report) (*primitive.ObjectID, error) {
+	id := primitive.NewObjectID()
+	return &id, nil
+}
+
+func (r *stubRepository) GetQueue(ctx context.Context, query model.ModerationQuery) ([]model.ModerationCase, error) {
+	return nil, nil
+}
+
+func (r *stubRepository) GetReports(ctx context.Context, exhibitionID string, status string) ([]model.Report, error) {
+	return nil, nil
+}
+
+func (r *stubRepository) ApplyAction(ctx context.Context, action *model.ModerationAction) error {
+	r.actions[action.ID] = action
+	r.applied = append(r.applied, *action)
+	return nil
+}
+
+func (r *stubRepository) GetActions(ctx context.Context, exhibitionID string) ([]model.ModerationAction, error) {
+	return nil, nil
+}
+
+func (r *stubRepository) GetActionByID(ctx context.Context, actionID string) (*model.ModerationAction, error) {
+	id, _ := primitive.ObjectIDFromHex(actionID)
+	action, ok := r.actions[id]
+	if !ok {
+		return nil, cerr.ErrModerationNotFound
+	}
+	return action, nil
+}
+
+func (r *stubRepository) CreateAppeal(ctx context.Context, appeal *model.Appeal) (*primitive.ObjectID, error) {
+	for _, existing := range r.appeals {
+		if existing.ActionID == appeal.ActionID {
+			return nil, cerr.ErrAlreadyAppealed
+		}
+	}
+	appeal.ID = primitive.NewObjectID()
+	r.appeals[appeal.ID] = appeal
+	return &appeal.ID, nil
+}
+
+func (r *stubRepository) GetAppeals(ctx context.Context, exhibitionID *primitive.ObjectID, status string) ([]model.Appeal, error) {
+	return nil, nil
+}
+
+func (r *stubRepository) GetAppealByID(ctx context.Context, appealID string) (*model.Appeal, error) {
+	id, _ := primitive.ObjectIDFromHex(appealID)
+	appeal, ok := r.appeals[id]
+	if !ok {
+		return nil, cerr.ErrAppealNotFound
+	}
+	copied := *appeal
+	return &copied, nil
+}
+
+func (r *stubRepository) DecideAppeal(ctx context.Context, appeal *model.Appeal) error {
+	r.appeals[appeal.ID] = appeal
+	return nil
+}
+
+func TestAppeals(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubRepository()
+	service := moderationsvc.ModerationServices{Repository: repo}
+	moderator := model.Actor{UserID: model.UserID{UserID: primitive.NewObjectID()}, Role: "admin"}
+	owner := model.Actor{UserID: model.UserID{UserID: primitive.NewObjectID()}, Role: "exhibitor"}
+	exhibitionID := primitive.NewObjectID().Hex()
+
+	dismissed, err := service.TakeAction(ctx, moderator, exhibitionID, &model.RequestModerationAction{Action: model.ModerationDismiss, Reason: "Not offensive"})
+	require.NoError(t, err)
+	_, err = service.AppealAction(ctx, owner, exhibitionID, &model.RequestAppeal{ActionID: dismissed.ID.Hex(), Message: "?"})
+	assert.ErrorIs(t, err, cerr.ErrNotAppealable)
+
+	banned, err := service.TakeAction(ctx, moderator, exhibitionID, &model.RequestModerationAction{Action: model.ModerationBan, Reason: "Hate speech"})
+	require.NoError(t, err)
+	_, err = service.AppealAction(ctx, owner, primitive.NewObjectID().Hex(), &model.RequestAppeal{ActionID: banned.ID.Hex(), Message: "Wrong exhibition"})
+	assert.ErrorIs(t, err, cerr.ErrModerationNotFound)
+
+	appeal, err := service.AppealAction(ctx, owner, exhibitionID, &model.RequestAppeal{ActionID: banned.ID.Hex(), Message: "It is a quote in context"})
+	require.NoError(t, err)
+	assert.Equal(t, model.AppealPending, appeal.Status)
+	_, err = service.AppealAction(ctx, owner, exhibitionID, &model.RequestAppeal{ActionID: banned.ID.Hex(), Message: "Again"})
+	assert.ErrorIs(t, err, cerr.ErrAlreadyAppealed)
+
+	decided, err := service.DecideAppeal(ctx, moderator, appeal.ID.Hex(), &model.RequestAppealDecision{Status: model.AppealGranted, Reason: "Quoted for context"})
+	require.NoError(t, err)
+	assert.Equal(t, model.AppealGranted, decided.Status)
+	assert.Equal(t, moderator.UserID.UserID, *decided.DecidedBy)
+
+	require.Len(t, repo.applied, 3)
+	unban := repo.applied[2]
+	assert.Equal(t, model.ModerationUnban, unban.Action)
+	assert.Equal(t, appeal.ID, *unban.AppealID)
+	assert.Equal(t, "Quoted for context", unban.Reason)
+
+	_, err = service.DecideAppeal(ctx, moderator, appeal.ID.Hex(), &model.RequestAppealDecision{Status: model.AppealRejected, Reason: "Changed my mind"})
+	assert.ErrorIs(t, err, cerr.ErrAppealDecided)
+}
@@ -0,0 +1,106 @@
+package outboxsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/eventbus"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
+	"context"
+	"log"
+	"time"
+)
+
+// Lease is the name of the lease the relays share.
+const Lease = "relay"
+
+const (
+	// PollInterval is how long the relay waits when no message is pending or another relay
+	// holds the lease.
+	PollInterval = time.Second
+	// BatchSize is how many pending messages the relay reads at once.
+	BatchSize = 100
+	// leaseDuration is how long a relay keeps the lease without renewing it.
+	leaseDuration = 30 * time.Second
+	// retryDelay is how long the relay waits after failing to publish a message.
+	retryDelay = 5 * time.Second
+)
+
+// Relay publishes the messages of the outbox to the event bus. Only the relay holding the
+// lease publishes, so several instances may run side by side. Messages are published in
+// the order of their sequence numbers and marked published once the broker stored them;
+// when publishing fails, the relay stops and tries the same message again later. Consumers
+// thus receive every event at least once, and the events of an exhibition in order.
+type Relay struct {
+	Repository outboxrepo.IOutboxRepository
+	Publisher  eventbus.EventPublisher
+	// Owner identifies the relay holding the lease.
+	Owner string
+}
+
+// Run publishes messages until the context is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		wait, err := r.PublishPending(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Error relaying outbox messages: %v", err)
+		}
+		sleep(ctx, wait)
+	}
+}
+
+// PublishPending publishes a batch of pending messages if the relay holds the lease, and
+// returns how long to wait before the next batch.
+func (r *Relay) PublishPending(ctx context.Context) (time.Duration, error) {
+	acquired, err := r.Repository.AcquireLease(ctx, Lease, r.Owner, time.Now(), leaseDuration)
+	if err != nil {
+		return retryDelay, err
+	}
+	if !acquired {
+		return PollInterval, nil
+	}
+	renewAt := time.Now().Add(leaseDuration / 3)
+
+	messages, err := r.Repository.GetPending(ctx, BatchSize)
+	if err != nil {
+		return retryDelay, err
+	}
+	if len(messages) == 0 {
+		return PollInterval, nil
+	}
+
+	for _, message := range messages {
+		if time.Now().After(renewAt) {
+			acquired, err := r.Repository.AcquireLease(ctx, Lease, r.Owner, time.Now(), leaseDuration)
+			if err != nil {
+				return retryDelay, err
+			}
+			if !acquired {
+				return PollInterval, nil
+			}
+			renewAt = time.Now().Add(leaseDuration / 3)
+		}
+
+		if err := r.Publisher.Publish(ctx, &message.Event); err != nil {
+			if err := r.Repository.RecordFailure(ctx, message.ID, err.Error()); err != nil {
+				log.Printf("Error recording failure of outbox message %s: %v", message.ID.Hex(), err)
+			}
+			// Later messages wait so the events of an exhibition stay in order
+			return retryDelay, err
+		}
+		if err := r.Repository.MarkPublished(ctx, message.ID, time.Now()); err != nil {
+			// The message is published again with the next batch
+			return retryDelay, err
+		}
+	}
+	return 0, nil
+}
+
+func sleep(ctx context.Context, duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,138 @@
+package outboxsvc_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/eventbus"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/outboxrepo"
+	"atommuse/backend/exhibition-service/pkg/service/outboxsvc"
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// outbox keeps messages in memory and lets one owner hold the lease.
+type outbox struct {
+	messages []model.OutboxMessage
+	owner    string
+}
+
+func (o *outbox) EnsureIndexes(ctx context.Context) error { return nil }
+
+func (o *outbox) Write(ctx context.Context, write func(tx *outboxrepo.Tx) error) error {
+	return errors.New("not supported")
+}
+
+func (o *outbox) add(exhibitionID primitive.ObjectID, sequence int64, eventType string) {
+	id := primitive.NewObjectID()
+	o.messages = append(o.messages, model.OutboxMessage{
+		ID:    id,
+		Event: model.Event{ID: id.Hex(), Sequence: sequence, Type: eventType, ExhibitionID: exhibitionID},
+	})
+}
+
+func (o *outbox) GetPending(ctx context.Context, limit int64) ([]model.OutboxMessage, error) {
+	var pending []model.OutboxMessage
+	for _, message := range o.messages {
+		if !message.Published {
+			pending = append(pending, message)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return pending[i].Event.Sequence < pending[j].Event.Sequence })
+	if int64(len(pending)) > limit {
+		pending = pending[:limit]
+	}
+	return pending, nil
+}
+
+func (o *outbox) MarkPublished(ctx context.Context, messageID primitive.ObjectID, publishedAt time.Time) error {
+	for i := range o.messages {
+		if o.messages[i].ID == messageID {
+			o.messages[i].Published = true
+			o.messages[i].PublishedAt = &publishedAt
+		}
+	}
+	return nil
+}
+
+func (o *outbox) RecordFailure(ctx context.Context, messageID primitive.ObjectID, reason string) error {
+	for i := range o.messages {
+		if o.messages[i].ID == messageID {
+			o.messages[i].Attempts++
+			o.messages[i].LastError = reason
+		}
+	}
+	return nil
+}
+
+func (o *outbox) AcquireLease(ctx context.Context, name, owner string, now time.Time, duration time.Duration) (bool, error) {
+	if o.owner == "" {
+		o.owner = owner
+	}
+	return o.owner == owner, nil
+}
+
+// flakyBus fails to publish a number of times before passing events on to the bus.
+type flakyBus struct {
+	*eventbus.MemoryBus
+	failures int
+}
+
+func (b *flakyBus) Publish(ctx context.Context, event *model.Event) error {
+	if b.failures > 0 {
+		b.failures--
+		return errors.New("broker unavailable")
+	}
+	return b.MemoryBus.Publish(ctx, event)
+}
+
+func TestPublishPending(t *testing.T) {
+	first, second := primitive.NewObjectID(), primitive.NewObjectID()
+	repo := &outbox{}
+	repo.add(first, 1, model.EventExhibitionCreated)
+	repo.add(first, 2, model.EventExhibitionPublished)
+	repo.add(second, 1, model.EventExhibitionCreated)
+	repo.add(first, 3, model.EventLikesChanged)
+
+	bus := &flakyBus{MemoryBus: eventbus.NewMemoryBus(), failures: 2}
+	relay := &outboxsvc.Relay{Repository: repo, Publisher: bus, Owner: "a"}
+
+	// Publishing stops at the first failure so later events keep waiting
+	_, err := relay.PublishPending(context.Background())
+	require.Error(t, err)
+	assert.Empty(t, bus.Events())
+	assert.Equal(t, 1, repo.messages[0].Attempts)
+	assert.Equal(t, "broker unavailable", repo.messages[0].LastError)
+
+	_, err = relay.PublishPending(context.Background())
+	require.Error(t, err)
+
+	wait, err := relay.PublishPending(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	var sequences []int64
+	for _, published := range bus.Events() {
+		if published.ExhibitionID == first {
+			sequences = append(sequences, published.Sequence)
+		}
+	}
+	assert.Equal(t, []int64{1, 2, 3}, sequences)
+	assert.Len(t, bus.Events(), 4)
+
+	wait, err = relay.PublishPending(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, outboxsvc.PollInterval, wait)
+
+	// Another relay waits while the lease is held
+	other := &outboxsvc.Relay{Repository: repo, Publisher: bus, Owner: "b"}
+	repo.add(second, 2, model.EventExhibitionDeleted)
+	_, err = other.PublishPending(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, bus.Events(), 4)
+}
@@ -0,0 +1,85 @@
+package poisvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/geo"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/poirepo"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IPointOfInterestServices defines the interface for the points of interest of mapLayout exhibitions.
+type IPointOfInterestServices interface {
+	CreatePointOfInterest(ctx context.Context, request *model.RequestCreatePointOfInterest) (*primitive.ObjectID, error)
+	GetPointOfInterestByID(ctx context.Context, pointID string) (*model.PointOfInterest, error)
+	UpdatePointOfInterest(ctx context.Context, pointID string, request *model.RequestUpdatePointOfInterest) (*model.PointOfInterest, error)
+	DeletePointOfInterest(ctx context.Context, pointID string) error
+}
+
+// PointOfInterestServices is the implementation of the IPointOfInterestServices interface.
+type PointOfInterestServices struct {
+	Repository poirepo.IPointOfInterestRepository
+}
+
+// CreatePointOfInterest adds a point to the map of a mapLayout exhibition.
+func (service PointOfInterestServices) CreatePointOfInterest(ctx context.Context, request *model.RequestCreatePointOfInterest) (*primitive.ObjectID, error) {
+	usedLayout, err := service.Repository.GetExhibitionLayout(ctx, request.ExhibitionID)
+	if err != nil {
+		return nil, err
+	}
+	if usedLayout != layout.Map {
+		return nil, cerr.ErrLayoutMismatch
+	}
+
+	point := fromRequest(&request.RequestUpdatePointOfInterest)
+	point.ExhibitionID = request.ExhibitionID
+	if err := geo.Validate(point.Location); err != nil {
+		return nil, err
+	}
+
+	return service.Repository.CreatePointOfInterest(ctx, &point)
+}
+
+func (service PointOfInterestServices) GetPointOfInterestByID(ctx context.Context, pointID string) (*model.PointOfInterest, error) {
+	return service.Repository.GetPointOfInterestByID(ctx, pointID)
+}
+
+// UpdatePointOfInterest replaces the content and location of a point and returns it.
+func (service PointOfInterestServices) UpdatePointOfInterest(ctx context.Context, pointID string, request *model.RequestUpdatePointOfInterest) (*model.PointOfInterest, error) {
+	existing, err := service.Repository.GetPointOfInterestByID(ctx, pointID)
+	if err != nil {
+		return nil, err
+	}
+
+	point := fromRequest(request)
+	point.ID = existing.ID
+	point.ExhibitionID = existing.ExhibitionID
+	if err := geo.Validate(point.Location); err != nil {
+		return nil, err
+	}
+
+	if err := service.Repository.UpdatePointOfInterest(ctx, &point); err != nil {
+		return nil, err
+	}
+
+	return &point, nil
+}
+
+func (service PointOfInterestServices) DeletePointOfInterest(ctx context.Context, pointID string) error {
+	return service.Repository.DeletePointOfInterest(ctx, pointID)
+}
+
+func fromRequest(request *model.RequestUpdatePointOfInterest) model.PointOfInterest {
+	return model.PointOfInterest{
+		Order:        request.Order,
+		Title:        request.Title,
+		Text:         request.Text,
+		Address:      request.Address,
+		Location:     request.Location,
+		Media:        request.Media,
+		Translations: request.Translations,
+	}
+}
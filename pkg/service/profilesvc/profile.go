@@ -0,0 +1,82 @@
+package profilesvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/profilerepo"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// batchSize is how many stored profiles a reconciliation reads at a time.
+const batchSize = 100
+
+// IProfileServices defines the interface for the copies of user profiles kept by exhibitions.
+type IProfileServices interface {
+	ApplyProfileChange(ctx context.Context, user *model.UserProfile) (*model.ProfileSync, error)
+	Reconcile(ctx context.Context) ([]model.ProfileSync, error)
+}
+
+// ProfileServices is the implementation of the IProfileServices interface.
+type ProfileServices struct {
+	Repository profilerepo.IProfileRepository
+}
+
+// ApplyProfileChange stores a profile the user service reported as changed and updates its
+// copies in the exhibitions. A change older than the stored profile is ignored, and the
+// stored profile is applied instead.
+func (service ProfileServices) ApplyProfileChange(ctx context.Context, user *model.UserProfile) (*model.ProfileSync, error) {
+	user.UpdatedAt = time.Now()
+	saved, err := service.Repository.SaveProfile(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := user
+	if !saved {
+		stored, err := service.Repository.GetProfile(ctx, user.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil {
+			latest = stored
+		}
+	}
+
+	sync, err := service.Repository.ApplyProfile(ctx, latest)
+	if err != nil {
+		return nil, err
+	}
+	sync.Stale = !saved
+	return sync, nil
+}
+
+// Reconcile applies every stored profile again and returns the profiles whose copies had
+// drifted from them, such as copies taken from tokens issued before a change or copies a
+// failed update missed.
+func (service ProfileServices) Reconcile(ctx context.Context) ([]model.ProfileSync, error) {
+	drifted := []model.ProfileSync{}
+	after := primitive.NilObjectID
+	for {
+		users, err := service.Repository.GetProfiles(ctx, after, batchSize)
+		if err != nil {
+			return drifted, err
+		}
+
+		for i := range users {
+			sync, err := service.Repository.ApplyProfile(ctx, &users[i])
+			if err != nil {
+				return drifted, err
+			}
+			if sync.Exhibitions > 0 || sync.Collaborations > 0 {
+				drifted = append(drifted, *sync)
+			}
+		}
+
+		if len(users) < batchSize {
+			return drifted, nil
+		}
+		after = users[len(users)-1].UserID
+	}
+}
@@ -0,0 +1,41 @@
+package profilesvc
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// ReconcileInterval is how long the reconciler waits between two reconciliations.
+const ReconcileInterval = time.Hour
+
+// Reconciler repairs the copies of user profiles that drifted from the stored profiles, at
+// every ReconcileInterval. Several instances may run side by side since repairing a copy
+// twice leaves it the same.
+type Reconciler struct {
+	Service IProfileServices
+}
+
+// Run reconciles the profiles until the context is cancelled.
+func (r *Reconciler) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		drifted, err := r.Service.Reconcile(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Error reconciling user profiles: %v", err)
+		}
+		for _, sync := range drifted {
+			log.Printf("Repaired the profile of user %s in %d exhibitions and %d collaborations",
+				sync.UserID.Hex(), sync.Exhibitions, sync.Collaborations)
+		}
+		sleep(ctx, ReconcileInterval)
+	}
+}
+
+func sleep(ctx context.Context, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,141 @@
+package quizsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/quiz"
+	"atommuse/backend/exhibition-service/pkg/repositorty/quizrepo"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IQuizServices defines the interface for the quizzes of exhibitions and their attempts.
+type IQuizServices interface {
+	CreateQuiz(ctx context.Context, request *model.RequestQuiz) (*primitive.ObjectID, error)
+	GetQuizzes(ctx context.Context, query model.QuizQuery) ([]model.Quiz, error)
+	GetQuizByID(ctx context.Context, quizID string) (*model.Quiz, error)
+	UpdateQuiz(ctx context.Context, quizID string, request *model.RequestQuiz) (*model.Quiz, error)
+	DeleteQuiz(ctx context.Context, quizID string) error
+	SubmitAttempt(ctx context.Context, subject *model.Quiz, userID primitive.ObjectID, request *model.RequestSubmitQuiz) (*model.QuizAttempt, error)
+	GetAttempts(ctx context.Context, quizID, userID primitive.ObjectID) ([]model.QuizAttempt, error)
+	GetStatistics(ctx context.Context, subject *model.Quiz) (*model.QuizStatistics, error)
+}
+
+// QuizServices is the implementation of the IQuizServices interface.
+type QuizServices struct {
+	Repository quizrepo.IQuizRepository
+	// TreeRepository loads the sections and rooms a quiz may be attached to.
+	TreeRepository exhibisvc.ITreeRepository
+}
+
+// CreateQuiz adds a quiz to an exhibition, or to one of its sections or rooms.
+func (service QuizServices) CreateQuiz(ctx context.Context, request *model.RequestQuiz) (*primitive.ObjectID, error) {
+	now := time.Now()
+	newQuiz := fromRequest(request)
+	newQuiz.ExhibitionID = request.ExhibitionID
+	newQuiz.CreatedAt = now
+	newQuiz.UpdatedAt = now
+
+	if err := service.validate(ctx, &newQuiz); err != nil {
+		return nil, err
+	}
+
+	return service.Repository.CreateQuiz(ctx, &newQuiz)
+}
+
+func (service QuizServices) GetQuizzes(ctx context.Context, query model.QuizQuery) ([]model.Quiz, error) {
+	return service.Repository.GetQuizzes(ctx, query)
+}
+
+func (service QuizServices) GetQuizByID(ctx context.Context, quizID string) (*model.Quiz, error) {
+	return service.Repository.GetQuizByID(ctx, quizID)
+}
+
+// UpdateQuiz replaces the content of a quiz and returns it. Earlier attempts keep the score
+// they were given; statistics count their answers by question position.
+func (service QuizServices) UpdateQuiz(ctx context.Context, quizID string, request *model.RequestQuiz) (*model.Quiz, error) {
+	existing, err := service.Repository.GetQuizByID(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := fromRequest(request)
+	updated.ID = existing.ID
+	updated.ExhibitionID = existing.ExhibitionID
+	updated.CreatedAt = existing.CreatedAt
+	updated.UpdatedAt = time.Now()
+
+	if err := service.validate(ctx, &updated); err != nil {
+		return nil, err
+	}
+
+	if err := service.Repository.UpdateQuiz(ctx, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteQuiz deletes a quiz together with its attempts.
+func (service QuizServices) DeleteQuiz(ctx context.Context, quizID string) error {
+	return service.Repository.DeleteQuiz(ctx, quizID)
+}
+
+// SubmitAttempt scores the answers of a user and records the attempt. Users may attempt a
+// quiz any number of times.
+func (service QuizServices) SubmitAttempt(ctx context.Context, subject *model.Quiz, userID primitive.ObjectID, request *model.RequestSubmitQuiz) (*model.QuizAttempt, error) {
+	attempt := model.QuizAttempt{
+		QuizID:       subject.ID,
+		ExhibitionID: subject.ExhibitionID,
+		UserID:       userID,
+		Answers:      request.Answers,
+		SubmittedAt:  time.Now(),
+	}
+	if err := quiz.Score(subject, &attempt); err != nil {
+		return nil, err
+	}
+
+	objectID, err := service.Repository.CreateAttempt(ctx, &attempt)
+	if err != nil {
+		return nil, err
+	}
+	attempt.ID = *objectID
+
+	return &attempt, nil
+}
+
+func (service QuizServices) GetAttempts(ctx context.Context, quizID, userID primitive.ObjectID) ([]model.QuizAttempt, error) {
+	return service.Repository.GetAttempts(ctx, quizID, userID)
+}
+
+// GetStatistics summarizes the attempts of a quiz.
+func (service QuizServices) GetStatistics(ctx context.Context, subject *model.Quiz) (*model.QuizStatistics, error) {
+	tally, err := service.Repository.GetQuizTally(ctx, subject.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	statistics := quiz.Statistics(subject, tally)
+	return &statistics, nil
+}
+
+func (service QuizServices) validate(ctx context.Context, subject *model.Quiz) error {
+	exhibition, err := service.TreeRepository.GetExhibitionTree(ctx, subject.ExhibitionID.Hex())
+	if err != nil {
+		return err
+	}
+
+	return quiz.Validate(subject, exhibition)
+}
+
+func fromRequest(request *model.RequestQuiz) model.Quiz {
+	return model.Quiz{
+		SectionID:   request.SectionID,
+		RoomID:      request.RoomID,
+		Title:       request.Title,
+		Description: request.Description,
+		Questions:   request.Questions,
+	}
+}
@@ -0,0 +1,216 @@
+package reservationsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/reservationrepo"
+	"atommuse/backend/exhibition-service/pkg/reservation"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IReservationServices defines the interface for time slots and timed-entry reservations.
+type IReservationServices interface {
+	CreateTimeSlot(ctx context.Context, exhibitionID primitive.ObjectID, request *model.RequestTimeSlot) (*primitive.ObjectID, error)
+	GetTimeSlots(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.TimeSlot, error)
+	GetTimeSlotByID(ctx context.Context, slotID string) (*model.TimeSlot, error)
+	UpdateTimeSlot(ctx context.Context, slotID string, request *model.RequestTimeSlot) (*model.TimeSlot, error)
+	DeleteTimeSlot(ctx context.Context, slotID string) error
+	Reserve(ctx context.Context, exhibitionID, userID primitive.ObjectID, request *model.RequestCreateReservation) (*model.ResponseReservation, error)
+	GetReservationByID(ctx context.Context, reservationID string) (*model.ResponseReservation, error)
+	GetReservations(ctx context.Context, userID primitive.ObjectID) ([]model.ResponseReservation, error)
+	CancelReservation(ctx context.Context, existing *model.Reservation) error
+	CheckIn(ctx context.Context, exhibitionID primitive.ObjectID, token string) (*model.ResponseReservation, error)
+}
+
+// ReservationServices is the implementation of the IReservationServices interface.
+type ReservationServices struct {
+	Repository reservationrepo.IReservationRepository
+	// Secret is the key booking tokens are signed with.
+	Secret []byte
+}
+
+func (service ReservationServices) CreateTimeSlot(ctx context.Context, exhibitionID primitive.ObjectID, request *model.RequestTimeSlot) (*primitive.ObjectID, error) {
+	if err := reservation.ValidateSlot(request); err != nil {
+		return nil, err
+	}
+
+	return service.Repository.CreateTimeSlot(ctx, &model.TimeSlot{
+		ExhibitionID: exhibitionID,
+		StartsAt:     request.StartsAt,
+		EndsAt:       request.EndsAt,
+		Capacity:     request.Capacity,
+	})
+}
+
+// GetTimeSlots retrieves the time slots of an exhibition with their remaining seats.
+func (service ReservationServices) GetTimeSlots(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.TimeSlot, error) {
+	slots, err := service.Repository.GetTimeSlots(ctx, exhibitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range slots {
+		slots[i].Remaining = reservation.Remaining(&slots[i])
+	}
+	return slots, nil
+}
+
+func (service ReservationServices) GetTimeSlotByID(ctx context.Context, slotID string) (*model.TimeSlot, error) {
+	slot, err := service.Repository.GetTimeSlotByID(ctx, slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	slot.Remaining = reservation.Remaining(slot)
+	return slot, nil
+}
+
+// UpdateTimeSlot changes the window and capacity of a slot. The capacity cannot drop below the
+// seats already booked.
+func (service ReservationServices) UpdateTimeSlot(ctx context.Context, slotID string, request *model.RequestTimeSlot) (*model.TimeSlot, error) {
+	if err := reservation.ValidateSlot(request); err != nil {
+		return nil, err
+	}
+
+	slot, err := service.Repository.GetTimeSlotByID(ctx, slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	slot.StartsAt, slot.EndsAt, slot.Capacity = request.StartsAt, request.EndsAt, request.Capacity
+	if err := service.Repository.UpdateTimeSlot(ctx, slot); err != nil {
+		return nil, err
+	}
+
+	// Reread the slot for the seats booked in the meantime
+	return service.GetTimeSlotByID(ctx, slotID)
+}
+
+// DeleteTimeSlot deletes a slot without bookings.
+func (service ReservationServices) DeleteTimeSlot(ctx context.Context, slotID string) error {
+	slot, err := service.Repository.GetTimeSlotByID(ctx, slotID)
+	if err != nil {
+		return err
+	}
+
+	return service.Repository.DeleteTimeSlot(ctx, slot.ID)
+}
+
+// Reserve books seats in a time slot of an exhibition for a user. The seats are taken from
+// the slot first; if the reservation cannot be stored they are given back.
+func (service ReservationServices) Reserve(ctx context.Context, exhibitionID, userID primitive.ObjectID, request *model.RequestCreateReservation) (*model.ResponseReservation, error) {
+	seats := request.Seats
+	if seats == 0 {
+		seats = 1
+	}
+
+	if err := service.Repository.ReserveSeats(ctx, exhibitionID, request.SlotID, seats); err != nil {
+		return nil, err
+	}
+
+	booking := model.Reservation{
+		ExhibitionID: exhibitionID,
+		SlotID:       request.SlotID,
+		UserID:       userID,
+		Seats:        seats,
+		Status:       model.ReservationConfirmed,
+		CreatedAt:    time.Now(),
+	}
+	objectID, err := service.Repository.CreateReservation(ctx, &booking)
+	if err != nil {
+		if releaseErr := service.Repository.ReleaseSeats(ctx, request.SlotID, seats); releaseErr != nil {
+			log.Printf("Error releasing %d seats of time slot %s: %v", seats, request.SlotID.Hex(), releaseErr)
+		}
+		return nil, err
+	}
+	booking.ID = *objectID
+
+	return service.describe(ctx, &booking)
+}
+
+// GetReservationByID retrieves a reservation with its slot and booking token.
+func (service ReservationServices) GetReservationByID(ctx context.Context, reservationID string) (*model.ResponseReservation, error) {
+	existing, err := service.Repository.GetReservationByID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.describe(ctx, existing)
+}
+
+// GetReservations retrieves the reservations of a user, newest first. Only confirmed
+// reservations carry a booking token.
+func (service ReservationServices) GetReservations(ctx context.Context, userID primitive.ObjectID) ([]model.ResponseReservation, error) {
+	reservations, err := service.Repository.GetReservationsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.ResponseReservation, len(reservations))
+	for i := range reservations {
+		response, err := service.describe(ctx, &reservations[i])
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = *response
+	}
+	return responses, nil
+}
+
+// CancelReservation cancels a confirmed reservation and gives its seats back to the slot.
+func (service ReservationServices) CancelReservation(ctx context.Context, existing *model.Reservation) error {
+	if err := service.Repository.UpdateReservationStatus(ctx, existing.ID, model.ReservationConfirmed, model.ReservationCancelled, time.Now()); err != nil {
+		return err
+	}
+
+	return service.Repository.ReleaseSeats(ctx, existing.SlotID, existing.Seats)
+}
+
+// CheckIn validates a booking token for an exhibition and consumes it, so the same token
+// cannot be checked in twice.
+func (service ReservationServices) CheckIn(ctx context.Context, exhibitionID primitive.ObjectID, token string) (*model.ResponseReservation, error) {
+	reservationID, err := reservation.Verify(token, service.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := service.Repository.GetReservationByID(ctx, reservationID.Hex())
+	if errors.Is(err, cerr.ErrReservationNotFound) || (err == nil && existing.ExhibitionID != exhibitionID) {
+		return nil, cerr.ErrInvalidBookingToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := service.Repository.UpdateReservationStatus(ctx, existing.ID, model.ReservationConfirmed, model.ReservationCheckedIn, now); err != nil {
+		return nil, err
+	}
+	existing.Status = model.ReservationCheckedIn
+	existing.CheckedInAt = &now
+
+	return service.describe(ctx, existing)
+}
+
+// describe adds the time slot and, while the reservation is confirmed, the booking token.
+func (service ReservationServices) describe(ctx context.Context, existing *model.Reservation) (*model.ResponseReservation, error) {
+	response := &model.ResponseReservation{Reservation: *existing}
+	if existing.Status == model.ReservationConfirmed {
+		response.Token = reservation.Token(existing.ID, service.Secret)
+	}
+
+	slot, err := service.GetTimeSlotByID(ctx, existing.SlotID.Hex())
+	switch {
+	case err == nil:
+		response.Slot = slot
+	case !errors.Is(err, cerr.ErrTimeSlotNotFound):
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -3,6 +3,8 @@ package roomsvc
 import (
 	"atommuse/backend/exhibition-service/pkg/model"
 	"atommuse/backend/exhibition-service/pkg/repositorty/roomrepo"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
 	"context"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -21,10 +23,29 @@ type IRoomServices interface {
 // RoomServices is the implementation of the IExhibitionRoomServices interface.
 type RoomServices struct {
 	Repository roomrepo.IRoomRepository
+	// ScreeningService screens the texts of rooms before they are written. Texts are not
+	// screened when it is nil.
+	ScreeningService screeningsvc.IScreeningServices
 }
 
+// CreateExhibitionRoom adds a room to an exhibition. Flagged texts hold the exhibition for
+// review, and blocked texts are rejected.
 func (service RoomServices) CreateExhibitionRoom(ctx context.Context, Room *model.RequestCreateExhibitionRoom) (*primitive.ObjectID, error) {
-	return service.Repository.CreateExhibitionRoom(ctx, Room)
+	if service.ScreeningService == nil {
+		return service.Repository.CreateExhibitionRoom(ctx, Room)
+	}
+
+	fields := screening.RoomFields(&model.Room{Left: Room.Left, Center: Room.Center, Right: Room.Right})
+	var roomID *primitive.ObjectID
+	err := service.ScreeningService.ScreenContent(ctx, Room.ExhibitionID, fields, func() error {
+		var err error
+		roomID, err = service.Repository.CreateExhibitionRoom(ctx, Room)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roomID, nil
 }
 
 func (service RoomServices) DeleteExhibitionRoomByID(ctx context.Context, RoomID string) error {
@@ -44,6 +65,27 @@ func (service RoomServices) GetRoomsByExhibitionID(ctx context.Context, exhibiti
 	return service.Repository.GetRoomsByExhibitionID(ctx, exhibitionID)
 }
 
+// UpdateExhibitionRoom updates a room. Flagged texts hold the exhibition of the room for
+// review, and blocked texts are rejected.
 func (service RoomServices) UpdateExhibitionRoom(ctx context.Context, RoomID string, updatedRoom *model.RequestUpdateExhibitionRoom) (*primitive.ObjectID, error) {
-	return service.Repository.UpdateExhibitionRoom(ctx, RoomID, updatedRoom)
+	if service.ScreeningService == nil {
+		return service.Repository.UpdateExhibitionRoom(ctx, RoomID, updatedRoom)
+	}
+
+	current, err := service.Repository.GetExhibitionRoomByID(ctx, RoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := screening.RoomFields(&model.Room{Left: updatedRoom.Left, Center: updatedRoom.Center, Right: updatedRoom.Right})
+	var updatedID *primitive.ObjectID
+	err = service.ScreeningService.ScreenContent(ctx, current.ExhibitionID, fields, func() error {
+		var err error
+		updatedID, err = service.Repository.UpdateExhibitionRoom(ctx, RoomID, updatedRoom)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedID, nil
 }
@@ -0,0 +1,167 @@
+package screeningsvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/screeningrepo"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IScreeningServices defines the interface for screening content and managing the rules.
+type IScreeningServices interface {
+	Screen(ctx context.Context, fields []screening.Field) (*model.ScreeningResult, error)
+	ScreenContent(ctx context.Context, exhibitionID primitive.ObjectID, fields []screening.Field, write func() error) error
+	HoldForReview(ctx context.Context, exhibitionID primitive.ObjectID, result *model.ScreeningResult) error
+	CreateRule(ctx context.Context, request *model.RequestScreeningRule) (*model.ScreeningRule, error)
+	GetRules(ctx context.Context) ([]model.ScreeningRule, error)
+	UpdateRule(ctx context.Context, ruleID string, request *model.RequestScreeningRule) (*model.ScreeningRule, error)
+	DeleteRule(ctx context.Context, ruleID string) error
+}
+
+// ScreeningServices is the implementation of the IScreeningServices interface.
+type ScreeningServices struct {
+	Repository screeningrepo.IScreeningRepository
+}
+
+// Screen matches texts against the enabled rules. It fails with a screening.BlockedError
+// listing the blocking matches when a text matches a rule blocking it.
+func (service ScreeningServices) Screen(ctx context.Context, fields []screening.Field) (*model.ScreeningResult, error) {
+	rules, err := service.Repository.GetRules(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	screener, err := screening.Compile(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	result := screener.Screen(fields)
+	if result.Verdict == model.ScreeningBlocked {
+		blocked := &screening.BlockedError{}
+		for _, match := range result.Matches {
+			if match.Severity == model.ScreeningBlock {
+				blocked.Matches = append(blocked.Matches, match)
+			}
+		}
+		return nil, blocked
+	}
+	return &result, nil
+}
+
+// ScreenContent screens texts and writes them to an exhibition with write. When they are
+// flagged, the exhibition is held for review before they are written, and the hold is lifted
+// again when they cannot be written. Blocked texts are not written.
+func (service ScreeningServices) ScreenContent(ctx context.Context, exhibitionID primitive.ObjectID, fields []screening.Field, write func() error) error {
+	result, err := service.Screen(ctx, fields)
+	if err != nil {
+		return err
+	}
+	if result.Verdict != model.ScreeningFlagged {
+		return write()
+	}
+
+	hold, err := service.hold(ctx, exhibitionID, result)
+	if err != nil {
+		return err
+	}
+	if err := write(); err != nil {
+		if releaseErr := service.Repository.ReleaseHold(ctx, exhibitionID, hold); releaseErr != nil {
+			log.Printf("Error lifting the review hold of exhibition %s: %v", exhibitionID.Hex(), releaseErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// HoldForReview withdraws an exhibition with flagged content from publication and puts it in
+// the moderation queue, until a moderator approves or bans it.
+func (service ScreeningServices) HoldForReview(ctx context.Context, exhibitionID primitive.ObjectID, result *model.ScreeningResult) error {
+	_, err := service.hold(ctx, exhibitionID, result)
+	return err
+}
+
+func (service ScreeningServices) hold(ctx context.Context, exhibitionID primitive.ObjectID, result *model.ScreeningResult) (*model.ScreeningHold, error) {
+	rules := []string{}
+	seen := map[string]bool{}
+	for _, match := range result.Matches {
+		if !seen[match.Rule] {
+			seen[match.Rule] = true
+			rules = append(rules, match.Rule)
+		}
+	}
+
+	return service.Repository.HoldForReview(ctx, exhibitionID, &model.Report{
+		Details:   "Flagged by content screening: " + strings.Join(rules, ", "),
+		Matches:   result.Matches,
+		CreatedAt: time.Now(),
+	})
+}
+
+// CreateRule adds a screening rule. Patterns must be valid regular expressions.
+func (service ScreeningServices) CreateRule(ctx context.Context, request *model.RequestScreeningRule) (*model.ScreeningRule, error) {
+	now := time.Now()
+	rule := newRule(request)
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+	if _, err := screening.Compile([]model.ScreeningRule{rule}); err != nil {
+		return nil, err
+	}
+
+	id, err := service.Repository.CreateRule(ctx, &rule)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = *id
+
+	return &rule, nil
+}
+
+func (service ScreeningServices) GetRules(ctx context.Context) ([]model.ScreeningRule, error) {
+	return service.Repository.GetRules(ctx, false)
+}
+
+// UpdateRule replaces a screening rule. Patterns must be valid regular expressions.
+func (service ScreeningServices) UpdateRule(ctx context.Context, ruleID string, request *model.RequestScreeningRule) (*model.ScreeningRule, error) {
+	existing, err := service.Repository.GetRuleByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := newRule(request)
+	rule.ID = existing.ID
+	rule.CreatedAt = existing.CreatedAt
+	rule.UpdatedAt = time.Now()
+	if _, err := screening.Compile([]model.ScreeningRule{rule}); err != nil {
+		return nil, err
+	}
+
+	if err := service.Repository.UpdateRule(ctx, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (service ScreeningServices) DeleteRule(ctx context.Context, ruleID string) error {
+	return service.Repository.DeleteRule(ctx, ruleID)
+}
+
+// newRule builds a rule from a request, keeping only the words or the pattern of its type.
+func newRule(request *model.RequestScreeningRule) model.ScreeningRule {
+	rule := model.ScreeningRule{
+		Name:     request.Name,
+		Type:     request.Type,
+		Severity: request.Severity,
+		Enabled:  *request.Enabled,
+	}
+	if request.Type == model.ScreeningWords {
+		rule.Words = request.Words
+	} else {
+		rule.Pattern = request.Pattern
+	}
+	return rule
+}
@@ -0,0 +1,118 @@
+package screeningsvc_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// stubRepository serves fixed rules and records the exhibitions held for review.
+type stubRepository struct {
+	rules []model.ScreeningRule
+	held  map[primitive.ObjectID]*model.Report
+}
+
+func newStubRepository(rules ...model.ScreeningRule) *stubRepository {
+	return &stubRepository{rules: rules, held: map[primitive.ObjectID]*model.Report{}}
+}
+
+func (r *stubRepository) CreateRule(ctx context.Context, rule *model.ScreeningRule) (*primitive.ObjectID, error) {
+	id := primitive.NewObjectID()
+	return &id, nil
+}
+
+func (r *stubRepository) GetRules(ctx context.Context, enabledOnly bool) ([]model.ScreeningRule, error) {
+	return r.rules, nil
+}
+
+func (r *stubRepository) GetRuleByID(ctx context.Context, ruleID string) (*model.ScreeningRule, error) {
+	return nil, cerr.ErrScreeningRuleNotFound
+}
+
+func (r *stubRepository) UpdateRule(ctx context.Context, rule *model.ScreeningRule) error {
+	return nil
+}
+
+func (r *stubRepository) DeleteRule(ctx context.Context, ruleID string) error {
+	return nil
+}
+
+func (r *stubRepository) HoldForReview(ctx context.Context, exhibitionID primitive.ObjectID, report *model.Report) (*model.ScreeningHold, error) {
+	r.held[exhibitionID] = report
+	return &model.ScreeningHold{Status: "created"}, nil
+}
+
+func (r *stubRepository) ReleaseHold(ctx context.Context, exhibitionID primitive.ObjectID, hold *model.ScreeningHold) error {
+	delete(r.held, exhibitionID)
+	return nil
+}
+
+func TestScreenContent(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubRepository(
+		model.ScreeningRule{Name: "Gambling", Type: model.ScreeningWords, Words: []string{"casino", "คาสิโน"}, Severity: model.ScreeningFlag, Enabled: true},
+		model.ScreeningRule{Name: "Slurs", Type: model.ScreeningWords, Words: []string{"scum"}, Severity: model.ScreeningBlock, Enabled: true},
+	)
+	service := screeningsvc.ScreeningServices{Repository: repo}
+	written := 0
+	write := func() error {
+		written++
+		return nil
+	}
+
+	clean := primitive.NewObjectID()
+	require.NoError(t, service.ScreenContent(ctx, clean, []screening.Field{{Name: "title", Text: "Silk of Isan"}}, write))
+	assert.NotContains(t, repo.held, clean)
+	assert.Equal(t, 1, written)
+
+	flagged := primitive.NewObjectID()
+	require.NoError(t, service.ScreenContent(ctx, flagged, []screening.Field{{Name: "title", Text: "ทัวร์คาสิโนออนไลน์"}}, write))
+	require.Contains(t, repo.held, flagged)
+	assert.Equal(t, "Flagged by content screening: Gambling", repo.held[flagged].Details)
+	assert.Len(t, repo.held[flagged].Matches, 1)
+	assert.Equal(t, 2, written)
+
+	blocked := primitive.NewObjectID()
+	err := service.ScreenContent(ctx, blocked, []screening.Field{{Name: "title", Text: "Casino scum"}}, write)
+	assert.ErrorIs(t, err, cerr.ErrContentBlocked)
+	assert.Equal(t, 2, written)
+	var blockedErr *screening.BlockedError
+	require.True(t, errors.As(err, &blockedErr))
+	require.Len(t, blockedErr.Matches, 1)
+	assert.Equal(t, "Slurs", blockedErr.Matches[0].Rule)
+	assert.NotContains(t, repo.held, blocked)
+}
+
+func TestScreenContentLiftsHoldWhenWriteFails(t *testing.T) {
+	repo := newStubRepository(model.ScreeningRule{Name: "Gambling", Type: model.ScreeningWords, Words: []string{"casino"}, Severity: model.ScreeningFlag, Enabled: true})
+	service := screeningsvc.ScreeningServices{Repository: repo}
+	writeErr := errors.New("write failed")
+
+	exhibitionID := primitive.NewObjectID()
+	err := service.ScreenContent(context.Background(), exhibitionID, []screening.Field{{Name: "title", Text: "Casino nights"}}, func() error {
+		return writeErr
+	})
+	assert.ErrorIs(t, err, writeErr)
+	assert.NotContains(t, repo.held, exhibitionID)
+}
+
+func TestCreateRule(t *testing.T) {
+	service := screeningsvc.ScreeningServices{Repository: &stubRepository{}}
+	enabled := true
+
+	_, err := service.CreateRule(context.Background(), &model.RequestScreeningRule{Name: "Broken", Type: model.ScreeningPattern, Pattern: "[", Severity: model.ScreeningFlag, Enabled: &enabled})
+	assert.ErrorIs(t, err, cerr.ErrInvalidScreeningRule)
+
+	rule, err := service.CreateRule(context.Background(), &model.RequestScreeningRule{Name: "Spam", Type: model.ScreeningWords, Words: []string{"viagra"}, Pattern: "ignored", Severity: model.ScreeningFlag, Enabled: &enabled})
+	require.NoError(t, err)
+	assert.Empty(t, rule.Pattern)
+	assert.False(t, rule.ID.IsZero())
+}
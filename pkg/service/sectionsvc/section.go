@@ -3,6 +3,8 @@ package sectionsvc
 import (
 	"atommuse/backend/exhibition-service/pkg/model"
 	"atommuse/backend/exhibition-service/pkg/repositorty/sectionrepo"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
 	"context"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -21,10 +23,35 @@ type ISectionServices interface {
 // SectionServices is the implementation of the IExhibitionSectionServices interface.
 type SectionServices struct {
 	Repository sectionrepo.ISectionRepository
+	// ScreeningService screens the texts of sections before they are written. Texts are not
+	// screened when it is nil.
+	ScreeningService screeningsvc.IScreeningServices
 }
 
+// CreateExhibitionSection adds a section to an exhibition. Flagged texts hold the exhibition
+// for review, and blocked texts are rejected.
 func (service SectionServices) CreateExhibitionSection(ctx context.Context, section *model.RequestCreateExhibitionSection) (*primitive.ObjectID, error) {
-	return service.Repository.CreateExhibitionSection(ctx, section)
+	if service.ScreeningService == nil {
+		return service.Repository.CreateExhibitionSection(ctx, section)
+	}
+
+	fields := screening.SectionFields(&model.ExhibitionSection{
+		Title:        section.Title,
+		Text:         section.Text,
+		LeftCol:      section.LeftCol,
+		RightCol:     section.RightCol,
+		Translations: section.Translations,
+	})
+	var sectionID *primitive.ObjectID
+	err := service.ScreeningService.ScreenContent(ctx, section.ExhibitionID, fields, func() error {
+		var err error
+		sectionID, err = service.Repository.CreateExhibitionSection(ctx, section)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sectionID, nil
 }
 
 func (service SectionServices) DeleteExhibitionSectionByID(ctx context.Context, sectionID string) error {
@@ -44,6 +71,33 @@ func (service SectionServices) GetSectionsByExhibitionID(ctx context.Context, ex
 	return service.Repository.GetSectionsByExhibitionID(ctx, exhibitionID)
 }
 
+// UpdateExhibitionSection updates a section. Flagged texts hold the exhibition of the section
+// for review, and blocked texts are rejected.
 func (service SectionServices) UpdateExhibitionSection(ctx context.Context, sectionID string, updatedSection *model.RequestUpdateExhibitionSection) (*primitive.ObjectID, error) {
-	return service.Repository.UpdateExhibitionSection(ctx, sectionID, updatedSection)
+	if service.ScreeningService == nil {
+		return service.Repository.UpdateExhibitionSection(ctx, sectionID, updatedSection)
+	}
+
+	current, err := service.Repository.GetExhibitionSectionByID(ctx, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := screening.SectionFields(&model.ExhibitionSection{
+		Title:        updatedSection.Title,
+		Text:         updatedSection.Text,
+		LeftCol:      updatedSection.LeftCol,
+		RightCol:     updatedSection.RightCol,
+		Translations: updatedSection.Translations,
+	})
+	var updatedID *primitive.ObjectID
+	err = service.ScreeningService.ScreenContent(ctx, current.ExhibitionID, fields, func() error {
+		var err error
+		updatedID, err = service.Repository.UpdateExhibitionSection(ctx, sectionID, updatedSection)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedID, nil
 }
@@ -0,0 +1,175 @@
+package sharesvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/sharerepo"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// IShareLinkServices defines the interface for share link services.
+type IShareLinkServices interface {
+	CreateShareLink(ctx context.Context, exhibitionID string, actor model.Actor, request *model.RequestCreateShareLink) (*model.ResponseCreateShareLink, error)
+	GetShareLinks(ctx context.Context, exhibitionID string, actor model.Actor) ([]model.ShareLink, error)
+	RevokeShareLink(ctx context.Context, exhibitionID, linkID string, actor model.Actor) error
+	ResolveShareLink(ctx context.Context, token, password string) (*model.ShareLink, error)
+}
+
+// ShareLinkServices is the implementation of the IShareLinkServices interface.
+type ShareLinkServices struct {
+	Repository          sharerepo.IShareLinkRepository
+	CollaboratorService collabsvc.ICollaboratorServices
+}
+
+// CreateShareLink creates a preview link for the exhibition. The returned token is not stored.
+func (service ShareLinkServices) CreateShareLink(ctx context.Context, exhibitionID string, actor model.Actor, request *model.RequestCreateShareLink) (*model.ResponseCreateShareLink, error) {
+	access, err := service.CollaboratorService.Authorize(ctx, exhibitionID, actor, model.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	link := model.ShareLink{
+		ExhibitionID: access.ID,
+		Label:        request.Label,
+		TokenHash:    HashToken(token),
+		CreatedBy:    actor.UserID.UserID,
+		CreatedAt:    time.Now(),
+		ExpiresAt:    request.ExpiresAt,
+	}
+
+	if request.Password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		link.PasswordHash = string(passwordHash)
+		link.HasPassword = true
+	}
+
+	linkID, err := service.Repository.CreateShareLink(ctx, &link)
+	if err != nil {
+		return nil, err
+	}
+	link.ID = *linkID
+
+	return &model.ResponseCreateShareLink{ShareLink: link, Token: token}, nil
+}
+
+func (service ShareLinkServices) GetShareLinks(ctx context.Context, exhibitionID string, actor model.Actor) ([]model.ShareLink, error) {
+	if _, err := service.CollaboratorService.Authorize(ctx, exhibitionID, actor, model.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return service.Repository.GetShareLinksByExhibitionID(ctx, exhibitionID)
+}
+
+func (service ShareLinkServices) RevokeShareLink(ctx context.Context, exhibitionID, linkID string, actor model.Actor) error {
+	if _, err := service.CollaboratorService.Authorize(ctx, exhibitionID, actor, model.RoleEditor); err != nil {
+		return err
+	}
+
+	return service.Repository.RevokeShareLink(ctx, exhibitionID, linkID)
+}
+
+// ResolveShareLink finds the share link for a token and checks that it is usable with the given password.
+func (service ShareLinkServices) ResolveShareLink(ctx context.Context, token, password string) (*model.ShareLink, error) {
+	if token == "" {
+		return nil, cerr.ErrShareLinkNotFound
+	}
+
+	link, err := service.Repository.GetShareLinkByTokenHash(ctx, HashToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckShareLink(link, password, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// CheckShareLink validates revocation, expiry and password of a share link at the given time.
+func CheckShareLink(link *model.ShareLink, password string, now time.Time) error {
+	if link.RevokedAt != nil {
+		return cerr.ErrShareLinkNotFound
+	}
+
+	if link.ExpiresAt != nil && !now.Before(*link.ExpiresAt) {
+		return cerr.ErrShareLinkExpired
+	}
+
+	if link.HasPassword {
+		if password == "" {
+			return cerr.ErrPasswordRequired
+		}
+		if bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(password)) != nil {
+			return cerr.ErrPasswordInvalid
+		}
+	}
+
+	return nil
+}
+
+// CanView reports whether the viewer may see an exhibition regardless of its visibility:
+// admins, the owner and accepted collaborators.
+func CanView(exhibition *model.ResponseExhibition, viewer model.ExhibitionViewer) bool {
+	if viewer.Actor == nil {
+		return false
+	}
+	if viewer.Actor.Role == "admin" {
+		return true
+	}
+
+	access := &model.ExhibitionAccess{ID: exhibition.ID, UserID: exhibition.UserID, Collaborators: exhibition.Collaborators}
+	return collabsvc.RoleOf(access, viewer.Actor.UserID.UserID) != ""
+}
+
+// CanEdit reports whether the viewer may edit an exhibition: admins, the owner and editors.
+func CanEdit(exhibition *model.ResponseExhibition, viewer model.ExhibitionViewer) bool {
+	if viewer.Actor == nil {
+		return false
+	}
+	if viewer.Actor.Role == "admin" {
+		return true
+	}
+
+	access := &model.ExhibitionAccess{ID: exhibition.ID, UserID: exhibition.UserID, Collaborators: exhibition.Collaborators}
+	role := collabsvc.RoleOf(access, viewer.Actor.UserID.UserID)
+	return role == model.RoleOwner || role == model.RoleEditor
+}
+
+// ViewerUserID returns the hex user ID of the viewer, or an empty string for anonymous viewers.
+func ViewerUserID(viewer model.ExhibitionViewer) string {
+	if viewer.Actor == nil {
+		return ""
+	}
+	return viewer.Actor.UserID.UserID.Hex()
+}
+
+// HashToken returns the stored form of a share link token.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,62 @@
+package sharesvc_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/service/sharesvc"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCheckShareLink(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	passwordHash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+
+	tests := []struct {
+		name     string
+		link     model.ShareLink
+		password string
+		err      error
+	}{
+		{"open link", model.ShareLink{}, "", nil},
+		{"not yet expired", model.ShareLink{ExpiresAt: &future}, "", nil},
+		{"expired", model.ShareLink{ExpiresAt: &past}, "", cerr.ErrShareLinkExpired},
+		{"revoked", model.ShareLink{RevokedAt: &past}, "", cerr.ErrShareLinkNotFound},
+		{"password missing", model.ShareLink{HasPassword: true, PasswordHash: string(passwordHash)}, "", cerr.ErrPasswordRequired},
+		{"password wrong", model.ShareLink{HasPassword: true, PasswordHash: string(passwordHash)}, "guess", cerr.ErrPasswordInvalid},
+		{"password right", model.ShareLink{HasPassword: true, PasswordHash: string(passwordHash)}, "secret", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, sharesvc.CheckShareLink(&tt.link, tt.password, now))
+		})
+	}
+}
+
+func TestCanView(t *testing.T) {
+	owner := primitive.NewObjectID()
+	viewer := primitive.NewObjectID()
+	exhibition := &model.ResponseExhibition{
+		UserID: model.UserID{UserID: owner},
+		Collaborators: []model.Collaborator{
+			{UserID: viewer, Role: model.RoleViewer, Status: model.InvitationAccepted},
+		},
+	}
+
+	actor := func(userID primitive.ObjectID, role string) model.ExhibitionViewer {
+		return model.ExhibitionViewer{Actor: &model.Actor{UserID: model.UserID{UserID: userID}, Role: role}}
+	}
+
+	assert.False(t, sharesvc.CanView(exhibition, model.ExhibitionViewer{}))
+	assert.True(t, sharesvc.CanView(exhibition, actor(owner, "exhibitor")))
+	assert.True(t, sharesvc.CanView(exhibition, actor(viewer, "exhibitor")))
+	assert.False(t, sharesvc.CanView(exhibition, actor(primitive.NewObjectID(), "exhibitor")))
+	assert.True(t, sharesvc.CanView(exhibition, actor(primitive.NewObjectID(), "admin")))
+}
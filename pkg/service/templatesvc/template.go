@@ -0,0 +1,421 @@
+package templatesvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
+	"atommuse/backend/exhibition-service/pkg/repositorty/templaterepo"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"atommuse/backend/exhibition-service/pkg/service/artworksvc"
+	"atommuse/backend/exhibition-service/pkg/service/collabsvc"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Placeholder content used when an exhibition is saved as a template without its content.
+const (
+	PlaceholderTitle = "Title"
+	PlaceholderText  = "Write your text here."
+)
+
+// ITemplateServices defines the interface for cloning and template services.
+type ITemplateServices interface {
+	CloneExhibition(ctx context.Context, exhibitionID string, actor model.Actor, request *model.RequestCloneExhibition) (*primitive.ObjectID, error)
+	CreateTemplate(ctx context.Context, actor model.Actor, request *model.RequestCreateTemplate) (*primitive.ObjectID, error)
+	GetTemplates(ctx context.Context, actor model.Actor) ([]model.ExhibitionTemplate, error)
+	GetTemplateByID(ctx context.Context, templateID string, actor model.Actor) (*model.ExhibitionTemplate, error)
+	DeleteTemplate(ctx context.Context, templateID string, actor model.Actor) error
+	InstantiateTemplate(ctx context.Context, templateID string, actor model.Actor, request *model.RequestInstantiateTemplate) (*primitive.ObjectID, error)
+}
+
+// TemplateServices is the implementation of the ITemplateServices interface.
+type TemplateServices struct {
+	Repository          templaterepo.ITemplateRepository
+	CollaboratorService collabsvc.ICollaboratorServices
+	// ScreeningService screens the texts of new exhibitions before they are stored. Texts are
+	// not screened when it is nil.
+	ScreeningService screeningsvc.IScreeningServices
+	// ArtworkService drops the references of new exhibitions to artworks outside the catalogue
+	// of their owner. References are kept when it is nil.
+	ArtworkService artworksvc.IArtworkServices
+}
+
+// CloneExhibition deep-copies an exhibition with its sections and rooms into a new private
+// exhibition owned by the actor. Any collaborator of the source may clone it. Artworks of the
+// source that are not in the catalogue of the actor are left out of the copy.
+func (service TemplateServices) CloneExhibition(ctx context.Context, exhibitionID string, actor model.Actor, request *model.RequestCloneExhibition) (*primitive.ObjectID, error) {
+	if _, err := service.CollaboratorService.Authorize(ctx, exhibitionID, actor, model.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	source, err := service.Repository.GetExhibitionTree(ctx, exhibitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := request.ExhibitionName
+	if name == "" {
+		name = source.ExhibitionName + " (copy)"
+	}
+
+	clone := CloneExhibition(source, actor.UserID)
+	clone.ExhibitionName = name
+
+	return service.insert(ctx, clone)
+}
+
+// CreateTemplate saves an exhibition as a template. Only admins can create system templates.
+func (service TemplateServices) CreateTemplate(ctx context.Context, actor model.Actor, request *model.RequestCreateTemplate) (*primitive.ObjectID, error) {
+	scope := request.Scope
+	if scope == "" {
+		scope = model.TemplateScopeUser
+	}
+	if scope == model.TemplateScopeSystem && actor.Role != "admin" {
+		return nil, cerr.ErrForbidden
+	}
+
+	exhibitionID := request.ExhibitionID.Hex()
+	if _, err := service.CollaboratorService.Authorize(ctx, exhibitionID, actor, model.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	source, err := service.Repository.GetExhibitionTree(ctx, exhibitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	template := TemplateFromExhibition(source, request.KeepContent)
+	template.Name = request.Name
+	template.Description = request.Description
+	template.Scope = scope
+	template.OwnerID = actor.UserID.UserID
+	template.CreatedAt = time.Now()
+
+	return service.Repository.CreateTemplate(ctx, template)
+}
+
+func (service TemplateServices) GetTemplates(ctx context.Context, actor model.Actor) ([]model.ExhibitionTemplate, error) {
+	return service.Repository.GetTemplates(ctx, actor.UserID.UserID)
+}
+
+func (service TemplateServices) GetTemplateByID(ctx context.Context, templateID string, actor model.Actor) (*model.ExhibitionTemplate, error) {
+	template, err := service.Repository.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canUseTemplate(template, actor) {
+		return nil, cerr.ErrTemplateNotFound
+	}
+
+	return template, nil
+}
+
+// DeleteTemplate deletes a template. Users may delete their own templates, admins any template.
+func (service TemplateServices) DeleteTemplate(ctx context.Context, templateID string, actor model.Actor) error {
+	template, err := service.GetTemplateByID(ctx, templateID, actor)
+	if err != nil {
+		return err
+	}
+
+	if actor.Role != "admin" && template.OwnerID != actor.UserID.UserID {
+		return cerr.ErrForbidden
+	}
+
+	return service.Repository.DeleteTemplate(ctx, templateID)
+}
+
+// InstantiateTemplate creates a new private exhibition owned by the actor from a template.
+func (service TemplateServices) InstantiateTemplate(ctx context.Context, templateID string, actor model.Actor, request *model.RequestInstantiateTemplate) (*primitive.ObjectID, error) {
+	template, err := service.GetTemplateByID(ctx, templateID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	exhibition := ExhibitionFromTemplate(template, actor.UserID)
+	exhibition.ExhibitionName = request.ExhibitionName
+	exhibition.ExhibitionDescription = request.ExhibitionDescription
+	exhibition.StartDate = request.StartDate
+	exhibition.EndDate = request.EndDate
+
+	return service.insert(ctx, exhibition)
+}
+
+// insert screens a new exhibition with its sections and rooms and stores it, without the
+// artworks its owner cannot use.
+func (service TemplateServices) insert(ctx context.Context, exhibition *model.ResponseExhibition) (*primitive.ObjectID, error) {
+	if service.ArtworkService != nil {
+		if err := service.ArtworkService.DropForeignReferences(ctx, exhibition, exhibition.UserID.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	screened, err := ScreenExhibition(ctx, service.ScreeningService, exhibition)
+	if err != nil {
+		return nil, err
+	}
+
+	objectID, err := service.Repository.InsertExhibitionTree(ctx, exhibition)
+	if err != nil {
+		return nil, err
+	}
+
+	QueueForReview(ctx, service.ScreeningService, *objectID, screened)
+	return objectID, nil
+}
+
+// ScreenExhibition screens the texts of a new exhibition with its sections and rooms before it
+// is stored, as for exhibitions created from scratch. A blocked exhibition fails with a
+// screening.BlockedError. A flagged one is held for review and the screening result is
+// returned, to be queued with QueueForReview once the exhibition is stored. Texts are not
+// screened when screeningService is nil.
+func ScreenExhibition(ctx context.Context, screeningService screeningsvc.IScreeningServices, exhibition *model.ResponseExhibition) (*model.ScreeningResult, error) {
+	if screeningService == nil {
+		return nil, nil
+	}
+
+	result, err := screeningService.Screen(ctx, screening.TreeFields(exhibition))
+	if err != nil {
+		return nil, err
+	}
+	if result.Verdict != model.ScreeningFlagged {
+		return nil, nil
+	}
+
+	exhibition.Status = moderation.Review
+	return result, nil
+}
+
+// QueueForReview puts a stored exhibition held by ScreenExhibition in the moderation queue.
+// Nothing is queued when it was not flagged.
+func QueueForReview(ctx context.Context, screeningService screeningsvc.IScreeningServices, exhibitionID primitive.ObjectID, screened *model.ScreeningResult) {
+	if screened == nil {
+		return
+	}
+
+	// The exhibition is already held, only its place in the moderation queue is missing
+	if err := screeningService.HoldForReview(ctx, exhibitionID, screened); err != nil {
+		log.Printf("Error queueing exhibition %s for review: %v", exhibitionID.Hex(), err)
+	}
+}
+
+// CloneExhibition copies the content of an exhibition for a new owner. Engagement data,
+// collaborators and publication state are reset; IDs are assigned when the copy is stored.
+func CloneExhibition(source *model.ResponseExhibition, owner model.UserID) *model.ResponseExhibition {
+	return &model.ResponseExhibition{
+		ExhibitionName:        source.ExhibitionName,
+		ExhibitionDescription: source.ExhibitionDescription,
+		ThumbnailImg:          source.ThumbnailImg,
+		StartDate:             source.StartDate,
+		EndDate:               source.EndDate,
+		IsPublic:              false,
+		ExhibitionCategories:  append([]string(nil), source.ExhibitionCategories...),
+		ExhibitionTags:        append([]string(nil), source.ExhibitionTags...),
+		UserID:                owner,
+		LayoutUsed:            source.LayoutUsed,
+		ExhibitionSections:    append([]model.ExhibitionSection(nil), source.ExhibitionSections...),
+		Room:                  append([]model.Room(nil), source.Room...),
+		Timeline:              append([]model.TimelineEntry(nil), source.Timeline...),
+		PointsOfInterest:      append([]model.PointOfInterest(nil), source.PointsOfInterest...),
+		Venue:                 source.Venue,
+		Status:                "created",
+		MediaRights:           append([]model.MediaRights(nil), source.MediaRights...),
+		DefaultLocale:         source.DefaultLocale,
+		Translations:          source.Translations,
+	}
+}
+
+// TemplateFromExhibition builds a template from an exhibition. Unless keepContent is set,
+// texts and images are replaced with placeholders while the structure is kept.
+func TemplateFromExhibition(source *model.ResponseExhibition, keepContent bool) *model.ExhibitionTemplate {
+	template := &model.ExhibitionTemplate{
+		ThumbnailImg:         source.ThumbnailImg,
+		LayoutUsed:           source.LayoutUsed,
+		ExhibitionCategories: append([]string(nil), source.ExhibitionCategories...),
+		ExhibitionTags:       append([]string(nil), source.ExhibitionTags...),
+	}
+
+	for _, section := range source.ExhibitionSections {
+		section.ID = primitive.NilObjectID
+		section.ExhibitionID = primitive.NilObjectID
+		if !keepContent {
+			section = placeholderSection(section)
+		}
+		template.Sections = append(template.Sections, section)
+	}
+
+	for _, room := range source.Room {
+		room.ID = primitive.NilObjectID
+		room.ExhibitionID = primitive.NilObjectID
+		if !keepContent {
+			room = placeholderRoom(room)
+		}
+		template.Rooms = append(template.Rooms, room)
+	}
+
+	for _, entry := range source.Timeline {
+		entry.ID = primitive.NilObjectID
+		entry.ExhibitionID = primitive.NilObjectID
+		if !keepContent {
+			entry = placeholderTimelineEntry(entry)
+		}
+		template.Timeline = append(template.Timeline, entry)
+	}
+
+	for _, point := range source.PointsOfInterest {
+		point.ID = primitive.NilObjectID
+		point.ExhibitionID = primitive.NilObjectID
+		if !keepContent {
+			point = placeholderPointOfInterest(point)
+		}
+		template.PointsOfInterest = append(template.PointsOfInterest, point)
+	}
+
+	if !keepContent {
+		template.ThumbnailImg = placeholderImage(template.ThumbnailImg)
+	}
+
+	return template
+}
+
+// ExhibitionFromTemplate builds a new private exhibition for the owner from a template.
+func ExhibitionFromTemplate(template *model.ExhibitionTemplate, owner model.UserID) *model.ResponseExhibition {
+	return &model.ResponseExhibition{
+		ThumbnailImg:         template.ThumbnailImg,
+		IsPublic:             false,
+		ExhibitionCategories: append([]string(nil), template.ExhibitionCategories...),
+		ExhibitionTags:       append([]string(nil), template.ExhibitionTags...),
+		UserID:               owner,
+		LayoutUsed:           template.LayoutUsed,
+		ExhibitionSections:   append([]model.ExhibitionSection(nil), template.Sections...),
+		Room:                 append([]model.Room(nil), template.Rooms...),
+		Timeline:             append([]model.TimelineEntry(nil), template.Timeline...),
+		PointsOfInterest:     append([]model.PointOfInterest(nil), template.PointsOfInterest...),
+		Status:               "created",
+	}
+}
+
+func canUseTemplate(template *model.ExhibitionTemplate, actor model.Actor) bool {
+	return template.Scope == model.TemplateScopeSystem ||
+		template.OwnerID == actor.UserID.UserID ||
+		actor.Role == "admin"
+}
+
+// placeholderTimelineEntry keeps the dates and era of an entry, which give a template its shape.
+func placeholderTimelineEntry(entry model.TimelineEntry) model.TimelineEntry {
+	entry.Title = placeholderString(entry.Title, PlaceholderTitle)
+	entry.Text = placeholderString(entry.Text, PlaceholderText)
+	entry.Translations = nil
+
+	media := make([]string, len(entry.Media))
+	for i, ref := range entry.Media {
+		media[i] = placeholderImage(ref)
+	}
+	entry.Media = media
+
+	return entry
+}
+
+// placeholderPointOfInterest keeps the location and order of a point, which give a template its shape.
+func placeholderPointOfInterest(point model.PointOfInterest) model.PointOfInterest {
+	point.Title = placeholderString(point.Title, PlaceholderTitle)
+	point.Text = placeholderString(point.Text, PlaceholderText)
+	point.Translations = nil
+
+	media := make([]string, len(point.Media))
+	for i, ref := range point.Media {
+		media[i] = placeholderImage(ref)
+	}
+	point.Media = media
+
+	return point
+}
+
+func placeholderSection(section model.ExhibitionSection) model.ExhibitionSection {
+	section.Title = placeholderString(section.Title, PlaceholderTitle)
+	section.Text = placeholderString(section.Text, PlaceholderText)
+	section.LeftCol.Title = placeholderString(section.LeftCol.Title, PlaceholderTitle)
+	section.LeftCol.Text = placeholderString(section.LeftCol.Text, PlaceholderText)
+	section.LeftCol.ImageDescription = placeholderString(section.LeftCol.ImageDescription, PlaceholderText)
+	section.LeftCol.Image = placeholderImage(section.LeftCol.Image)
+	section.RightCol.Title = placeholderString(section.RightCol.Title, PlaceholderTitle)
+	section.RightCol.Text = placeholderString(section.RightCol.Text, PlaceholderText)
+	section.RightCol.ImageDescription = placeholderString(section.RightCol.ImageDescription, PlaceholderText)
+	section.RightCol.Image = placeholderImage(section.RightCol.Image)
+	section.Translations = nil
+
+	images := make([]string, len(section.Images))
+	for i, image := range section.Images {
+		images[i] = placeholderImage(image)
+	}
+	section.Images = images
+
+	return section
+}
+
+func placeholderRoom(room model.Room) model.Room {
+	room.MapThumbnail = placeholderImage(room.MapThumbnail)
+
+	left := make([]model.LeftRightItem, len(room.Left))
+	for i, item := range room.Left {
+		item.Src = placeholderImage(item.Src)
+		item.Details = placeholderDetails(item.Details)
+		left[i] = item
+	}
+	room.Left = left
+
+	center := make([]model.CenterItem, len(room.Center))
+	for i, item := range room.Center {
+		item.Src = placeholderImage(item.Src)
+		item.Details = placeholderDetails(item.Details)
+		center[i] = item
+	}
+	room.Center = center
+
+	right := make([]model.LeftRightItem, len(room.Right))
+	for i, item := range room.Right {
+		item.Src = placeholderImage(item.Src)
+		item.Details = placeholderDetails(item.Details)
+		right[i] = item
+	}
+	room.Right = right
+
+	return room
+}
+
+func placeholderDetails(details model.Details) model.Details {
+	details.Img = placeholderImage(details.Img)
+
+	contents := make([]model.Contents, len(details.Contents))
+	for i, content := range details.Contents {
+		contents[i] = model.Contents{
+			Title: placeholderString(content.Title, PlaceholderTitle),
+			Text:  [][]string{{PlaceholderText}},
+		}
+	}
+	details.Contents = contents
+	details.Translations = nil
+
+	return details
+}
+
+// placeholderString keeps empty fields empty so the template mirrors the source structure.
+func placeholderString(value, placeholder string) string {
+	if value == "" {
+		return ""
+	}
+	return placeholder
+}
+
+// placeholderImage replaces an image with TEMPLATE_PLACEHOLDER_IMAGE, or drops it when unset.
+func placeholderImage(value string) string {
+	if value == "" {
+		return ""
+	}
+	return os.Getenv("TEMPLATE_PLACEHOLDER_IMAGE")
+}
@@ -0,0 +1,168 @@
+package templatesvc_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/moderation"
+	"atommuse/backend/exhibition-service/pkg/screening"
+	"atommuse/backend/exhibition-service/pkg/service/screeningsvc"
+	"atommuse/backend/exhibition-service/pkg/service/templatesvc"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func sourceExhibition() *model.ResponseExhibition {
+	exhibitionID := primitive.NewObjectID()
+	return &model.ResponseExhibition{
+		ID:             exhibitionID,
+		ExhibitionName: "Siam Ceramics",
+		IsPublic:       true,
+		UserID:         model.UserID{UserID: primitive.NewObjectID()},
+		LayoutUsed:     "blogLayout",
+		LikeCount:      12,
+		LikeList:       []string{"a", "b"},
+		VisitedNumber:  300,
+		Status:         "created",
+		ExhibitionSections: []model.ExhibitionSection{
+			{
+				ID:           primitive.NewObjectID(),
+				SectionType:  "banner",
+				Title:        "Celadon",
+				Text:         "Green glazed stoneware",
+				Images:       []string{"https://cdn.example/1.jpg"},
+				LeftCol:      model.LeftColumn{Image: "https://cdn.example/2.jpg", Title: "Bowl"},
+				Translations: map[string]model.SectionTranslation{"th": {Title: "เซลาดอน"}},
+				ExhibitionID: exhibitionID,
+			},
+		},
+		Room: []model.Room{
+			{
+				ID: primitive.NewObjectID(),
+				Center: []model.CenterItem{{Details: model.Details{
+					Contents:     []model.Contents{{Title: "Jar"}},
+					Translations: map[string][]model.Contents{"th": {{Title: "ไห"}}},
+				}}},
+				ExhibitionID: exhibitionID,
+			},
+		},
+		Collaborators: []model.Collaborator{{UserID: primitive.NewObjectID(), Role: model.RoleEditor}},
+	}
+}
+
+func TestCloneExhibition(t *testing.T) {
+	source := sourceExhibition()
+	owner := model.UserID{UserID: primitive.NewObjectID(), Username: "curator"}
+
+	clone := templatesvc.CloneExhibition(source, owner)
+
+	assert.Equal(t, owner, clone.UserID)
+	assert.False(t, clone.IsPublic)
+	assert.Zero(t, clone.LikeCount)
+	assert.Empty(t, clone.LikeList)
+	assert.Zero(t, clone.VisitedNumber)
+	assert.Empty(t, clone.Collaborators)
+	assert.Equal(t, source.ExhibitionSections, clone.ExhibitionSections)
+	assert.True(t, clone.ID.IsZero())
+}
+
+func TestTemplateFromExhibition(t *testing.T) {
+	t.Setenv("TEMPLATE_PLACEHOLDER_IMAGE", "https://cdn.example/placeholder.png")
+	source := sourceExhibition()
+
+	template := templatesvc.TemplateFromExhibition(source, false)
+	assert.Len(t, template.Sections, 1)
+
+	section := template.Sections[0]
+	assert.True(t, section.ID.IsZero())
+	assert.True(t, section.ExhibitionID.IsZero())
+	assert.Equal(t, "banner", section.SectionType)
+	assert.Equal(t, templatesvc.PlaceholderTitle, section.Title)
+	assert.Equal(t, templatesvc.PlaceholderText, section.Text)
+	assert.Equal(t, []string{"https://cdn.example/placeholder.png"}, section.Images)
+	assert.Equal(t, "https://cdn.example/placeholder.png", section.LeftCol.Image)
+	assert.Empty(t, section.RightCol.Image)
+	assert.Nil(t, section.Translations)
+
+	details := template.Rooms[0].Center[0].Details
+	assert.Equal(t, templatesvc.PlaceholderTitle, details.Contents[0].Title)
+	assert.Nil(t, details.Translations)
+
+	// The source must not be modified
+	assert.Equal(t, "Celadon", source.ExhibitionSections[0].Title)
+	assert.Equal(t, "https://cdn.example/1.jpg", source.ExhibitionSections[0].Images[0])
+	assert.NotNil(t, source.ExhibitionSections[0].Translations)
+	assert.NotNil(t, source.Room[0].Center[0].Details.Translations)
+
+	kept := templatesvc.TemplateFromExhibition(source, true)
+	assert.Equal(t, "Celadon", kept.Sections[0].Title)
+	assert.Equal(t, "เซลาดอน", kept.Sections[0].Translations["th"].Title)
+}
+
+// screeningService flags or blocks texts containing a word and records the exhibitions held
+// for review.
+type screeningService struct {
+	screeningsvc.IScreeningServices
+	severity string
+	word     string
+	held     []primitive.ObjectID
+}
+
+func (s *screeningService) Screen(ctx context.Context, fields []screening.Field) (*model.ScreeningResult, error) {
+	rules := []model.ScreeningRule{{Name: "words", Type: model.ScreeningWords, Words: []string{s.word}, Severity: s.severity, Enabled: true}}
+	screener, err := screening.Compile(rules)
+	if err != nil {
+		return nil, err
+	}
+	result := screener.Screen(fields)
+	if result.Verdict == model.ScreeningBlocked {
+		return nil, &screening.BlockedError{Matches: result.Matches}
+	}
+	return &result, nil
+}
+
+func (s *screeningService) HoldForReview(ctx context.Context, exhibitionID primitive.ObjectID, result *model.ScreeningResult) error {
+	s.held = append(s.held, exhibitionID)
+	return nil
+}
+
+func TestScreenExhibition(t *testing.T) {
+	t.Run("blocked section text", func(t *testing.T) {
+		service := &screeningService{severity: model.ScreeningBlock, word: "stoneware"}
+		exhibition := templatesvc.CloneExhibition(sourceExhibition(), model.UserID{})
+
+		_, err := templatesvc.ScreenExhibition(context.Background(), service, exhibition)
+		var blocked *screening.BlockedError
+		require.ErrorAs(t, err, &blocked)
+		assert.Equal(t, "exhibitionSections[0].text", blocked.Matches[0].Field)
+	})
+
+	t.Run("flagged exhibitions are held", func(t *testing.T) {
+		service := &screeningService{severity: model.ScreeningFlag, word: "celadon"}
+		exhibition := templatesvc.CloneExhibition(sourceExhibition(), model.UserID{})
+
+		screened, err := templatesvc.ScreenExhibition(context.Background(), service, exhibition)
+		require.NoError(t, err)
+		require.NotNil(t, screened)
+		assert.Equal(t, moderation.Review, exhibition.Status)
+
+		id := primitive.NewObjectID()
+		templatesvc.QueueForReview(context.Background(), service, id, screened)
+		assert.Equal(t, []primitive.ObjectID{id}, service.held)
+	})
+
+	t.Run("clean exhibitions are not held", func(t *testing.T) {
+		service := &screeningService{severity: model.ScreeningFlag, word: "forbidden"}
+		exhibition := templatesvc.CloneExhibition(sourceExhibition(), model.UserID{})
+
+		screened, err := templatesvc.ScreenExhibition(context.Background(), service, exhibition)
+		require.NoError(t, err)
+		assert.Nil(t, screened)
+		assert.Equal(t, "created", exhibition.Status)
+
+		templatesvc.QueueForReview(context.Background(), service, primitive.NewObjectID(), screened)
+		assert.Empty(t, service.held)
+	})
+}
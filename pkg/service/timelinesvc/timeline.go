@@ -0,0 +1,85 @@
+package timelinesvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/timelinerepo"
+	"atommuse/backend/exhibition-service/pkg/timeline"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ITimelineServices defines the interface for the entries of timelineLayout exhibitions.
+type ITimelineServices interface {
+	CreateTimelineEntry(ctx context.Context, request *model.RequestCreateTimelineEntry) (*primitive.ObjectID, error)
+	GetTimelineEntryByID(ctx context.Context, entryID string) (*model.TimelineEntry, error)
+	UpdateTimelineEntry(ctx context.Context, entryID string, request *model.RequestUpdateTimelineEntry) (*model.TimelineEntry, error)
+	DeleteTimelineEntry(ctx context.Context, entryID string) error
+}
+
+// TimelineServices is the implementation of the ITimelineServices interface.
+type TimelineServices struct {
+	Repository timelinerepo.ITimelineRepository
+}
+
+// CreateTimelineEntry adds an entry to the timeline of a timelineLayout exhibition.
+func (service TimelineServices) CreateTimelineEntry(ctx context.Context, request *model.RequestCreateTimelineEntry) (*primitive.ObjectID, error) {
+	usedLayout, err := service.Repository.GetExhibitionLayout(ctx, request.ExhibitionID)
+	if err != nil {
+		return nil, err
+	}
+	if usedLayout != layout.Timeline {
+		return nil, cerr.ErrLayoutMismatch
+	}
+
+	entry := fromRequest(&request.RequestUpdateTimelineEntry)
+	entry.ExhibitionID = request.ExhibitionID
+	if err := timeline.Validate(&entry); err != nil {
+		return nil, err
+	}
+
+	return service.Repository.CreateTimelineEntry(ctx, &entry)
+}
+
+func (service TimelineServices) GetTimelineEntryByID(ctx context.Context, entryID string) (*model.TimelineEntry, error) {
+	return service.Repository.GetTimelineEntryByID(ctx, entryID)
+}
+
+// UpdateTimelineEntry replaces the content of an entry and returns it.
+func (service TimelineServices) UpdateTimelineEntry(ctx context.Context, entryID string, request *model.RequestUpdateTimelineEntry) (*model.TimelineEntry, error) {
+	existing, err := service.Repository.GetTimelineEntryByID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := fromRequest(request)
+	entry.ID = existing.ID
+	entry.ExhibitionID = existing.ExhibitionID
+	if err := timeline.Validate(&entry); err != nil {
+		return nil, err
+	}
+
+	if err := service.Repository.UpdateTimelineEntry(ctx, &entry); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (service TimelineServices) DeleteTimelineEntry(ctx context.Context, entryID string) error {
+	return service.Repository.DeleteTimelineEntry(ctx, entryID)
+}
+
+func fromRequest(request *model.RequestUpdateTimelineEntry) model.TimelineEntry {
+	return model.TimelineEntry{
+		StartDate:    request.StartDate,
+		EndDate:      request.EndDate,
+		Era:          request.Era,
+		Title:        request.Title,
+		Text:         request.Text,
+		Media:        request.Media,
+		Translations: request.Translations,
+	}
+}
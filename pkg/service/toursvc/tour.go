@@ -0,0 +1,145 @@
+package toursvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/media"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/tourrepo"
+	"atommuse/backend/exhibition-service/pkg/service/exhibisvc"
+	"atommuse/backend/exhibition-service/pkg/tour"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/text/language"
+)
+
+// ITourServices defines the interface for the guided tours of exhibitions.
+type ITourServices interface {
+	CreateTour(ctx context.Context, request *model.RequestTour) (*primitive.ObjectID, error)
+	GetToursByExhibitionID(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.Tour, error)
+	GetTourByID(ctx context.Context, tourID string) (*model.Tour, error)
+	UpdateTour(ctx context.Context, tourID string, request *model.RequestTour) (*model.Tour, error)
+	DeleteTour(ctx context.Context, tourID string) error
+	SetStopAudio(ctx context.Context, tourID string, index int, locale string, audio io.Reader, sniffed, declared string) (string, error)
+}
+
+// TourServices is the implementation of the ITourServices interface.
+type TourServices struct {
+	Repository tourrepo.ITourRepository
+	// TreeRepository loads the sections and rooms the stops of a tour must reference.
+	TreeRepository exhibisvc.ITreeRepository
+}
+
+// CreateTour adds a tour to an exhibition after checking that its stops exist.
+func (service TourServices) CreateTour(ctx context.Context, request *model.RequestTour) (*primitive.ObjectID, error) {
+	now := time.Now()
+	newTour := fromRequest(request)
+	newTour.ExhibitionID = request.ExhibitionID
+	newTour.CreatedAt = now
+	newTour.UpdatedAt = now
+
+	if err := service.validate(ctx, &newTour); err != nil {
+		return nil, err
+	}
+
+	return service.Repository.CreateTour(ctx, &newTour)
+}
+
+func (service TourServices) GetToursByExhibitionID(ctx context.Context, exhibitionID primitive.ObjectID) ([]model.Tour, error) {
+	return service.Repository.GetToursByExhibitionID(ctx, exhibitionID)
+}
+
+func (service TourServices) GetTourByID(ctx context.Context, tourID string) (*model.Tour, error) {
+	return service.Repository.GetTourByID(ctx, tourID)
+}
+
+// UpdateTour replaces the name, stops and translations of a tour and returns it.
+// A tour cannot move to another exhibition.
+func (service TourServices) UpdateTour(ctx context.Context, tourID string, request *model.RequestTour) (*model.Tour, error) {
+	existing, err := service.Repository.GetTourByID(ctx, tourID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := fromRequest(request)
+	updated.ID = existing.ID
+	updated.ExhibitionID = existing.ExhibitionID
+	updated.CreatedAt = existing.CreatedAt
+	updated.UpdatedAt = time.Now()
+
+	if err := service.validate(ctx, &updated); err != nil {
+		return nil, err
+	}
+
+	if err := service.Repository.UpdateTour(ctx, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (service TourServices) DeleteTour(ctx context.Context, tourID string) error {
+	return service.Repository.DeleteTour(ctx, tourID)
+}
+
+// SetStopAudio stores a narration recording and attaches it to a stop, or to the translation
+// of the stop in a locale when one is given. It returns the media reference of the recording.
+func (service TourServices) SetStopAudio(ctx context.Context, tourID string, index int, locale string, audio io.Reader, sniffed, declared string) (string, error) {
+	existing, err := service.Repository.GetTourByID(ctx, tourID)
+	if err != nil {
+		return "", err
+	}
+	if index < 0 || index >= len(existing.Stops) {
+		return "", &tour.ValidationError{Problems: []string{fmt.Sprintf("the tour has no stop %d", index)}}
+	}
+
+	// The locale becomes part of a field path, so only canonical language tags are accepted
+	if locale != "" {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return "", &tour.ValidationError{Problems: []string{fmt.Sprintf("%q is not a locale", locale)}}
+		}
+		locale = tag.String()
+	}
+
+	extension, ok := tour.AudioExtension(sniffed, declared)
+	if !ok {
+		return "", cerr.ErrUnsupportedAudio
+	}
+
+	ref := fmt.Sprintf("/tours/%s/%s%s", existing.ID.Hex(), primitive.NewObjectID().Hex(), extension)
+	if err := media.Store(ref, audio); err != nil {
+		return "", err
+	}
+
+	field := fmt.Sprintf("stops.%d.audio", index)
+	if locale != "" {
+		field = fmt.Sprintf("stops.%d.translations.%s.audio", index, locale)
+	}
+	if err := service.Repository.SetStopAudio(ctx, existing.ID, field, ref); err != nil {
+		return "", err
+	}
+
+	return ref, nil
+}
+
+func (service TourServices) validate(ctx context.Context, subject *model.Tour) error {
+	exhibition, err := service.TreeRepository.GetExhibitionTree(ctx, subject.ExhibitionID.Hex())
+	if err != nil {
+		return err
+	}
+
+	return tour.Validate(subject, exhibition)
+}
+
+func fromRequest(request *model.RequestTour) model.Tour {
+	return model.Tour{
+		Name:         request.Name,
+		Description:  request.Description,
+		Stops:        request.Stops,
+		Translations: request.Translations,
+	}
+}
@@ -0,0 +1,158 @@
+package webhooksvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/webhookrepo"
+	"atommuse/backend/exhibition-service/pkg/service/eventsvc"
+	"atommuse/backend/exhibition-service/pkg/webhook"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Consumer is the name the dispatcher stores its position in the events under.
+const Consumer = "webhooks"
+
+const (
+	// PollInterval is how long delivery workers wait when no delivery is due.
+	PollInterval = 2 * time.Second
+	// lease is how long a claimed delivery is hidden from other workers.
+	lease = 3 * webhook.Timeout
+	// retryDelay is how long the dispatcher waits before following the events again after
+	// an error.
+	retryDelay = 30 * time.Second
+)
+
+// Dispatcher queues a delivery for every event a webhook subscribed to and sends the due
+// deliveries, retrying failed ones with exponential backoff until they are dead. Several
+// instances may run side by side: events are queued once per webhook and every delivery is
+// claimed by one worker at a time.
+type Dispatcher struct {
+	Repository   webhookrepo.IWebhookRepository
+	EventService eventsvc.IEventServices
+	Client       *http.Client
+	Workers      int
+}
+
+// Run dispatches events until the context is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	for i := 0; i < d.Workers; i++ {
+		go d.deliver(ctx)
+	}
+	d.follow(ctx)
+}
+
+// follow queues the deliveries of events from where the dispatcher stopped last time.
+func (d *Dispatcher) follow(ctx context.Context) {
+	for ctx.Err() == nil {
+		checkpoint, err := d.Repository.GetCheckpoint(ctx, Consumer)
+		if err == nil {
+			query := model.EventQuery{ResumeToken: checkpoint}
+			err = d.EventService.FollowEvents(ctx, query, func(event *model.Event, token string) error {
+				if event != nil {
+					if err := d.enqueue(ctx, event); err != nil {
+						return err
+					}
+				}
+				if token == "" {
+					return nil
+				}
+				return d.Repository.SaveCheckpoint(ctx, Consumer, token)
+			})
+		}
+		if err == nil || ctx.Err() != nil {
+			continue
+		}
+
+		log.Printf("Error following events for webhooks: %v", err)
+		if errors.Is(err, cerr.ErrEventHistoryLost) || errors.Is(err, cerr.ErrInvalidResumeToken) {
+			// Start over from the current events; the missed ones cannot be delivered
+			if err := d.Repository.SaveCheckpoint(ctx, Consumer, ""); err != nil {
+				log.Printf("Error resetting webhook event checkpoint: %v", err)
+			}
+			continue
+		}
+		sleep(ctx, retryDelay)
+	}
+}
+
+// enqueue queues a delivery of an event for every webhook subscribed to it.
+func (d *Dispatcher) enqueue(ctx context.Context, event *model.Event) error {
+	hooks, err := d.Repository.GetSubscribedWebhooks(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := make([]model.WebhookDelivery, len(hooks))
+	for i, hook := range hooks {
+		deliveries[i] = model.WebhookDelivery{
+			WebhookID:     hook.ID,
+			Event:         *event,
+			Status:        model.DeliveryPending,
+			NextAttemptAt: &now,
+			Attempts:      []model.DeliveryAttempt{},
+			CreatedAt:     now,
+		}
+	}
+	return d.Repository.CreateDeliveries(ctx, deliveries)
+}
+
+// deliver sends due deliveries one at a time.
+func (d *Dispatcher) deliver(ctx context.Context) {
+	for ctx.Err() == nil {
+		delivery, err := d.Repository.ClaimDueDelivery(ctx, time.Now(), lease)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Error claiming webhook delivery: %v", err)
+			}
+			sleep(ctx, PollInterval)
+			continue
+		}
+		if delivery == nil {
+			sleep(ctx, PollInterval)
+			continue
+		}
+
+		if err := d.attempt(ctx, delivery); err != nil {
+			log.Printf("Error delivering webhook delivery %s: %v", delivery.ID.Hex(), err)
+		}
+	}
+}
+
+// attempt sends a delivery and records the outcome. Deliveries of deactivated webhooks are
+// dead right away so they can be redelivered once the webhook is active again.
+func (d *Dispatcher) attempt(ctx context.Context, delivery *model.WebhookDelivery) error {
+	hook, err := d.Repository.GetWebhookByID(ctx, delivery.WebhookID.Hex())
+	if errors.Is(err, cerr.ErrWebhookNotFound) {
+		// The webhook was deleted together with its deliveries
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if !hook.Active {
+		delivery.Status = model.DeliveryDead
+		delivery.NextAttemptAt = nil
+		attempt := model.DeliveryAttempt{AttemptedAt: now, Error: "webhook is inactive"}
+		return d.Repository.RecordAttempt(ctx, delivery, attempt)
+	}
+
+	attempt := webhook.Send(ctx, d.Client, hook, delivery, now)
+	webhook.Advance(delivery, &attempt)
+	return d.Repository.RecordAttempt(ctx, delivery, attempt)
+}
+
+func sleep(ctx context.Context, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,114 @@
+package webhooksvc
+
+import (
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/repositorty/webhookrepo"
+	"atommuse/backend/exhibition-service/pkg/webhook"
+	"context"
+	"time"
+)
+
+// IWebhookServices defines the interface for the webhooks of other services and their deliveries.
+type IWebhookServices interface {
+	CreateWebhook(ctx context.Context, actor model.Actor, request *model.RequestWebhook) (*model.ResponseCreateWebhook, error)
+	GetWebhooks(ctx context.Context) ([]model.Webhook, error)
+	GetWebhookByID(ctx context.Context, webhookID string) (*model.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhookID string, request *model.RequestWebhook) (*model.Webhook, error)
+	DeleteWebhook(ctx context.Context, webhookID string) error
+	GetDeliveries(ctx context.Context, query model.DeliveryQuery) ([]model.WebhookDelivery, error)
+	GetDeliveryByID(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error)
+	Redeliver(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error)
+}
+
+// WebhookServices is the implementation of the IWebhookServices interface.
+type WebhookServices struct {
+	Repository webhookrepo.IWebhookRepository
+}
+
+// CreateWebhook registers a webhook with a new secret, which is only returned now.
+func (service WebhookServices) CreateWebhook(ctx context.Context, actor model.Actor, request *model.RequestWebhook) (*model.ResponseCreateWebhook, error) {
+	if err := webhook.Validate(request); err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	hook := model.Webhook{
+		URL:         request.URL,
+		Description: request.Description,
+		Events:      request.Events,
+		Active:      request.Active == nil || *request.Active,
+		Secret:      secret,
+		CreatedBy:   actor.UserID.UserID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	id, err := service.Repository.CreateWebhook(ctx, &hook)
+	if err != nil {
+		return nil, err
+	}
+	hook.ID = *id
+
+	return &model.ResponseCreateWebhook{Webhook: hook, Secret: secret}, nil
+}
+
+func (service WebhookServices) GetWebhooks(ctx context.Context) ([]model.Webhook, error) {
+	return service.Repository.GetWebhooks(ctx)
+}
+
+func (service WebhookServices) GetWebhookByID(ctx context.Context, webhookID string) (*model.Webhook, error) {
+	return service.Repository.GetWebhookByID(ctx, webhookID)
+}
+
+// UpdateWebhook changes the target and subscriptions of a webhook and returns it. Active
+// keeps its value when it is left out.
+func (service WebhookServices) UpdateWebhook(ctx context.Context, webhookID string, request *model.RequestWebhook) (*model.Webhook, error) {
+	if err := webhook.Validate(request); err != nil {
+		return nil, err
+	}
+
+	hook, err := service.Repository.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	hook.URL = request.URL
+	hook.Description = request.Description
+	hook.Events = request.Events
+	if request.Active != nil {
+		hook.Active = *request.Active
+	}
+	hook.UpdatedAt = time.Now()
+
+	if err := service.Repository.UpdateWebhook(ctx, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// DeleteWebhook deletes a webhook together with its deliveries.
+func (service WebhookServices) DeleteWebhook(ctx context.Context, webhookID string) error {
+	return service.Repository.DeleteWebhook(ctx, webhookID)
+}
+
+func (service WebhookServices) GetDeliveries(ctx context.Context, query model.DeliveryQuery) ([]model.WebhookDelivery, error) {
+	return service.Repository.GetDeliveries(ctx, query)
+}
+
+func (service WebhookServices) GetDeliveryByID(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	return service.Repository.GetDeliveryByID(ctx, deliveryID)
+}
+
+// Redeliver queues a delivery to be sent again right away and returns it. Dead deliveries
+// get a fresh series of retries.
+func (service WebhookServices) Redeliver(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	if err := service.Repository.Redeliver(ctx, deliveryID, time.Now()); err != nil {
+		return nil, err
+	}
+	return service.Repository.GetDeliveryByID(ctx, deliveryID)
+}
@@ -0,0 +1,300 @@
+package sitegen
+
+import (
+	"archive/zip"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/layout"
+	"atommuse/backend/exhibition-service/pkg/media"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/rights"
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+)
+
+//go:embed templates
+var files embed.FS
+
+var templates = template.Must(template.New("site").Funcs(template.FuncMap{
+	"media":    func(ref string) string { return ref },
+	"roomPage": roomPage,
+	"inc":      func(i int) int { return i + 1 },
+}).ParseFS(files, "templates/*.html"))
+
+// MaxMediaSize is the largest media file copied into a site.
+const MaxMediaSize = 200 << 20
+
+// Options configure how a site is rendered.
+type Options struct {
+	// Client downloads remote media so the site works offline. Remote media is linked as is
+	// when Client is nil or the download fails. Only set it for trusted operators such as the
+	// sitegen command, never for URLs entered by users of the server.
+	Client *http.Client
+}
+
+type page struct {
+	Title      string
+	Layout     string
+	Back       string
+	Exhibition *model.ResponseExhibition
+	Body       template.HTML
+	Credits    []string
+}
+
+// indexData is passed to the index page of both layouts.
+type indexData struct {
+	Exhibition *model.ResponseExhibition
+	Sections   []template.HTML
+}
+
+type roomData struct {
+	Room                *model.Room
+	Left, Center, Right []template.HTML
+	Prev, Next          string
+}
+
+type site struct {
+	archive   *zip.Writer
+	options   Options
+	templates *template.Template
+	copied    map[string]string
+	credits   []string
+}
+
+// Supports reports whether exhibitions with the given layout can be rendered.
+func Supports(name string) bool {
+	return name == layout.Blog || name == layout.Live
+}
+
+// Write renders an exhibition with its sections or rooms into a self-contained static site and
+// writes it as a zip archive. blogLayout exhibitions become a single page of sections,
+// liveLayout exhibitions an index page with one page per room. Referenced media is copied into
+// the archive and links point to the copies. Every page lists the credit lines of the media.
+func Write(w io.Writer, exhibition *model.ResponseExhibition, options Options) error {
+	if !Supports(exhibition.LayoutUsed) {
+		return cerr.ErrUnsupportedLayout
+	}
+
+	s := &site{archive: zip.NewWriter(w), options: options, copied: map[string]string{}, credits: rights.Credits(exhibition)}
+	s.templates = template.Must(templates.Clone()).Funcs(template.FuncMap{"media": s.mediaLink})
+
+	if err := s.copyMedia(exhibition); err != nil {
+		return err
+	}
+
+	if err := s.writeAsset("style.css"); err != nil {
+		return err
+	}
+
+	var err error
+	if exhibition.LayoutUsed == layout.Blog {
+		err = s.writeBlog(exhibition)
+	} else {
+		err = s.writeLive(exhibition)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.archive.Close()
+}
+
+func (s *site) writeBlog(exhibition *model.ResponseExhibition) error {
+	data := indexData{Exhibition: exhibition}
+	for _, section := range exhibition.ExhibitionSections {
+		html, err := s.render(lookup("section-", section.SectionType), section)
+		if err != nil {
+			return err
+		}
+		data.Sections = append(data.Sections, html)
+	}
+
+	return s.writePage("index.html", exhibition, "", "blog", data)
+}
+
+func (s *site) writeLive(exhibition *model.ResponseExhibition) error {
+	if err := s.writePage("index.html", exhibition, "", "live", indexData{Exhibition: exhibition}); err != nil {
+		return err
+	}
+
+	for i := range exhibition.Room {
+		room := &exhibition.Room[i]
+		data := roomData{Room: room}
+
+		var err error
+		if data.Left, err = s.renderItems(room.Left); err != nil {
+			return err
+		}
+		if data.Right, err = s.renderItems(room.Right); err != nil {
+			return err
+		}
+		for _, item := range room.Center {
+			html, err := s.render(lookup("item-", item.PreviewType), item)
+			if err != nil {
+				return err
+			}
+			data.Center = append(data.Center, html)
+		}
+
+		if i > 0 {
+			data.Prev = roomPage(i - 1)
+		}
+		if i < len(exhibition.Room)-1 {
+			data.Next = roomPage(i + 1)
+		}
+
+		title := fmt.Sprintf("Room %d", i+1)
+		if err := s.writePage(roomPage(i), exhibition, title, "room", data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *site) renderItems(items []model.LeftRightItem) ([]template.HTML, error) {
+	var rendered []template.HTML
+	for _, item := range items {
+		html, err := s.render(lookup("item-", item.PreviewType), item)
+		if err != nil {
+			return nil, err
+		}
+		rendered = append(rendered, html)
+	}
+	return rendered, nil
+}
+
+// writePage renders a body template inside the page shell. Pages other than the index get a
+// link back to it.
+func (s *site) writePage(name string, exhibition *model.ResponseExhibition, title, body string, data interface{}) error {
+	html, err := s.render(body, data)
+	if err != nil {
+		return err
+	}
+
+	p := page{Title: exhibition.ExhibitionName, Layout: exhibition.LayoutUsed, Exhibition: exhibition, Body: html, Credits: s.credits}
+	if title != "" {
+		p.Title = title
+		p.Back = "index.html"
+	}
+
+	entry, err := s.archive.Create(name)
+	if err != nil {
+		return err
+	}
+	return s.templates.ExecuteTemplate(entry, "page", p)
+}
+
+func (s *site) writeAsset(name string) error {
+	content, err := files.ReadFile("templates/" + name)
+	if err != nil {
+		return err
+	}
+
+	entry, err := s.archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = entry.Write(content)
+	return err
+}
+
+// copyMedia adds every media file the exhibition references to the archive. Media that cannot
+// be read stays linked to its original location.
+func (s *site) copyMedia(exhibition *model.ResponseExhibition) error {
+	for i, ref := range media.References(exhibition) {
+		if media.IsRemote(ref) && s.options.Client == nil {
+			continue
+		}
+		name := fmt.Sprintf("media/%03d-%s", i+1, fileName(ref))
+
+		reader, err := s.open(ref)
+		if err != nil {
+			log.Printf("Skipping media %s: %v", ref, err)
+			continue
+		}
+
+		entry, err := s.archive.Create(name)
+		if err == nil {
+			var n int64
+			n, err = io.Copy(entry, io.LimitReader(reader, MaxMediaSize+1))
+			if err == nil && n > MaxMediaSize {
+				err = fmt.Errorf("media is larger than %d bytes", MaxMediaSize)
+			}
+		}
+		reader.Close()
+		if err != nil {
+			return fmt.Errorf("error copying media %s: %v", ref, err)
+		}
+
+		s.copied[ref] = name
+	}
+
+	return nil
+}
+
+// mediaLink points a media reference at its copy in the archive.
+func (s *site) mediaLink(ref string) string {
+	if name, ok := s.copied[ref]; ok {
+		return name
+	}
+	return ref
+}
+
+// fileName returns the last path element of a media reference without query or fragment.
+func fileName(ref string) string {
+	if u, err := url.Parse(ref); err == nil {
+		ref = u.Path
+	}
+	if name := path.Base(ref); name != "." && name != "/" {
+		return name
+	}
+	return "file"
+}
+
+func (s *site) open(ref string) (io.ReadCloser, error) {
+	if localPath, ok := media.LocalPath(ref); ok {
+		return os.Open(localPath)
+	}
+
+	if !media.IsRemote(ref) {
+		return nil, fmt.Errorf("media is not stored locally")
+	}
+
+	response, err := s.options.Client.Get(ref)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s", response.Status)
+	}
+	return response.Body, nil
+}
+
+// lookup returns the template for a section type or preview type, falling back to the default.
+func lookup(prefix, kind string) string {
+	if kind != "" && templates.Lookup(prefix+kind) != nil {
+		return prefix + kind
+	}
+	return prefix + "default"
+}
+
+func (s *site) render(name string, data interface{}) (template.HTML, error) {
+	var buf bytes.Buffer
+	if err := s.templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
+	}
+	return template.HTML(buf.String()), nil
+}
+
+func roomPage(i int) string {
+	return fmt.Sprintf("room-%d.html", i+1)
+}
@@ -0,0 +1,85 @@
+package sitegen_test
+
+import (
+	"archive/zip"
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/sitegen"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func render(t *testing.T, exhibition *model.ResponseExhibition) map[string]string {
+	var buf bytes.Buffer
+	require.NoError(t, sitegen.Write(&buf, exhibition, sitegen.Options{}))
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		reader.Close()
+		files[file.Name] = string(content)
+	}
+	return files
+}
+
+func TestWriteBlogLayout(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("MEDIA_ROOT", root)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "uploads"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "uploads", "bowl.jpg"), []byte("jpg"), 0o644))
+
+	files := render(t, &model.ResponseExhibition{
+		ExhibitionName: "Siam Ceramics",
+		LayoutUsed:     "blogLayout",
+		ExhibitionSections: []model.ExhibitionSection{
+			{SectionType: "banner", Title: "Celadon <glaze>"},
+			{SectionType: "unknown", LeftCol: model.LeftColumn{Title: "Bowl", Image: "/uploads/bowl.jpg"}},
+		},
+	})
+
+	require.Contains(t, files, "index.html")
+	assert.Contains(t, files, "style.css")
+	assert.Equal(t, "jpg", files["media/001-bowl.jpg"])
+
+	index := files["index.html"]
+	assert.Contains(t, index, "<title>Siam Ceramics</title>")
+	assert.Contains(t, index, "Celadon &lt;glaze&gt;")
+	assert.Contains(t, index, `src="media/001-bowl.jpg"`)
+	assert.Contains(t, index, `class="section unknown"`)
+}
+
+func TestWriteLiveLayout(t *testing.T) {
+	files := render(t, &model.ResponseExhibition{
+		ExhibitionName: "Night Market",
+		LayoutUsed:     "liveLayout",
+		Room: []model.Room{
+			{Center: []model.CenterItem{{PreviewType: "video", Src: "https://cdn.example/clip.mp4"}}},
+			{Left: []model.LeftRightItem{{Src: "https://cdn.example/lamp.jpg", Details: model.Details{Contents: []model.Contents{{Title: "Lamp"}}}}}},
+		},
+	})
+
+	assert.Contains(t, files["index.html"], `href="room-1.html"`)
+	assert.Contains(t, files["index.html"], `href="room-2.html"`)
+
+	// Remote media is linked as is without a client
+	assert.Contains(t, files["room-1.html"], `<video src="https://cdn.example/clip.mp4"`)
+	assert.Contains(t, files["room-1.html"], `href="room-2.html"`)
+	assert.Contains(t, files["room-2.html"], "<h4>Lamp</h4>")
+}
+
+func TestWriteUnsupportedLayout(t *testing.T) {
+	err := sitegen.Write(io.Discard, &model.ResponseExhibition{LayoutUsed: "galleryLayout"}, sitegen.Options{})
+	assert.ErrorIs(t, err, cerr.ErrUnsupportedLayout)
+}
@@ -0,0 +1,190 @@
+package timeline
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date is a calendar date of year, month or day precision. Month and Day are zero when the
+// date is less precise.
+type Date struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+var datePattern = regexp.MustCompile(`^(-?\d{4,})(?:-(\d{2})(?:-(\d{2}))?)?$`)
+
+// Parse parses an ISO 8601 calendar date such as "1350", "1767-04", "1932-06-24" or "-0500".
+func Parse(value string) (Date, error) {
+	match := datePattern.FindStringSubmatch(value)
+	if match == nil {
+		return Date{}, fmt.Errorf("%q is not a date of the form YYYY, YYYY-MM or YYYY-MM-DD", value)
+	}
+
+	year, err := strconv.Atoi(match[1])
+	if err != nil {
+		return Date{}, fmt.Errorf("%q has an invalid year", value)
+	}
+	date := Date{Year: year}
+
+	if match[2] != "" {
+		date.Month, _ = strconv.Atoi(match[2])
+		if date.Month < 1 || date.Month > 12 {
+			return Date{}, fmt.Errorf("%q has an invalid month", value)
+		}
+	}
+
+	if match[3] != "" {
+		date.Day, _ = strconv.Atoi(match[3])
+		// time.Date normalises days past the end of the month into the next month
+		if date.Day < 1 || time.Date(year, time.Month(date.Month), date.Day, 0, 0, 0, 0, time.UTC).Day() != date.Day {
+			return Date{}, fmt.Errorf("%q has an invalid day", value)
+		}
+	}
+
+	return date, nil
+}
+
+// Compare returns -1, 0 or 1 as d is before, equal to or after other. A less precise date
+// comes before the more precise dates it contains.
+func (d Date) Compare(other Date) int {
+	for _, pair := range [][2]int{{d.Year, other.Year}, {d.Month, other.Month}, {d.Day, other.Day}} {
+		if pair[0] < pair[1] {
+			return -1
+		}
+		if pair[0] > pair[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// ValidationError lists the problems of a timeline entry.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid timeline entry: " + strings.Join(e.Problems, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return cerr.ErrInvalidTimelineEntry
+}
+
+// Validate checks that the dates of an entry parse and that it does not end before it starts.
+func Validate(entry *model.TimelineEntry) error {
+	var problems []string
+
+	start, err := Parse(entry.StartDate)
+	if err != nil {
+		problems = append(problems, "startDate: "+err.Error())
+	}
+
+	if entry.EndDate != "" {
+		end, endErr := Parse(entry.EndDate)
+		switch {
+		case endErr != nil:
+			problems = append(problems, "endDate: "+endErr.Error())
+		case err == nil && end.Compare(start) < 0:
+			problems = append(problems, "endDate is before startDate")
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// Sort orders entries chronologically by start date, then by end date with open ended entries
+// last, then by title. Entries with dates that do not parse go last.
+func Sort(entries []model.TimelineEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return less(&entries[i], &entries[j])
+	})
+}
+
+func less(a, b *model.TimelineEntry) bool {
+	aStart, aErr := Parse(a.StartDate)
+	bStart, bErr := Parse(b.StartDate)
+	if aErr != nil || bErr != nil {
+		return aErr == nil && bErr != nil
+	}
+	if c := aStart.Compare(bStart); c != 0 {
+		return c < 0
+	}
+
+	if a.EndDate != b.EndDate {
+		if a.EndDate == "" || b.EndDate == "" {
+			return b.EndDate == ""
+		}
+		aEnd, aErr := Parse(a.EndDate)
+		bEnd, bErr := Parse(b.EndDate)
+		if aErr == nil && bErr == nil {
+			if c := aEnd.Compare(bEnd); c != 0 {
+				return c < 0
+			}
+		}
+	}
+
+	return a.Title < b.Title
+}
+
+// Eras groups sorted entries by era. Eras are ordered by their earliest entry and entries without
+// an era form a group with an empty name.
+func Eras(entries []model.TimelineEntry) []model.TimelineEra {
+	eras := []model.TimelineEra{}
+	index := map[string]int{}
+
+	for _, entry := range entries {
+		i, ok := index[entry.Era]
+		if !ok {
+			i = len(eras)
+			index[entry.Era] = i
+			eras = append(eras, model.TimelineEra{Era: entry.Era, StartDate: entry.StartDate})
+		}
+
+		era := &eras[i]
+		era.Entries = append(era.Entries, entry)
+		if end := lastDate(&entry); after(end, era.EndDate) {
+			era.EndDate = end
+		}
+	}
+
+	// An era whose entries all share one date has no range
+	for i := range eras {
+		if eras[i].EndDate == eras[i].StartDate {
+			eras[i].EndDate = ""
+		}
+	}
+
+	return eras
+}
+
+func lastDate(entry *model.TimelineEntry) string {
+	if entry.EndDate != "" {
+		return entry.EndDate
+	}
+	return entry.StartDate
+}
+
+// after reports whether the date a is after b. Dates that do not parse are never after.
+func after(a, b string) bool {
+	aDate, err := Parse(a)
+	if err != nil {
+		return false
+	}
+	bDate, err := Parse(b)
+	if err != nil {
+		return true
+	}
+	return aDate.Compare(bDate) > 0
+}
@@ -0,0 +1,91 @@
+package timeline_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/timeline"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	date, err := timeline.Parse("1932-06-24")
+	require.NoError(t, err)
+	assert.Equal(t, timeline.Date{Year: 1932, Month: 6, Day: 24}, date)
+
+	date, err = timeline.Parse("-0500")
+	require.NoError(t, err)
+	assert.Equal(t, timeline.Date{Year: -500}, date)
+
+	for _, value := range []string{"", "32", "1932-13", "1932-02-30", "1932/06/24", "June 1932"} {
+		_, err := timeline.Parse(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	parse := func(value string) timeline.Date {
+		date, err := timeline.Parse(value)
+		require.NoError(t, err)
+		return date
+	}
+
+	assert.Equal(t, -1, parse("-0500").Compare(parse("0001")))
+	assert.Equal(t, -1, parse("1767").Compare(parse("1767-04")))
+	assert.Equal(t, 1, parse("1767-04-08").Compare(parse("1767-04")))
+	assert.Equal(t, 0, parse("1767-04").Compare(parse("1767-04")))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, timeline.Validate(&model.TimelineEntry{StartDate: "1350", EndDate: "1767-04-07"}))
+	assert.NoError(t, timeline.Validate(&model.TimelineEntry{StartDate: "1767-04", EndDate: "1767-04-07"}))
+
+	err := timeline.Validate(&model.TimelineEntry{StartDate: "1767", EndDate: "1350"})
+	assert.ErrorIs(t, err, cerr.ErrInvalidTimelineEntry)
+
+	var invalid *timeline.ValidationError
+	require.ErrorAs(t, timeline.Validate(&model.TimelineEntry{StartDate: "soon", EndDate: "later"}), &invalid)
+	assert.Len(t, invalid.Problems, 2)
+}
+
+func TestSort(t *testing.T) {
+	entries := []model.TimelineEntry{
+		{Title: "Fall of Ayutthaya", StartDate: "1767-04-07"},
+		{Title: "Broken", StartDate: "someday"},
+		{Title: "Ayutthaya period", StartDate: "1350", EndDate: "1767"},
+		{Title: "Founding", StartDate: "1350"},
+		{Title: "Sukhothai", StartDate: "1238", EndDate: "1438"},
+		{Title: "Angkor", StartDate: "1238", EndDate: "1431"},
+	}
+
+	timeline.Sort(entries)
+
+	var titles []string
+	for _, entry := range entries {
+		titles = append(titles, entry.Title)
+	}
+	assert.Equal(t, []string{"Angkor", "Sukhothai", "Ayutthaya period", "Founding", "Fall of Ayutthaya", "Broken"}, titles)
+}
+
+func TestEras(t *testing.T) {
+	entries := []model.TimelineEntry{
+		{Title: "Founding", StartDate: "1350", Era: "Ayutthaya"},
+		{Title: "Chronicle", StartDate: "1400"},
+		{Title: "Trade", StartDate: "1511", EndDate: "1600", Era: "Ayutthaya"},
+		{Title: "Fall", StartDate: "1767-04-07", Era: "Ayutthaya"},
+		{Title: "Thonburi", StartDate: "1767-12-28", Era: "Thonburi"},
+	}
+
+	eras := timeline.Eras(entries)
+
+	require.Len(t, eras, 3)
+	assert.Equal(t, "Ayutthaya", eras[0].Era)
+	assert.Equal(t, "1350", eras[0].StartDate)
+	assert.Equal(t, "1767-04-07", eras[0].EndDate)
+	assert.Len(t, eras[0].Entries, 3)
+	assert.Equal(t, "", eras[1].Era)
+	assert.Equal(t, "", eras[1].EndDate)
+	assert.Equal(t, "Thonburi", eras[2].Era)
+}
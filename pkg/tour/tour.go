@@ -0,0 +1,209 @@
+package tour
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"fmt"
+	"mime"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxAudioSize is the largest narration recording that may be uploaded, in bytes.
+const MaxAudioSize = 50 << 20
+
+// audioExtensions maps the accepted audio media types to the extension they are stored with.
+var audioExtensions = map[string]string{
+	"audio/mpeg":  ".mp3",
+	"audio/mp4":   ".m4a",
+	"audio/x-m4a": ".m4a",
+	"audio/aac":   ".aac",
+	"audio/ogg":   ".ogg",
+	"audio/wav":   ".wav",
+	"audio/wave":  ".wav",
+	"audio/x-wav": ".wav",
+	"audio/webm":  ".webm",
+	"audio/flac":  ".flac",
+}
+
+// ValidationError lists the problems of a tour.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid tour: " + strings.Join(e.Problems, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return cerr.ErrInvalidTour
+}
+
+// Validate checks that every stop of a tour references exactly one section or room item of
+// the exhibition and that durations are not negative.
+func Validate(tour *model.Tour, exhibition *model.ResponseExhibition) error {
+	var problems []string
+	addf := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	for i, stop := range tour.Stops {
+		switch {
+		case stop.SectionID != nil && stop.RoomID != nil:
+			addf("stops[%d] references both a section and a room", i)
+		case stop.SectionID != nil:
+			if findSection(exhibition, *stop.SectionID) == nil {
+				addf("stops[%d].sectionId is not a section of the exhibition", i)
+			}
+		case stop.RoomID != nil:
+			if _, err := findItem(exhibition, &stop); err != nil {
+				addf("stops[%d]: %v", i, err)
+			}
+		default:
+			addf("stops[%d] references neither a section nor a room item", i)
+		}
+
+		if stop.Duration < 0 {
+			addf("stops[%d].duration must not be negative", i)
+		}
+		for locale, translation := range stop.Translations {
+			if translation.Duration < 0 {
+				addf("stops[%d].translations.%s.duration must not be negative", i, locale)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// Resolve prepares a tour for playback in the locale the exhibition was localized in. Stops
+// whose section or room item no longer exists are left out.
+func Resolve(tour *model.Tour, exhibition *model.ResponseExhibition) *model.ResponseTour {
+	locale := exhibition.Locale
+	response := &model.ResponseTour{
+		ID:           tour.ID,
+		ExhibitionID: tour.ExhibitionID,
+		Name:         tour.Name,
+		Description:  tour.Description,
+		Locale:       locale,
+		Stops:        []model.ResolvedStop{},
+	}
+	if translation, ok := tour.Translations[locale]; ok {
+		response.Name = fallback(translation.Name, response.Name)
+		response.Description = fallback(translation.Description, response.Description)
+	}
+
+	for i := range tour.Stops {
+		stop := &tour.Stops[i]
+		resolved := model.ResolvedStop{
+			Index:     i,
+			Title:     stop.Title,
+			Narration: stop.Narration,
+			Audio:     stop.Audio,
+			Duration:  stop.Duration,
+		}
+		if translation, ok := stop.Translations[locale]; ok {
+			resolved.Title = fallback(translation.Title, resolved.Title)
+			resolved.Narration = fallback(translation.Narration, resolved.Narration)
+			// A recording and its duration belong together
+			if translation.Audio != "" {
+				resolved.Audio = translation.Audio
+				resolved.Duration = translation.Duration
+			}
+		}
+
+		if stop.SectionID != nil {
+			resolved.Section = findSection(exhibition, *stop.SectionID)
+			if resolved.Section == nil {
+				continue
+			}
+		} else {
+			item, err := findItem(exhibition, stop)
+			if err != nil {
+				continue
+			}
+			resolved.Item = item
+		}
+
+		response.Duration += resolved.Duration
+		response.Stops = append(response.Stops, resolved)
+	}
+
+	return response
+}
+
+// AudioExtension returns the extension a narration recording is stored with. The sniffed media
+// type of the content is preferred over the declared one, which is only used when sniffing
+// cannot tell.
+func AudioExtension(sniffed, declared string) (string, bool) {
+	mediaType, _, _ := mime.ParseMediaType(sniffed)
+	if mediaType == "application/octet-stream" || mediaType == "" {
+		mediaType, _, _ = mime.ParseMediaType(declared)
+	}
+	if mediaType == "application/ogg" {
+		mediaType = "audio/ogg"
+	}
+
+	extension, ok := audioExtensions[mediaType]
+	return extension, ok
+}
+
+func findSection(exhibition *model.ResponseExhibition, sectionID primitive.ObjectID) *model.ExhibitionSection {
+	for i := range exhibition.ExhibitionSections {
+		if exhibition.ExhibitionSections[i].ID == sectionID {
+			return &exhibition.ExhibitionSections[i]
+		}
+	}
+	return nil
+}
+
+func findItem(exhibition *model.ResponseExhibition, stop *model.TourStop) (*model.TourItem, error) {
+	var room *model.Room
+	for i := range exhibition.Room {
+		if exhibition.Room[i].ID == *stop.RoomID {
+			room = &exhibition.Room[i]
+		}
+	}
+	if room == nil {
+		return nil, fmt.Errorf("roomId is not a room of the exhibition")
+	}
+
+	item := &model.TourItem{RoomID: room.ID, Wall: stop.Wall, ItemIndex: stop.ItemIndex}
+	outOfRange := func(length int) bool {
+		return stop.ItemIndex < 0 || stop.ItemIndex >= length
+	}
+
+	switch stop.Wall {
+	case model.WallLeft, model.WallRight:
+		items := room.Left
+		if stop.Wall == model.WallRight {
+			items = room.Right
+		}
+		if outOfRange(len(items)) {
+			return nil, fmt.Errorf("the %s wall has no item %d", stop.Wall, stop.ItemIndex)
+		}
+		source := items[stop.ItemIndex]
+		item.PreviewType, item.Src, item.Details, item.Artwork = source.PreviewType, source.Src, source.Details, source.Artwork
+	case model.WallCenter:
+		if outOfRange(len(room.Center)) {
+			return nil, fmt.Errorf("the center wall has no item %d", stop.ItemIndex)
+		}
+		source := room.Center[stop.ItemIndex]
+		item.PreviewType, item.Src, item.Details, item.Artwork = source.PreviewType, source.Src, source.Details, source.Artwork
+	default:
+		return nil, fmt.Errorf("wall must be %s, %s or %s", model.WallLeft, model.WallCenter, model.WallRight)
+	}
+
+	return item, nil
+}
+
+func fallback(translated, value string) string {
+	if translated != "" {
+		return translated
+	}
+	return value
+}
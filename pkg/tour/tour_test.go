@@ -0,0 +1,101 @@
+package tour_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/tour"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	sectionID = primitive.NewObjectID()
+	roomID    = primitive.NewObjectID()
+)
+
+func exhibition() *model.ResponseExhibition {
+	return &model.ResponseExhibition{
+		ExhibitionSections: []model.ExhibitionSection{{ID: sectionID, Title: "Celadon"}},
+		Room: []model.Room{{
+			ID:     roomID,
+			Center: []model.CenterItem{{Src: "/uploads/jar.jpg"}},
+			Right:  []model.LeftRightItem{{Src: "/uploads/bowl.jpg"}, {Src: "/uploads/plate.jpg"}},
+		}},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := &model.Tour{Stops: []model.TourStop{
+		{SectionID: &sectionID},
+		{RoomID: &roomID, Wall: model.WallRight, ItemIndex: 1},
+	}}
+	assert.NoError(t, tour.Validate(valid, exhibition()))
+
+	other := primitive.NewObjectID()
+	invalid := &model.Tour{Stops: []model.TourStop{
+		{},
+		{SectionID: &sectionID, RoomID: &roomID},
+		{SectionID: &other},
+		{RoomID: &roomID, Wall: model.WallLeft},
+		{RoomID: &roomID, Wall: "ceiling"},
+		{SectionID: &sectionID, Duration: -1},
+	}}
+	err := tour.Validate(invalid, exhibition())
+	assert.ErrorIs(t, err, cerr.ErrInvalidTour)
+
+	var problems *tour.ValidationError
+	require.ErrorAs(t, err, &problems)
+	assert.Len(t, problems.Problems, 6)
+}
+
+func TestResolve(t *testing.T) {
+	missing := primitive.NewObjectID()
+	subject := &model.Tour{
+		Name:         "Highlights",
+		Translations: map[string]model.TourTranslation{"th": {Name: "ไฮไลต์"}},
+		Stops: []model.TourStop{
+			{SectionID: &sectionID, Narration: "Green glaze", Audio: "/tours/en-1.mp3", Duration: 60,
+				Translations: map[string]model.TourStopTranslation{"th": {Narration: "เคลือบเขียว", Audio: "/tours/th-1.mp3", Duration: 75}}},
+			{SectionID: &missing, Duration: 30},
+			{RoomID: &roomID, Wall: model.WallCenter, Narration: "A storage jar", Duration: 45},
+		},
+	}
+
+	exhibition := exhibition()
+	exhibition.Locale = "th"
+	resolved := tour.Resolve(subject, exhibition)
+
+	assert.Equal(t, "ไฮไลต์", resolved.Name)
+	assert.Equal(t, 120, resolved.Duration)
+	require.Len(t, resolved.Stops, 2)
+
+	assert.Equal(t, 0, resolved.Stops[0].Index)
+	assert.Equal(t, "เคลือบเขียว", resolved.Stops[0].Narration)
+	assert.Equal(t, "/tours/th-1.mp3", resolved.Stops[0].Audio)
+	assert.Equal(t, "Celadon", resolved.Stops[0].Section.Title)
+
+	// The stop of the deleted section is skipped, the room item keeps its default narration
+	assert.Equal(t, 2, resolved.Stops[1].Index)
+	assert.Equal(t, "A storage jar", resolved.Stops[1].Narration)
+	assert.Equal(t, "/uploads/jar.jpg", resolved.Stops[1].Item.Src)
+}
+
+func TestAudioExtension(t *testing.T) {
+	extension, ok := tour.AudioExtension("audio/mpeg", "audio/x-anything")
+	assert.True(t, ok)
+	assert.Equal(t, ".mp3", extension)
+
+	extension, ok = tour.AudioExtension("application/octet-stream", "audio/mp4")
+	assert.True(t, ok)
+	assert.Equal(t, ".m4a", extension)
+
+	extension, ok = tour.AudioExtension("application/ogg", "")
+	assert.True(t, ok)
+	assert.Equal(t, ".ogg", extension)
+
+	_, ok = tour.AudioExtension("image/png", "audio/mpeg")
+	assert.False(t, ok)
+}
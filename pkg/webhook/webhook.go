@@ -0,0 +1,215 @@
+package webhook
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/event"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers of the requests posted to webhooks.
+const (
+	SignatureHeader = "X-AtomMuse-Signature"
+	EventHeader     = "X-AtomMuse-Event"
+	DeliveryHeader  = "X-AtomMuse-Delivery"
+)
+
+const (
+	// Timeout bounds a request to a webhook, including reading the response.
+	Timeout = 10 * time.Second
+	// MaxFailures is how many attempts fail before a delivery is dead.
+	MaxFailures = 10
+	// MaxResponseSize is how much of a response is kept in the delivery log, in bytes.
+	MaxResponseSize = 1 << 10
+	// SignatureTolerance is how old a signature may be for Verify to accept it.
+	SignatureTolerance = 5 * time.Minute
+)
+
+// First and longest wait between two attempts of a delivery.
+const (
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+)
+
+// ValidationError lists the problems of a webhook.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid webhook: " + strings.Join(e.Problems, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return cerr.ErrInvalidWebhook
+}
+
+// Validate checks that a webhook posts to an absolute HTTP or HTTPS URL and subscribes to
+// known event types.
+func Validate(request *model.RequestWebhook) error {
+	var problems []string
+
+	target, err := url.Parse(request.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		problems = append(problems, "url must be an absolute http or https URL")
+	}
+	for i, eventType := range request.Events {
+		if !event.Matches(&model.Event{Type: eventType}, event.Types) {
+			problems = append(problems, fmt.Sprintf("events[%d] is not an event type: %s", i, eventType))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// NewSecret returns a random secret to sign the payloads of a webhook with.
+func NewSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+// Backoff returns how long to wait before the next attempt of a delivery that failed the
+// given number of times: 30 seconds, doubled after every failure up to 6 hours.
+func Backoff(failures int) time.Duration {
+	wait := baseBackoff
+	for i := 1; i < failures && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+	return wait
+}
+
+// Sign returns the signature header of a payload: the time it was signed at and an
+// HMAC-SHA256 of the time and the payload, as t=<unix seconds>,v1=<hex>.
+func Sign(secret string, signedAt time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac(secret, timestamp, body))
+}
+
+// Verify checks a signature header against a payload, as receivers of webhooks do.
+// Signatures older than SignatureTolerance are rejected to prevent replays.
+func Verify(secret, header string, body []byte, now time.Time) error {
+	var timestamp, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signature = value
+		}
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return cerr.ErrInvalidSignature
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, mac(secret, timestamp, body)) {
+		return cerr.ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(signedAt, 0)); age > SignatureTolerance || age < -SignatureTolerance {
+		return cerr.ErrInvalidSignature
+	}
+	return nil
+}
+
+// Send posts a delivery to its webhook and returns the attempt. The attempt succeeded when
+// the webhook answered with a 2xx status.
+func Send(ctx context.Context, client *http.Client, hook *model.Webhook, delivery *model.WebhookDelivery, now time.Time) model.DeliveryAttempt {
+	attempt := model.DeliveryAttempt{AttemptedAt: now}
+
+	body, err := json.Marshal(model.WebhookPayload{
+		ID:        delivery.ID,
+		Type:      delivery.Event.Type,
+		CreatedAt: delivery.CreatedAt,
+		Data:      delivery.Event,
+	})
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "AtomMuse-Webhooks/1.0")
+	request.Header.Set(EventHeader, delivery.Event.Type)
+	request.Header.Set(DeliveryHeader, delivery.ID.Hex())
+	request.Header.Set(SignatureHeader, Sign(hook.Secret, now, body))
+
+	started := time.Now()
+	response, err := client.Do(request)
+	if err != nil {
+		attempt.Error = err.Error()
+		attempt.Duration = time.Since(started).Milliseconds()
+		return attempt
+	}
+	defer response.Body.Close()
+
+	content, _ := io.ReadAll(io.LimitReader(response.Body, MaxResponseSize))
+	attempt.StatusCode = response.StatusCode
+	attempt.Response = string(content)
+	attempt.Duration = time.Since(started).Milliseconds()
+	return attempt
+}
+
+// Succeeded reports whether an attempt delivered its event.
+func Succeeded(attempt *model.DeliveryAttempt) bool {
+	return attempt.Error == "" && attempt.StatusCode >= 200 && attempt.StatusCode < 300
+}
+
+// Advance moves a delivery on after an attempt: it is delivered when the attempt succeeded,
+// and otherwise retried after a backoff until it failed MaxFailures times and is dead.
+func Advance(delivery *model.WebhookDelivery, attempt *model.DeliveryAttempt) {
+	if Succeeded(attempt) {
+		deliveredAt := attempt.AttemptedAt
+		delivery.Status = model.DeliveryDelivered
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &deliveredAt
+		return
+	}
+
+	delivery.Failures++
+	if delivery.Failures >= MaxFailures {
+		delivery.Status = model.DeliveryDead
+		delivery.NextAttemptAt = nil
+		return
+	}
+	next := attempt.AttemptedAt.Add(Backoff(delivery.Failures))
+	delivery.NextAttemptAt = &next
+}
+
+func mac(secret, timestamp string, body []byte) []byte {
+	hash := hmac.New(sha256.New, []byte(secret))
+	hash.Write([]byte(timestamp))
+	hash.Write([]byte("."))
+	hash.Write(body)
+	return hash.Sum(nil)
+}
@@ -0,0 +1,124 @@
+package webhook_test
+
+import (
+	"atommuse/backend/exhibition-service/pkg/cerr"
+	"atommuse/backend/exhibition-service/pkg/model"
+	"atommuse/backend/exhibition-service/pkg/webhook"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestValidate(t *testing.T) {
+	err := webhook.Validate(&model.RequestWebhook{
+		URL:    "https://comments.atommuse.internal/hooks",
+		Events: []string{model.EventExhibitionDeleted, model.EventLikesChanged},
+	})
+	assert.NoError(t, err)
+
+	err = webhook.Validate(&model.RequestWebhook{URL: "ftp://example.com", Events: []string{"exhibition.exploded"}})
+	var invalid *webhook.ValidationError
+	require.ErrorAs(t, err, &invalid)
+	assert.ErrorIs(t, err, cerr.ErrInvalidWebhook)
+	assert.Len(t, invalid.Problems, 2)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhook.Backoff(1))
+	assert.Equal(t, time.Minute, webhook.Backoff(2))
+	assert.Equal(t, 8*time.Minute, webhook.Backoff(5))
+	assert.Equal(t, 256*time.Minute, webhook.Backoff(10))
+	assert.Equal(t, 6*time.Hour, webhook.Backoff(100))
+}
+
+func TestAdvance(t *testing.T) {
+	now := time.Now()
+	delivery := &model.WebhookDelivery{Status: model.DeliveryPending, NextAttemptAt: &now}
+
+	webhook.Advance(delivery, &model.DeliveryAttempt{AttemptedAt: now, Error: "connection refused"})
+	assert.Equal(t, model.DeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Failures)
+	assert.Equal(t, now.Add(30*time.Second), *delivery.NextAttemptAt)
+
+	webhook.Advance(delivery, &model.DeliveryAttempt{AttemptedAt: now, StatusCode: http.StatusInternalServerError})
+	assert.Equal(t, 2, delivery.Failures)
+	assert.Equal(t, now.Add(time.Minute), *delivery.NextAttemptAt)
+
+	webhook.Advance(delivery, &model.DeliveryAttempt{AttemptedAt: now, StatusCode: http.StatusAccepted})
+	assert.Equal(t, model.DeliveryDelivered, delivery.Status)
+	assert.Nil(t, delivery.NextAttemptAt)
+	assert.Equal(t, now, *delivery.DeliveredAt)
+
+	dead := &model.WebhookDelivery{Status: model.DeliveryPending, Failures: webhook.MaxFailures - 1}
+	webhook.Advance(dead, &model.DeliveryAttempt{AttemptedAt: now, StatusCode: http.StatusGone})
+	assert.Equal(t, model.DeliveryDead, dead.Status)
+	assert.Equal(t, webhook.MaxFailures, dead.Failures)
+	assert.Nil(t, dead.NextAttemptAt)
+}
+
+func TestSignature(t *testing.T) {
+	body := []byte(`{"type":"exhibition.published"}`)
+	signedAt := time.Unix(1700000000, 0)
+	header := webhook.Sign("whsec_test", signedAt, body)
+	assert.True(t, strings.HasPrefix(header, "t=1700000000,v1="))
+
+	assert.NoError(t, webhook.Verify("whsec_test", header, body, signedAt.Add(time.Minute)))
+	assert.ErrorIs(t, webhook.Verify("whsec_other", header, body, signedAt), cerr.ErrInvalidSignature)
+	assert.ErrorIs(t, webhook.Verify("whsec_test", header, []byte(`{}`), signedAt), cerr.ErrInvalidSignature)
+	assert.ErrorIs(t, webhook.Verify("whsec_test", header, body, signedAt.Add(time.Hour)), cerr.ErrInvalidSignature)
+	assert.ErrorIs(t, webhook.Verify("whsec_test", "v1=00", body, signedAt), cerr.ErrInvalidSignature)
+}
+
+func TestSend(t *testing.T) {
+	delivery := &model.WebhookDelivery{
+		ID:        primitive.NewObjectID(),
+		Event:     model.Event{ID: "abc", Type: model.EventExhibitionPublished, ExhibitionID: primitive.NewObjectID()},
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	now := time.Now()
+
+	var received model.WebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, webhook.Verify("whsec_test", r.Header.Get(webhook.SignatureHeader), body, now))
+		assert.Equal(t, model.EventExhibitionPublished, r.Header.Get(webhook.EventHeader))
+		assert.Equal(t, delivery.ID.Hex(), r.Header.Get(webhook.DeliveryHeader))
+		assert.NoError(t, json.Unmarshal(body, &received))
+
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(strings.Repeat("x", 2*webhook.MaxResponseSize)))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	hook := &model.Webhook{URL: server.URL + "/ok", Secret: "whsec_test"}
+	attempt := webhook.Send(context.Background(), server.Client(), hook, delivery, now)
+	assert.True(t, webhook.Succeeded(&attempt))
+	assert.Equal(t, http.StatusNoContent, attempt.StatusCode)
+	assert.Equal(t, delivery.ID, received.ID)
+	assert.Equal(t, delivery.Event, received.Data)
+	assert.True(t, delivery.CreatedAt.Equal(received.CreatedAt))
+
+	hook.URL = server.URL + "/fail"
+	attempt = webhook.Send(context.Background(), server.Client(), hook, delivery, now)
+	assert.False(t, webhook.Succeeded(&attempt))
+	assert.Equal(t, http.StatusServiceUnavailable, attempt.StatusCode)
+	assert.Len(t, attempt.Response, webhook.MaxResponseSize)
+
+	server.Close()
+	attempt = webhook.Send(context.Background(), http.DefaultClient, hook, delivery, now)
+	assert.False(t, webhook.Succeeded(&attempt))
+	assert.NotEmpty(t, attempt.Error)
+}